@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// shutdownTimeout bounds how long the HTTP server waits for in-flight requests
+const shutdownTimeout = 10 * time.Second
+
+// adapterRunner runs an inbound adapter until ctx is cancelled or the adapter stops on its own
+type adapterRunner func(ctx context.Context) error
+
+// runAdapters starts the enabled adapters concurrently and blocks until all have stopped.
+// The first adapter to stop, or cancellation of ctx, shuts the others down.
+// It returns the first adapter error, or an error before starting anything if an
+// enabled adapter has no runner in this build.
+func runAdapters(ctx context.Context, runners map[string]adapterRunner, enabled []string) error {
+	for _, name := range enabled {
+		if _, ok := runners[name]; !ok {
+			return fmt.Errorf("adapter %q is not available in this build", name)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for _, name := range enabled {
+		wg.Add(1)
+		go func(name string, run adapterRunner) {
+			defer wg.Done()
+			defer cancel()
+			if err := run(ctx); err != nil {
+				once.Do(func() { firstErr = fmt.Errorf("%s adapter: %w", name, err) })
+			}
+			log.Printf("Stopped %s adapter", name)
+		}(name, runners[name])
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// serveHTTP serves on ln until ctx is cancelled, then shuts the server down gracefully
+func serveHTTP(ctx context.Context, server *http.Server, ln net.Listener) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Serve(ln)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// runUntilDone runs a blocking function that cannot be interrupted, returning early
+// when ctx is cancelled
+func runUntilDone(ctx context.Context, run func()) error {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		run()
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return nil
+}
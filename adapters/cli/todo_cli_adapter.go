@@ -2,23 +2,47 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strings"
 
+	"github.com/mr3iscuit/ddd-golang/application/bus"
 	"github.com/mr3iscuit/ddd-golang/application/command"
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
 	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/application/query"
 	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
 )
 
 // TodoCLIAdapter handles command-line interface for Todo operations
 type TodoCLIAdapter struct {
-	usecase port.TodoUseCasePort
+	commands *bus.CommandBus
+	queries  *bus.QueryBus
+	// maxTitleLength lets add and update reject oversized titles without a use case round trip
+	maxTitleLength int
 }
 
-// NewTodoCLIAdapter creates a new Todo CLI
-func NewTodoCLIAdapter(usecase port.TodoUseCasePort) *TodoCLIAdapter {
-	return &TodoCLIAdapter{usecase: usecase}
+// NewTodoCLIAdapter creates a new Todo CLI; a nil config falls back to the defaults
+func NewTodoCLIAdapter(usecase port.TodoUseCasePort, cfg *config.Config) *TodoCLIAdapter {
+	if cfg == nil {
+		cfg = config.Default()
+	}
+	return &TodoCLIAdapter{
+		commands:       bus.NewTodoCommandBus(usecase),
+		queries:        bus.NewTodoQueryBus(usecase),
+		maxTitleLength: cfg.MaxTitleLength,
+	}
+}
+
+// titleTooLong prints a message and reports true when title exceeds the configured limit
+func (c *TodoCLIAdapter) titleTooLong(title string) bool {
+	if len(title) <= c.maxTitleLength {
+		return false
+	}
+	fmt.Printf("Error: title is %d characters long; the maximum is %d\n", len(title), c.maxTitleLength)
+	return true
 }
 
 // Run starts the CLI application
@@ -28,7 +52,7 @@ func (c *TodoCLIAdapter) Run() {
 
 	for {
 		fmt.Print("> ")
-		input, _ := reader.ReadString('\n')
+		input, err := reader.ReadString('\n')
 		input = strings.TrimSpace(input)
 
 		if input == "quit" || input == "exit" {
@@ -36,6 +60,10 @@ func (c *TodoCLIAdapter) Run() {
 		}
 
 		c.handleCommand(input)
+		// Stop once stdin is closed instead of spinning on EOF
+		if err != nil {
+			break
+		}
 	}
 }
 
@@ -53,6 +81,9 @@ func (c *TodoCLIAdapter) handleCommand(input string) {
 			return
 		}
 		title := parts[1]
+		if c.titleTooLong(title) {
+			return
+		}
 		description := ""
 		priority := "medium"
 
@@ -67,8 +98,9 @@ func (c *TodoCLIAdapter) handleCommand(input string) {
 			Title:       title,
 			Description: description,
 			Priority:    priority,
+			Source:      string(model.TodoSourceCLI),
 		}
-		id, err := c.usecase.CreateTodoUseCase(cmd)
+		id, err := bus.DispatchCommand[model.TodoID](context.Background(), c.commands, cmd)
 		if err != nil {
 			fmt.Printf("Error: %s\n", err.GetErrorMessage())
 		} else {
@@ -76,7 +108,7 @@ func (c *TodoCLIAdapter) handleCommand(input string) {
 		}
 
 	case "list":
-		todoListResponse, err := c.usecase.ListTodosUseCase()
+		todoListResponse, err := bus.DispatchQuery[*appmodel.TodoListResponse](context.Background(), c.queries, query.ListTodosQuery{})
 		if err != nil {
 			fmt.Printf("Error: %s\n", err.GetErrorMessage())
 			return
@@ -97,8 +129,7 @@ func (c *TodoCLIAdapter) handleCommand(input string) {
 			fmt.Println("Usage: get <id>")
 			return
 		}
-		todoID := model.TodoID(parts[1])
-		todoResponse, err := c.usecase.GetTodoUseCase(todoID)
+		todoResponse, err := bus.DispatchQuery[*appmodel.TodoResponse](context.Background(), c.queries, query.GetTodoQuery{ID: parts[1]})
 		if err != nil {
 			fmt.Printf("Error: %s\n", err.GetErrorMessage())
 			return
@@ -113,6 +144,9 @@ func (c *TodoCLIAdapter) handleCommand(input string) {
 		if todoResponse.CompletedAt != nil {
 			fmt.Printf("  Completed: %s\n", todoResponse.CompletedAt.Format("2006-01-02 15:04:05"))
 		}
+		if len(todoResponse.Tags) > 0 {
+			fmt.Printf("  Tags: %s\n", strings.Join(todoResponse.Tags, ", "))
+		}
 
 	case "update":
 		if len(parts) < 3 {
@@ -121,6 +155,9 @@ func (c *TodoCLIAdapter) handleCommand(input string) {
 		}
 		id := parts[1]
 		title := parts[2]
+		if c.titleTooLong(title) {
+			return
+		}
 		description := ""
 		priority := ""
 
@@ -137,7 +174,7 @@ func (c *TodoCLIAdapter) handleCommand(input string) {
 			Description: description,
 			Priority:    priority,
 		}
-		err := c.usecase.UpdateTodoUseCase(cmd)
+		_, err := bus.DispatchCommand[bus.NoResult](context.Background(), c.commands, cmd)
 		if err != nil {
 			fmt.Printf("Error: %s\n", err.GetErrorMessage())
 		} else {
@@ -149,7 +186,7 @@ func (c *TodoCLIAdapter) handleCommand(input string) {
 			fmt.Println("Usage: complete <id>")
 			return
 		}
-		err := c.usecase.CompleteTodoUseCase(model.TodoID(parts[1]))
+		_, err := bus.DispatchCommand[bus.NoResult](context.Background(), c.commands, command.CompleteTodoCommand{ID: parts[1]})
 		if err != nil {
 			fmt.Printf("Error: %s\n", err.GetErrorMessage())
 		} else {
@@ -161,7 +198,7 @@ func (c *TodoCLIAdapter) handleCommand(input string) {
 			fmt.Println("Usage: archive <id>")
 			return
 		}
-		err := c.usecase.ArchiveTodoUseCase(model.TodoID(parts[1]))
+		_, err := bus.DispatchCommand[bus.NoResult](context.Background(), c.commands, command.ArchiveTodoCommand{ID: parts[1]})
 		if err != nil {
 			fmt.Printf("Error: %s\n", err.GetErrorMessage())
 		} else {
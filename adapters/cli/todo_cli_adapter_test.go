@@ -1,40 +1,63 @@
 package cli
 
 import (
+	"context"
+	"io"
+	"os"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/mr3iscuit/ddd-golang/application/command"
 	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/query"
 	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
 )
 
 type MockTodoUseCase struct {
 	mock.Mock
 }
 
-func (m *MockTodoUseCase) CreateTodoUseCase(cmd command.CreateTodoCommand) (model.TodoID, *model.DomainError) {
+func (m *MockTodoUseCase) CreateTodoUseCase(ctx context.Context, cmd command.CreateTodoCommand) (model.TodoID, *model.DomainError) {
 	args := m.Called(cmd)
 	return args.Get(0).(model.TodoID), args.Get(1).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) UpdateTodoUseCase(cmd command.UpdateTodoCommand) *model.DomainError {
+func (m *MockTodoUseCase) UpdateTodoUseCase(ctx context.Context, cmd command.UpdateTodoCommand) *model.DomainError {
 	args := m.Called(cmd)
 	return args.Get(0).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) CompleteTodoUseCase(id model.TodoID) *model.DomainError {
+func (m *MockTodoUseCase) CompleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
 	args := m.Called(id)
 	return args.Get(0).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) ArchiveTodoUseCase(id model.TodoID) *model.DomainError {
+func (m *MockTodoUseCase) UncompleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
 	args := m.Called(id)
 	return args.Get(0).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) GetTodoUseCase(id model.TodoID) (*appmodel.TodoResponse, *model.DomainError) {
+func (m *MockTodoUseCase) ReopenTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	args := m.Called(id)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) UnarchiveTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	args := m.Called(id)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) ArchiveTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	args := m.Called(id)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) GetTodoUseCase(ctx context.Context, id model.TodoID) (*appmodel.TodoResponse, *model.DomainError) {
 	args := m.Called(id)
 	if resp, ok := args.Get(0).(*appmodel.TodoResponse); ok {
 		return resp, args.Get(1).(*model.DomainError)
@@ -42,27 +65,139 @@ func (m *MockTodoUseCase) GetTodoUseCase(id model.TodoID) (*appmodel.TodoRespons
 	return nil, args.Get(1).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) ListTodosUseCase() (*appmodel.TodoListResponse, *model.DomainError) {
+func (m *MockTodoUseCase) ListTodosUseCase(ctx context.Context, q query.ListTodosQuery) (*appmodel.TodoListResponse, *model.DomainError) {
+	args := m.Called(q)
+	if resp, ok := args.Get(0).(*appmodel.TodoListResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) DeleteTodosUseCase(ctx context.Context, ids []model.TodoID) ([]model.TodoID, *model.DomainError) {
+	args := m.Called(ids)
+	if failed, ok := args.Get(0).([]model.TodoID); ok {
+		return failed, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) GetDashboardUseCase(ctx context.Context, owner model.UserID) (*appmodel.DashboardResponse, *model.DomainError) {
+	args := m.Called(owner)
+	if resp, ok := args.Get(0).(*appmodel.DashboardResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) CompletionTimeStatsUseCase(ctx context.Context) (*appmodel.CompletionTimeStatsResponse, *model.DomainError) {
+	args := m.Called()
+	if resp, ok := args.Get(0).(*appmodel.CompletionTimeStatsResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) GetRandomTodoUseCase(ctx context.Context) (*appmodel.TodoResponse, *model.DomainError) {
 	args := m.Called()
+	if resp, ok := args.Get(0).(*appmodel.TodoResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) UncompleteBatchUseCase(ctx context.Context, ids []model.TodoID) ([]model.TodoID, *model.DomainError) {
+	args := m.Called(ids)
+	if failed, ok := args.Get(0).([]model.TodoID); ok {
+		return failed, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) BulkCompleteTodosUseCase(ctx context.Context, ids []model.TodoID) (*appmodel.BulkResult, *model.DomainError) {
+	args := m.Called(ids)
+	if result, ok := args.Get(0).(*appmodel.BulkResult); ok {
+		return result, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) ListStaleTodosUseCase(ctx context.Context, olderThan time.Duration) (*appmodel.TodoListResponse, *model.DomainError) {
+	args := m.Called(olderThan)
 	if resp, ok := args.Get(0).(*appmodel.TodoListResponse); ok {
 		return resp, args.Get(1).(*model.DomainError)
 	}
 	return nil, args.Get(1).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) TestErrorUseCase() *model.DomainError {
+func (m *MockTodoUseCase) ListTodosByPriorityUseCase(ctx context.Context, includeArchived bool) (*appmodel.TodosByPriorityResponse, *model.DomainError) {
+	args := m.Called(includeArchived)
+	if resp, ok := args.Get(0).(*appmodel.TodosByPriorityResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) SearchTodosUseCase(ctx context.Context, text string) (*appmodel.TodoListResponse, *model.DomainError) {
+	args := m.Called(text)
+	if resp, ok := args.Get(0).(*appmodel.TodoListResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) CountTodosUseCase(ctx context.Context, filter model.TodoFilter) (*appmodel.CountResponse, *model.DomainError) {
+	args := m.Called(filter)
+	if resp, ok := args.Get(0).(*appmodel.CountResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) ValidateFieldUseCase(ctx context.Context, cmd command.ValidateFieldCommand) (*appmodel.FieldValidationResponse, *model.DomainError) {
+	args := m.Called(cmd)
+	if resp, ok := args.Get(0).(*appmodel.FieldValidationResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) SnapshotUseCase(ctx context.Context) ([]byte, *model.DomainError) {
+	args := m.Called()
+	if data, ok := args.Get(0).([]byte); ok {
+		return data, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) RestoreSnapshotUseCase(ctx context.Context, data []byte, replace bool) *model.DomainError {
+	args := m.Called(data, replace)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) DeleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	args := m.Called(id)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) TestErrorUseCase(ctx context.Context) *model.DomainError {
+	args := m.Called()
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) ReadinessUseCase(ctx context.Context) *model.DomainError {
 	args := m.Called()
 	return args.Get(0).(*model.DomainError)
 }
 
 func TestHandleCommand_Add(t *testing.T) {
 	mockUseCase := new(MockTodoUseCase)
-	adapter := NewTodoCLIAdapter(mockUseCase)
+	adapter := NewTodoCLIAdapter(mockUseCase, nil)
 
 	expectedCmd := command.CreateTodoCommand{
 		Title:       "Test",
 		Description: "Todo",
 		Priority:    "Test",
+		Source:      "cli",
 	}
 
 	mockUseCase.On("CreateTodoUseCase", expectedCmd).Return(model.TodoID("test-id"), (*model.DomainError)(nil))
@@ -74,12 +209,13 @@ func TestHandleCommand_Add(t *testing.T) {
 
 func TestHandleCommand_Add_Error(t *testing.T) {
 	mockUseCase := new(MockTodoUseCase)
-	adapter := NewTodoCLIAdapter(mockUseCase)
+	adapter := NewTodoCLIAdapter(mockUseCase, nil)
 
 	expectedCmd := command.CreateTodoCommand{
 		Title:       "Test",
 		Description: "Todo",
 		Priority:    "medium",
+		Source:      "cli",
 	}
 
 	domainError := model.NewDomainError(1001, 400, "Validation failed", "Title too short", nil)
@@ -92,7 +228,7 @@ func TestHandleCommand_Add_Error(t *testing.T) {
 
 func TestHandleCommand_List_Success(t *testing.T) {
 	mockUseCase := new(MockTodoUseCase)
-	adapter := NewTodoCLIAdapter(mockUseCase)
+	adapter := NewTodoCLIAdapter(mockUseCase, nil)
 
 	todos := []appmodel.TodoResponse{
 		{ID: "1", Title: "Todo 1", Status: "pending", Priority: "high"},
@@ -100,7 +236,7 @@ func TestHandleCommand_List_Success(t *testing.T) {
 	}
 	response := &appmodel.TodoListResponse{Todos: todos, Count: 2}
 
-	mockUseCase.On("ListTodosUseCase").Return(response, (*model.DomainError)(nil))
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{}).Return(response, (*model.DomainError)(nil))
 
 	adapter.handleCommand("list")
 
@@ -109,10 +245,10 @@ func TestHandleCommand_List_Success(t *testing.T) {
 
 func TestHandleCommand_List_Empty(t *testing.T) {
 	mockUseCase := new(MockTodoUseCase)
-	adapter := NewTodoCLIAdapter(mockUseCase)
+	adapter := NewTodoCLIAdapter(mockUseCase, nil)
 
 	response := &appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{}, Count: 0}
-	mockUseCase.On("ListTodosUseCase").Return(response, (*model.DomainError)(nil))
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{}).Return(response, (*model.DomainError)(nil))
 
 	adapter.handleCommand("list")
 
@@ -121,7 +257,7 @@ func TestHandleCommand_List_Empty(t *testing.T) {
 
 func TestHandleCommand_Get_Success(t *testing.T) {
 	mockUseCase := new(MockTodoUseCase)
-	adapter := NewTodoCLIAdapter(mockUseCase)
+	adapter := NewTodoCLIAdapter(mockUseCase, nil)
 
 	todoID := model.TodoID("test-id")
 	todoResponse := &appmodel.TodoResponse{
@@ -141,7 +277,7 @@ func TestHandleCommand_Get_Success(t *testing.T) {
 
 func TestHandleCommand_Update_Success(t *testing.T) {
 	mockUseCase := new(MockTodoUseCase)
-	adapter := NewTodoCLIAdapter(mockUseCase)
+	adapter := NewTodoCLIAdapter(mockUseCase, nil)
 
 	expectedCmd := command.UpdateTodoCommand{
 		ID:          "test-id",
@@ -159,7 +295,7 @@ func TestHandleCommand_Update_Success(t *testing.T) {
 
 func TestHandleCommand_Complete_Success(t *testing.T) {
 	mockUseCase := new(MockTodoUseCase)
-	adapter := NewTodoCLIAdapter(mockUseCase)
+	adapter := NewTodoCLIAdapter(mockUseCase, nil)
 
 	todoID := model.TodoID("test-id")
 	mockUseCase.On("CompleteTodoUseCase", todoID).Return((*model.DomainError)(nil))
@@ -171,7 +307,7 @@ func TestHandleCommand_Complete_Success(t *testing.T) {
 
 func TestHandleCommand_Archive_Success(t *testing.T) {
 	mockUseCase := new(MockTodoUseCase)
-	adapter := NewTodoCLIAdapter(mockUseCase)
+	adapter := NewTodoCLIAdapter(mockUseCase, nil)
 
 	todoID := model.TodoID("test-id")
 	mockUseCase.On("ArchiveTodoUseCase", todoID).Return((*model.DomainError)(nil))
@@ -183,24 +319,64 @@ func TestHandleCommand_Archive_Success(t *testing.T) {
 
 func TestHandleCommand_Empty(t *testing.T) {
 	mockUseCase := new(MockTodoUseCase)
-	adapter := NewTodoCLIAdapter(mockUseCase)
+	adapter := NewTodoCLIAdapter(mockUseCase, nil)
 
 	// Should not call any use case methods
 	adapter.handleCommand("")
 
 	mockUseCase.AssertNotCalled(t, "CreateTodoUseCase")
-	mockUseCase.AssertNotCalled(t, "ListTodosUseCase")
+	mockUseCase.AssertNotCalled(t, "ListTodosUseCase", mock.Anything)
 	mockUseCase.AssertNotCalled(t, "GetTodoUseCase")
 }
 
 func TestHandleCommand_Unknown(t *testing.T) {
 	mockUseCase := new(MockTodoUseCase)
-	adapter := NewTodoCLIAdapter(mockUseCase)
+	adapter := NewTodoCLIAdapter(mockUseCase, nil)
 
 	// Should not call any use case methods
 	adapter.handleCommand("unknown")
 
 	mockUseCase.AssertNotCalled(t, "CreateTodoUseCase")
-	mockUseCase.AssertNotCalled(t, "ListTodosUseCase")
+	mockUseCase.AssertNotCalled(t, "ListTodosUseCase", mock.Anything)
 	mockUseCase.AssertNotCalled(t, "GetTodoUseCase")
 }
+
+// captureStdout returns everything fn prints to stdout
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	fn()
+	require.NoError(t, w.Close())
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func TestHandleCommand_Add_TitleTooLong(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	cfg := config.Default()
+	cfg.MaxTitleLength = 5
+	adapter := NewTodoCLIAdapter(mockUseCase, cfg)
+
+	out := captureStdout(t, func() { adapter.handleCommand("add TooLongTitle") })
+
+	assert.Contains(t, out, "title is 12 characters long; the maximum is 5")
+	mockUseCase.AssertNotCalled(t, "CreateTodoUseCase", mock.Anything)
+}
+
+func TestHandleCommand_Update_TitleTooLong(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	cfg := config.Default()
+	cfg.MaxTitleLength = 5
+	adapter := NewTodoCLIAdapter(mockUseCase, cfg)
+
+	out := captureStdout(t, func() { adapter.handleCommand("update test-id TooLongTitle") })
+
+	assert.Contains(t, out, "the maximum is 5")
+	mockUseCase.AssertNotCalled(t, "UpdateTodoUseCase", mock.Anything)
+}
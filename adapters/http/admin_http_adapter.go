@@ -0,0 +1,131 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+// AdminHTTPAdapter implements the token-guarded admin endpoints using the
+// ReplayUseCasePort and the snapshot use cases of the TodoUseCasePort
+type AdminHTTPAdapter struct {
+	replay port.ReplayUseCasePort
+	todos  port.TodoUseCasePort
+	responder
+}
+
+var _ RouteRegistrar = (*AdminHTTPAdapter)(nil)
+
+// NewAdminHTTPAdapter creates a new admin HTTP handler. replay is nil when no
+// event store is kept, and POST /admin/replay is then not served.
+func NewAdminHTTPAdapter(replay port.ReplayUseCasePort, todos port.TodoUseCasePort, cfg *config.Config) *AdminHTTPAdapter {
+	return &AdminHTTPAdapter{
+		replay:    replay,
+		todos:     todos,
+		responder: responder{config: cfg, logger: slog.Default()},
+	}
+}
+
+// RegisterRoutes adds the admin endpoints, behind the admin token, to the given router
+func (h *AdminHTTPAdapter) RegisterRoutes(r chi.Router) {
+	if h.replay != nil {
+		r.With(h.adminAuthMiddleware).Post("/admin/replay", h.HandleReplayEvents)
+	}
+	r.With(h.adminAuthMiddleware).Get("/admin/snapshot", h.HandleSnapshot)
+	r.With(h.adminAuthMiddleware).Post("/admin/restore", h.HandleRestoreSnapshot)
+}
+
+// HandleReplayEvents handles POST /admin/replay
+// @Summary Replay events
+// @Description Rebuild every registered projection by re-applying all stored events in order
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Token header string true "Admin token"
+// @Success 200 {object} appmodel.ReplayResponse
+// @Failure 401 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /admin/replay [post]
+func (h *AdminHTTPAdapter) HandleReplayEvents(w http.ResponseWriter, r *http.Request) {
+	response, err := h.replay.ReplayEventsUseCase(r.Context())
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// HandleSnapshot handles GET /admin/snapshot
+// @Summary Snapshot all todos
+// @Description Download every todo as a JSON snapshot that can be restored later
+// @Tags admin
+// @Produce json
+// @Param X-Admin-Token header string true "Admin token"
+// @Success 200 {object} appmodel.Snapshot
+// @Failure 401 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /admin/snapshot [get]
+func (h *AdminHTTPAdapter) HandleSnapshot(w http.ResponseWriter, r *http.Request) {
+	data, err := h.todos.SnapshotUseCase(r.Context())
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="todos-snapshot.json"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// HandleRestoreSnapshot handles POST /admin/restore
+// @Summary Restore a snapshot
+// @Description Restore todos from a snapshot, optionally replacing all existing todos
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-Admin-Token header string true "Admin token"
+// @Param replace query bool false "Delete all existing todos before restoring"
+// @Param snapshot body appmodel.Snapshot true "Snapshot to restore"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 401 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /admin/restore [post]
+func (h *AdminHTTPAdapter) HandleRestoreSnapshot(w http.ResponseWriter, r *http.Request) {
+	replace := false
+	if raw := strings.TrimSpace(r.URL.Query().Get("replace")); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			h.writeDomainError(w, r, model.ErrInvalidQueryParam.WithDetails(map[string]string{"param": "replace", "value": raw}))
+			return
+		}
+		replace = parsed
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.writeDomainError(w, r, model.ErrInvalidJSON)
+		return
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		h.writeDomainError(w, r, model.ErrEmptyBody)
+		return
+	}
+
+	if err := h.todos.RestoreSnapshotUseCase(r.Context(), data, replace); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Snapshot restored successfully"})
+}
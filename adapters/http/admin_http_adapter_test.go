@@ -0,0 +1,167 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+type MockReplayUseCase struct {
+	mock.Mock
+}
+
+func (m *MockReplayUseCase) ReplayEventsUseCase(ctx context.Context) (*appmodel.ReplayResponse, *model.DomainError) {
+	args := m.Called()
+	if resp, ok := args.Get(0).(*appmodel.ReplayResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+// newAdminRouter serves the admin routes on the todo router, as main.go does
+func newAdminRouter(replayUseCase *MockReplayUseCase, todoUseCase *MockTodoUseCase, adminToken string) http.Handler {
+	cfg := config.Default()
+	cfg.AdminToken = adminToken
+	todoHandler := NewTodoHTTPAdapter(todoUseCase, cfg)
+	return todoHandler.Router(NewAdminHTTPAdapter(replayUseCase, todoUseCase, cfg))
+}
+
+func TestHandleReplayEvents(t *testing.T) {
+	mockUseCase := new(MockReplayUseCase)
+	mockUseCase.On("ReplayEventsUseCase").Return(&appmodel.ReplayResponse{Replayed: 3, Projections: []string{"search"}}, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("POST", "/admin/replay", nil)
+	req.Header.Set("X-Admin-Token", "secret")
+	w := httptest.NewRecorder()
+	newAdminRouter(mockUseCase, new(MockTodoUseCase), "secret").ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response appmodel.ReplayResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 3, response.Replayed)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleReplayEvents_RequiresAdminToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		sent       string
+	}{
+		{name: "missing token", configured: "secret", sent: ""},
+		{name: "wrong token", configured: "secret", sent: "guess"},
+		{name: "no token configured", configured: "", sent: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockReplayUseCase)
+			req := httptest.NewRequest("POST", "/admin/replay", nil)
+			if tt.sent != "" {
+				req.Header.Set("X-Admin-Token", tt.sent)
+			}
+			w := httptest.NewRecorder()
+			newAdminRouter(mockUseCase, new(MockTodoUseCase), tt.configured).ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			mockUseCase.AssertNotCalled(t, "ReplayEventsUseCase")
+		})
+	}
+}
+
+func TestHandleReplayEvents_NotServedWithoutEventStore(t *testing.T) {
+	cfg := config.Default()
+	cfg.AdminToken = "secret"
+	todoUseCase := new(MockTodoUseCase)
+	router := NewTodoHTTPAdapter(todoUseCase, cfg).Router(NewAdminHTTPAdapter(nil, todoUseCase, cfg))
+
+	req := httptest.NewRequest("POST", "/admin/replay", nil)
+	req.Header.Set("X-Admin-Token", "secret")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandleSnapshot(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	data := []byte(`{"version":1,"todos":[]}`)
+	mockUseCase.On("SnapshotUseCase").Return(data, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/admin/snapshot", nil)
+	req.Header.Set("X-Admin-Token", "secret")
+	w := httptest.NewRecorder()
+	newAdminRouter(new(MockReplayUseCase), mockUseCase, "secret").ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, string(data), w.Body.String())
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment")
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleRestoreSnapshot(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	data := []byte(`{"version":1,"todos":[]}`)
+	mockUseCase.On("RestoreSnapshotUseCase", data, true).Return((*model.DomainError)(nil))
+
+	req := httptest.NewRequest("POST", "/admin/restore?replace=true", bytes.NewBuffer(data))
+	req.Header.Set("X-Admin-Token", "secret")
+	w := httptest.NewRecorder()
+	newAdminRouter(new(MockReplayUseCase), mockUseCase, "secret").ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Snapshot restored successfully")
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleRestoreSnapshot_InvalidReplace(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+
+	req := httptest.NewRequest("POST", "/admin/restore?replace=maybe", bytes.NewBufferString(`{"version":1}`))
+	req.Header.Set("X-Admin-Token", "secret")
+	w := httptest.NewRecorder()
+	newAdminRouter(new(MockReplayUseCase), mockUseCase, "secret").ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockUseCase.AssertNotCalled(t, "RestoreSnapshotUseCase", mock.Anything, mock.Anything)
+}
+
+func TestSnapshotEndpoints_RequireAdminToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		sent   string
+	}{
+		{name: "snapshot without token", method: "GET", target: "/admin/snapshot"},
+		{name: "snapshot with wrong token", method: "GET", target: "/admin/snapshot", sent: "guess"},
+		{name: "restore without token", method: "POST", target: "/admin/restore?replace=true"},
+		{name: "restore with wrong token", method: "POST", target: "/admin/restore?replace=true", sent: "guess"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			req := httptest.NewRequest(tt.method, tt.target, bytes.NewBufferString(`{"version":1,"todos":[]}`))
+			if tt.sent != "" {
+				req.Header.Set("X-Admin-Token", tt.sent)
+			}
+			w := httptest.NewRecorder()
+			newAdminRouter(new(MockReplayUseCase), mockUseCase, "secret").ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			mockUseCase.AssertNotCalled(t, "SnapshotUseCase")
+			mockUseCase.AssertNotCalled(t, "RestoreSnapshotUseCase", mock.Anything, mock.Anything)
+		})
+	}
+}
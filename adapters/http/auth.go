@@ -0,0 +1,38 @@
+package http
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// errInvalidToken is returned by authenticators that reject a bearer token
+var errInvalidToken = errors.New("invalid bearer token")
+
+// Authenticator validates the bearer token of a request and returns the user it
+// identifies, or an empty UserID when the token is valid but not tied to a user
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (model.UserID, error)
+}
+
+// StaticTokenAuthenticator accepts a single shared token
+type StaticTokenAuthenticator struct {
+	token string
+}
+
+var _ Authenticator = (*StaticTokenAuthenticator)(nil)
+
+// NewStaticTokenAuthenticator creates an authenticator accepting only token
+func NewStaticTokenAuthenticator(token string) *StaticTokenAuthenticator {
+	return &StaticTokenAuthenticator{token: token}
+}
+
+// Authenticate compares the token in constant time; the shared token identifies no user
+func (a *StaticTokenAuthenticator) Authenticate(ctx context.Context, token string) (model.UserID, error) {
+	if subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+		return "", errInvalidToken
+	}
+	return "", nil
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+// defaultBootstrapPageSize is the number of todos returned by GET /bootstrap without a limit
+const defaultBootstrapPageSize = 20
+
+// BootstrapHTTPAdapter serves a client's initial state in one call using the BootstrapUseCasePort
+type BootstrapHTTPAdapter struct {
+	bootstrap port.BootstrapUseCasePort
+	responder
+}
+
+var _ RouteRegistrar = (*BootstrapHTTPAdapter)(nil)
+
+// NewBootstrapHTTPAdapter creates a new bootstrap HTTP handler
+func NewBootstrapHTTPAdapter(bootstrap port.BootstrapUseCasePort, cfg *config.Config) *BootstrapHTTPAdapter {
+	return &BootstrapHTTPAdapter{
+		bootstrap: bootstrap,
+		responder: responder{config: cfg, logger: slog.Default()},
+	}
+}
+
+// RegisterRoutes adds the bootstrap endpoint to the given router
+func (h *BootstrapHTTPAdapter) RegisterRoutes(r chi.Router) {
+	r.Get("/bootstrap", h.HandleBootstrap)
+}
+
+// HandleBootstrap handles GET /bootstrap
+// @Summary Bootstrap
+// @Description Get the first page of todos, every category and the todo counts per status in one call. Todos and counts are limited to the authenticated user when the bearer token identifies one.
+// @Tags bootstrap
+// @Produce json
+// @Param limit query int false "Maximum number of todos to return" default(20)
+// @Success 200 {object} appmodel.BootstrapResponse
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /bootstrap [get]
+func (h *BootstrapHTTPAdapter) HandleBootstrap(w http.ResponseWriter, r *http.Request) {
+	limit, err := parseIntParam(r, "limit", defaultBootstrapPageSize, 1, maxPageSize)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	response, err := h.bootstrap.BootstrapUseCase(r.Context(), limit)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
+}
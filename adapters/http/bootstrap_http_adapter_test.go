@@ -0,0 +1,94 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+type MockBootstrapUseCase struct {
+	mock.Mock
+}
+
+func (m *MockBootstrapUseCase) BootstrapUseCase(ctx context.Context, limit int) (*appmodel.BootstrapResponse, *model.DomainError) {
+	user, _ := port.AuthenticatedUserFromContext(ctx)
+	args := m.Called(user, limit)
+	if resp, ok := args.Get(0).(*appmodel.BootstrapResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+// userAuthenticator maps bearer tokens to the users they identify
+type userAuthenticator map[string]model.UserID
+
+func (a userAuthenticator) Authenticate(ctx context.Context, token string) (model.UserID, error) {
+	user, ok := a[token]
+	if !ok {
+		return "", errInvalidToken
+	}
+	return user, nil
+}
+
+func TestHandleBootstrap(t *testing.T) {
+	mockUseCase := new(MockBootstrapUseCase)
+	mockUseCase.On("BootstrapUseCase", model.UserID(""), defaultBootstrapPageSize).Return(&appmodel.BootstrapResponse{
+		Todos:      appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{{ID: "todo-1"}}, Count: 1, Total: 1},
+		Categories: appmodel.CategoryListResponse{Categories: []appmodel.CategoryResponse{{ID: "cat-1"}}, Count: 1},
+		Counts:     appmodel.StatusCountsResponse{Total: 1, Pending: 1},
+	}, (*model.DomainError)(nil))
+	cfg := config.Default()
+	router := NewTodoHTTPAdapter(new(MockTodoUseCase), cfg).Router(NewBootstrapHTTPAdapter(mockUseCase, cfg))
+
+	req := httptest.NewRequest("GET", "/bootstrap", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response appmodel.BootstrapResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "todo-1", response.Todos.Todos[0].ID)
+	assert.Equal(t, "cat-1", response.Categories.Categories[0].ID)
+	assert.Equal(t, 1, response.Counts.Pending)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleBootstrap_PassesAuthenticatedUser(t *testing.T) {
+	mockUseCase := new(MockBootstrapUseCase)
+	mockUseCase.On("BootstrapUseCase", model.UserID("alice"), 5).Return(&appmodel.BootstrapResponse{}, (*model.DomainError)(nil))
+	cfg := config.Default()
+	todoHandler := NewTodoHTTPAdapter(new(MockTodoUseCase), cfg)
+	todoHandler.UseAuthenticator(userAuthenticator{"alice-token": "alice"})
+	router := todoHandler.Router(NewBootstrapHTTPAdapter(mockUseCase, cfg))
+
+	req := httptest.NewRequest("GET", "/bootstrap?limit=5", nil)
+	req.Header.Set("Authorization", "Bearer alice-token")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleBootstrap_InvalidLimit(t *testing.T) {
+	mockUseCase := new(MockBootstrapUseCase)
+	cfg := config.Default()
+	router := NewTodoHTTPAdapter(new(MockTodoUseCase), cfg).Router(NewBootstrapHTTPAdapter(mockUseCase, cfg))
+
+	req := httptest.NewRequest("GET", "/bootstrap?limit=abc", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockUseCase.AssertNotCalled(t, "BootstrapUseCase", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,152 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+// CategoryHTTPAdapter implements HTTP endpoints using the CategoryUseCasePort
+type CategoryHTTPAdapter struct {
+	usecase port.CategoryUseCasePort
+	responder
+}
+
+var _ RouteRegistrar = (*CategoryHTTPAdapter)(nil)
+
+// NewCategoryHTTPAdapter creates a new Category HTTP handler
+func NewCategoryHTTPAdapter(usecase port.CategoryUseCasePort, cfg *config.Config) *CategoryHTTPAdapter {
+	return &CategoryHTTPAdapter{
+		usecase:   usecase,
+		responder: responder{config: cfg, logger: slog.Default()},
+	}
+}
+
+// RegisterRoutes adds the category endpoints to the given router
+func (h *CategoryHTTPAdapter) RegisterRoutes(r chi.Router) {
+	r.Get("/categories", h.HandleListCategories)
+	r.Post("/categories", h.HandleCreateCategory)
+	r.Get("/categories/{id}", h.HandleGetCategory)
+	r.Put("/categories/{id}", h.HandleUpdateCategory)
+	r.Delete("/categories/{id}", h.HandleDeleteCategory)
+}
+
+// HandleListCategories handles GET /categories
+// @Summary List all categories
+// @Description Get all categories
+// @Tags categories
+// @Produce json
+// @Success 200 {object} appmodel.CategoryListResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /categories [get]
+func (h *CategoryHTTPAdapter) HandleListCategories(w http.ResponseWriter, r *http.Request) {
+	response, err := h.usecase.ListCategoriesUseCase()
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// HandleCreateCategory handles POST /categories
+// @Summary Create a new category
+// @Description Create a new category with one of the supported colors
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param category body command.CreateCategoryCommand true "Category to create"
+// @Success 201 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /categories [post]
+func (h *CategoryHTTPAdapter) HandleCreateCategory(w http.ResponseWriter, r *http.Request) {
+	var cmd command.CreateCategoryCommand
+	if err := h.parseJSON(r, &cmd); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	id, err := h.usecase.CreateCategoryUseCase(cmd)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusCreated, map[string]string{"id": string(id)})
+}
+
+// HandleGetCategory handles GET /categories/{id}
+// @Summary Get a category
+// @Description Get a category by ID
+// @Tags categories
+// @Produce json
+// @Param id path string true "Category ID"
+// @Success 200 {object} appmodel.CategoryResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Router /categories/{id} [get]
+func (h *CategoryHTTPAdapter) HandleGetCategory(w http.ResponseWriter, r *http.Request) {
+	response, err := h.usecase.GetCategoryUseCase(model.CategoryID(chi.URLParam(r, "id")))
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// HandleUpdateCategory handles PUT /categories/{id}
+// @Summary Update a category
+// @Description Update a category; omitted fields are left unchanged
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID"
+// @Param category body command.UpdateCategoryCommand true "Category updates"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /categories/{id} [put]
+func (h *CategoryHTTPAdapter) HandleUpdateCategory(w http.ResponseWriter, r *http.Request) {
+	var cmd command.UpdateCategoryCommand
+	if err := h.parseJSON(r, &cmd); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	cmd.ID = chi.URLParam(r, "id")
+	if err := h.usecase.UpdateCategoryUseCase(cmd); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Category updated successfully"})
+}
+
+// HandleDeleteCategory handles DELETE /categories/{id}
+// @Summary Delete a category
+// @Description Delete a category; default categories cannot be deleted
+// @Tags categories
+// @Produce json
+// @Param id path string true "Category ID"
+// @Success 200 {object} map[string]string
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Failure 409 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /categories/{id} [delete]
+func (h *CategoryHTTPAdapter) HandleDeleteCategory(w http.ResponseWriter, r *http.Request) {
+	if err := h.usecase.DeleteCategoryUseCase(model.CategoryID(chi.URLParam(r, "id"))); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Category deleted successfully"})
+}
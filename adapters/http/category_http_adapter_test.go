@@ -0,0 +1,118 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+type MockCategoryUseCase struct {
+	mock.Mock
+}
+
+func (m *MockCategoryUseCase) CreateCategoryUseCase(cmd command.CreateCategoryCommand) (model.CategoryID, *model.DomainError) {
+	args := m.Called(cmd)
+	return args.Get(0).(model.CategoryID), args.Get(1).(*model.DomainError)
+}
+
+func (m *MockCategoryUseCase) UpdateCategoryUseCase(cmd command.UpdateCategoryCommand) *model.DomainError {
+	args := m.Called(cmd)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockCategoryUseCase) GetCategoryUseCase(id model.CategoryID) (*appmodel.CategoryResponse, *model.DomainError) {
+	args := m.Called(id)
+	if resp, ok := args.Get(0).(*appmodel.CategoryResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockCategoryUseCase) ListCategoriesUseCase() (*appmodel.CategoryListResponse, *model.DomainError) {
+	args := m.Called()
+	if resp, ok := args.Get(0).(*appmodel.CategoryListResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockCategoryUseCase) DeleteCategoryUseCase(id model.CategoryID) *model.DomainError {
+	args := m.Called(id)
+	return args.Get(0).(*model.DomainError)
+}
+
+// newCategoryRouter serves the category routes on the todo router, as main.go does
+func newCategoryRouter(categoryUseCase *MockCategoryUseCase) http.Handler {
+	cfg := config.Default()
+	todoHandler := NewTodoHTTPAdapter(new(MockTodoUseCase), cfg)
+	return todoHandler.Router(NewCategoryHTTPAdapter(categoryUseCase, cfg))
+}
+
+func TestHandleCreateCategory(t *testing.T) {
+	cmd := command.CreateCategoryCommand{Name: "Work", Color: "magenta"}
+	mockUseCase := new(MockCategoryUseCase)
+	mockUseCase.On("CreateCategoryUseCase", cmd).Return(model.CategoryID(""), model.ErrInvalidCategoryColor.WithDetails(map[string]string{"allowed": "red"}))
+
+	body, _ := json.Marshal(cmd)
+	w := httptest.NewRecorder()
+	newCategoryRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("POST", "/categories", bytes.NewBuffer(body)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var result appmodel.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, model.ErrInvalidCategoryColor.GetErrorCode(), result.ErrorCode)
+	assert.Equal(t, "red", result.Details["allowed"])
+}
+
+func TestHandleListAndGetCategories(t *testing.T) {
+	category := appmodel.CategoryResponse{ID: "cat-1", Name: "Work", Color: "blue"}
+	mockUseCase := new(MockCategoryUseCase)
+	mockUseCase.On("ListCategoriesUseCase").Return(&appmodel.CategoryListResponse{Categories: []appmodel.CategoryResponse{category}, Count: 1}, (*model.DomainError)(nil))
+	mockUseCase.On("GetCategoryUseCase", model.CategoryID("cat-1")).Return(&category, (*model.DomainError)(nil))
+	router := newCategoryRouter(mockUseCase)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/categories", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var list appmodel.CategoryListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+	assert.Equal(t, 1, list.Count)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/categories/cat-1", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var got appmodel.CategoryResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Equal(t, "Work", got.Name)
+}
+
+func TestHandleUpdateCategory(t *testing.T) {
+	mockUseCase := new(MockCategoryUseCase)
+	mockUseCase.On("UpdateCategoryUseCase", command.UpdateCategoryCommand{ID: "cat-1", Name: "Home"}).Return((*model.DomainError)(nil))
+
+	w := httptest.NewRecorder()
+	newCategoryRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("PUT", "/categories/cat-1", bytes.NewBufferString(`{"name":"Home"}`)))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleDeleteCategory_Default(t *testing.T) {
+	mockUseCase := new(MockCategoryUseCase)
+	mockUseCase.On("DeleteCategoryUseCase", model.CategoryID("cat-1")).Return(model.ErrCannotDeleteDefaultCategory)
+
+	w := httptest.NewRecorder()
+	newCategoryRouter(mockUseCase).ServeHTTP(w, httptest.NewRequest("DELETE", "/categories/cat-1", nil))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
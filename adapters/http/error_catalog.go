@@ -0,0 +1,73 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// Output formats supported by the error catalog endpoint
+const (
+	catalogFormatJSON = "json"
+	catalogFormatTS   = "ts"
+)
+
+// ErrorCatalogResponse lists every domain error the API can return
+type ErrorCatalogResponse struct {
+	Errors []model.DomainErrorResponse `json:"errors"`
+}
+
+// HandleGetErrorCatalog handles GET /errors and GET /errors.ts
+// @Summary Get the domain error catalog
+// @Description Lists every domain error code and message, as JSON or as a TypeScript module for client codegen
+// @Tags errors
+// @Produce json
+// @Produce plain
+// @Param format query string false "Output format (json or ts)"
+// @Success 200 {object} ErrorCatalogResponse
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Router /errors [get]
+func (h *TodoHTTPAdapter) HandleGetErrorCatalog(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = catalogFormatJSON
+		if r.URL.Path == "/errors.ts" {
+			format = catalogFormatTS
+		}
+	}
+
+	catalog := model.ErrorCatalog()
+	switch format {
+	case catalogFormatJSON:
+		response := ErrorCatalogResponse{Errors: make([]model.DomainErrorResponse, len(catalog))}
+		for i, e := range catalog {
+			response.Errors[i] = e.ToResponse()
+		}
+		h.writeJSONResponse(w, http.StatusOK, response)
+	case catalogFormatTS:
+		w.Header().Set("Content-Type", "text/typescript; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="errors.ts"`)
+		w.WriteHeader(http.StatusOK)
+		writeTypeScriptCatalog(w, catalog)
+	default:
+		h.writeDomainError(w, r, model.ErrUnsupportedFormat)
+	}
+}
+
+// writeTypeScriptCatalog renders the catalog as a const map of code to message
+func writeTypeScriptCatalog(w io.Writer, catalog []*model.DomainError) {
+	fmt.Fprintln(w, "// Code generated by the Todo API. DO NOT EDIT.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "export const ErrorMessages = {")
+	for _, e := range catalog {
+		// JSON string literals are valid TypeScript string literals
+		message, _ := json.Marshal(e.GetErrorMessage())
+		fmt.Fprintf(w, "  %d: %s,\n", e.GetErrorCode(), message)
+	}
+	fmt.Fprintln(w, "} as const;")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "export type ErrorCode = keyof typeof ErrorMessages;")
+}
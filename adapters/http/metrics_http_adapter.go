@@ -0,0 +1,73 @@
+package http
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Content types of the supported metrics formats
+const (
+	contentTypePrometheus  = "text/plain; version=0.0.4; charset=utf-8"
+	contentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// MetricsExporter writes collected metrics in the Prometheus text exposition
+// format or in the OpenMetrics text format
+type MetricsExporter interface {
+	WritePrometheus(w io.Writer) error
+	WriteOpenMetrics(w io.Writer) error
+}
+
+// MetricsHTTPAdapter serves collected metrics for scraping
+type MetricsHTTPAdapter struct {
+	exporter MetricsExporter
+}
+
+var _ RouteRegistrar = (*MetricsHTTPAdapter)(nil)
+
+// NewMetricsHTTPAdapter creates a new metrics HTTP handler
+func NewMetricsHTTPAdapter(exporter MetricsExporter) *MetricsHTTPAdapter {
+	return &MetricsHTTPAdapter{exporter: exporter}
+}
+
+// RegisterRoutes adds the metrics endpoint to the given router
+func (h *MetricsHTTPAdapter) RegisterRoutes(r chi.Router) {
+	r.Get("/metrics", h.HandleMetrics)
+}
+
+// HandleMetrics handles GET /metrics
+// @Summary Metrics
+// @Description Use case and repository call counts and latency histograms in the Prometheus text format, or in the OpenMetrics text format when the Accept header prefers application/openmetrics-text
+// @Tags metrics
+// @Produce plain
+// @Produce application/openmetrics-text
+// @Success 200 {string} string
+// @Router /metrics [get]
+func (h *MetricsHTTPAdapter) HandleMetrics(w http.ResponseWriter, r *http.Request) {
+	if prefersOpenMetrics(r.Header.Get("Accept")) {
+		w.Header().Set("Content-Type", contentTypeOpenMetrics)
+		w.WriteHeader(http.StatusOK)
+		_ = h.exporter.WriteOpenMetrics(w)
+		return
+	}
+	w.Header().Set("Content-Type", contentTypePrometheus)
+	w.WriteHeader(http.StatusOK)
+	_ = h.exporter.WritePrometheus(w)
+}
+
+// prefersOpenMetrics reports whether the Accept header ranks OpenMetrics above
+// the Prometheus text format. A missing header, text/plain and wildcards keep
+// the Prometheus format, which every scraper understands.
+func prefersOpenMetrics(accept string) bool {
+	for _, mr := range parseAccept(accept) {
+		switch mr.mediaType {
+		case "application/openmetrics-text":
+			return true
+		case "text/plain", "text/*", "*/*":
+			return false
+		}
+	}
+	return false
+}
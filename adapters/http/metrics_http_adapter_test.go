@@ -0,0 +1,72 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+type stubMetricsExporter string
+
+func (s stubMetricsExporter) WritePrometheus(w io.Writer) error {
+	_, err := io.WriteString(w, string(s))
+	return err
+}
+
+func (s stubMetricsExporter) WriteOpenMetrics(w io.Writer) error {
+	_, err := io.WriteString(w, string(s)+"# EOF\n")
+	return err
+}
+
+func TestHandleMetrics(t *testing.T) {
+	todoHandler := NewTodoHTTPAdapter(new(MockTodoUseCase), config.Default())
+	router := todoHandler.Router(NewMetricsHTTPAdapter(stubMetricsExporter("todo_usecase_calls_total 1\n")))
+
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "todo_usecase_calls_total 1\n", w.Body.String())
+}
+
+func TestHandleMetrics_OpenMetrics(t *testing.T) {
+	exporter := stubMetricsExporter("# TYPE todo_usecase_calls counter\ntodo_usecase_calls_total{outcome=\"success\"} 1\n")
+	todoHandler := NewTodoHTTPAdapter(new(MockTodoUseCase), config.Default())
+	router := todoHandler.Router(NewMetricsHTTPAdapter(exporter))
+
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/openmetrics-text; version=1.0.0; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "# TYPE todo_usecase_calls counter\ntodo_usecase_calls_total{outcome=\"success\"} 1\n# EOF\n", w.Body.String())
+}
+
+func TestPrefersOpenMetrics(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{"", false},
+		{"text/plain", false},
+		{"*/*", false},
+		{"application/openmetrics-text", true},
+		{"application/openmetrics-text;version=1.0.0;q=0.75,text/plain;version=0.0.4;q=0.5,*/*;q=0.1", true},
+		{"text/plain;q=0.9,application/openmetrics-text;q=0.5", false},
+		{"application/openmetrics-text;q=0", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			assert.Equal(t, tt.want, prefersOpenMetrics(tt.accept))
+		})
+	}
+}
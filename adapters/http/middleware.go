@@ -0,0 +1,384 @@
+package http
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/xml"
+	"log/slog"
+	"mime"
+	"net/http"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// Response formats supported by the HTTP adapter
+const (
+	formatJSON = "json"
+	formatXML  = "xml"
+)
+
+// consistencyHeader lets a client ask for reads that observe its own writes
+const consistencyHeader = "X-Consistency"
+
+// adminTokenHeader carries the shared secret checked by adminAuthMiddleware
+const adminTokenHeader = "X-Admin-Token"
+
+// requestIDHeader is the canonical header the request ID is echoed under
+const requestIDHeader = "X-Request-ID"
+
+type requestIDContextKey struct{}
+
+// RequestIDFromContext returns the request ID assigned by the request ID middleware
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
+
+// requestIDMiddleware reads the request ID from the first configured inbound header
+// that is present, generating one when none is, and echoes it under X-Request-ID
+func (h *TodoHTTPAdapter) requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var id string
+		for _, header := range h.config.RequestIDHeader {
+			if id = strings.TrimSpace(r.Header.Get(header)); id != "" {
+				break
+			}
+		}
+		if id == "" {
+			id = uuid.New().String()
+		}
+
+		w.Header().Set(requestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDContextKey{}, id)))
+	})
+}
+
+// statusRecorder captures the status code a handler writes so it can be logged
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the status before passing it on
+func (rec *statusRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+// Write records the implicit 200 of a handler that writes without calling WriteHeader
+func (rec *statusRecorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	return rec.ResponseWriter.Write(b)
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController
+func (rec *statusRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
+
+// requestLoggingMiddleware logs the method, path, status, duration and request ID of every
+// request, at warn for 4xx and error for 5xx responses. It must run after requestIDMiddleware.
+func (h *TodoHTTPAdapter) requestLoggingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+
+		status := rec.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+		h.logger.LogAttrs(r.Context(), level, "http request",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.Int("status", status),
+			slog.Duration("duration", time.Since(start)),
+			slog.String("request_id", RequestIDFromContext(r.Context())),
+		)
+	})
+}
+
+// recoveryMiddleware turns a handler panic into an ErrInternalServer response and logs the
+// stack trace. http.ErrAbortHandler is re-raised so deliberately aborted responses stay aborted.
+func (h *TodoHTTPAdapter) recoveryMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+			h.logger.ErrorContext(r.Context(), "http handler panicked",
+				slog.Any("panic", recovered),
+				slog.String("request_id", RequestIDFromContext(r.Context())),
+				slog.String("stack", string(debug.Stack())),
+			)
+			h.writeDomainError(w, r, model.ErrInternalServer)
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// consistencyMiddleware applies the read consistency requested with X-Consistency
+// (strong or eventual) to the request context; reads are eventual when it is absent
+func (h *TodoHTTPAdapter) consistencyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw := strings.TrimSpace(r.Header.Get(consistencyHeader))
+		if raw == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		consistency := port.ReadConsistency(strings.ToLower(raw))
+		if !consistency.IsValid() {
+			h.writeDomainError(w, r, model.ErrInvalidHeader.WithDetails(map[string]string{
+				"header":    consistencyHeader,
+				"value":     raw,
+				"supported": "strong, eventual",
+			}))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(port.WithReadConsistency(r.Context(), consistency)))
+	})
+}
+
+// contentNegotiationMiddleware rejects requests whose Accept header excludes every supported format
+func (h *TodoHTTPAdapter) contentNegotiationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := negotiateFormat(r.Header.Get("Accept")); !ok {
+			h.writeDomainError(w, r, model.ErrNotAcceptable)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// corsMiddleware adds CORS headers for the configured allowed origins and answers preflight
+// requests with 204. Custom response headers are exposed to allowed origins only; with no
+// origins configured no CORS header is sent.
+func (h *TodoHTTPAdapter) corsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" || len(h.config.CORSAllowedOrigins) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		allowed := h.allowedOrigin(origin)
+		if allowed != "" {
+			w.Header().Set("Access-Control-Allow-Origin", allowed)
+			if len(h.config.CORSExposedHeaders) > 0 {
+				w.Header().Set("Access-Control-Expose-Headers", strings.Join(h.config.CORSExposedHeaders, ", "))
+			}
+		}
+		if allowed != "*" {
+			w.Header().Add("Vary", "Origin")
+		}
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			if allowed != "" {
+				w.Header().Set("Access-Control-Allow-Methods", strings.Join(h.config.CORSAllowedMethods, ", "))
+				w.Header().Set("Access-Control-Allow-Headers", strings.Join(h.config.CORSAllowedHeaders, ", "))
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allowedOrigin returns the Access-Control-Allow-Origin value for origin, or "" when it is not allowed
+func (h *TodoHTTPAdapter) allowedOrigin(origin string) string {
+	for _, allowed := range h.config.CORSAllowedOrigins {
+		if allowed == "*" {
+			return "*"
+		}
+		if strings.EqualFold(allowed, origin) {
+			return origin
+		}
+	}
+	return ""
+}
+
+// urlGuardMiddleware rejects over-long URLs with 414 and paths, and so path parameters,
+// containing control characters
+func (h *TodoHTTPAdapter) urlGuardMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uri := r.RequestURI
+		if uri == "" {
+			uri = r.URL.RequestURI()
+		}
+		if h.config.MaxURLLength > 0 && len(uri) > h.config.MaxURLLength {
+			h.writeDomainError(w, r, model.ErrURITooLong.WithDetails(map[string]string{
+				"length": strconv.Itoa(len(uri)),
+				"max":    strconv.Itoa(h.config.MaxURLLength),
+			}))
+			return
+		}
+
+		if strings.IndexFunc(r.URL.Path, unicode.IsControl) >= 0 {
+			h.writeDomainError(w, r, model.ErrInvalidPathParam.WithDetails(map[string]string{
+				"path": strconv.QuoteToASCII(r.URL.Path),
+			}))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// publicPaths are served without authentication; paths ending in "/" match as prefixes
+var publicPaths = []string{"/swagger/", "/test-error", "/healthz", "/readyz"}
+
+// isPublicPath reports whether path is exempt from bearer authentication
+func isPublicPath(path string) bool {
+	for _, public := range publicPaths {
+		if path == public || (strings.HasSuffix(public, "/") && strings.HasPrefix(path, public)) {
+			return true
+		}
+	}
+	return false
+}
+
+// bearerAuthMiddleware rejects requests to non-public paths whose Authorization header
+// does not carry a bearer token accepted by the adapter's authenticator
+func (h *TodoHTTPAdapter) bearerAuthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isPublicPath(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		scheme, token, found := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") {
+			h.writeUnauthorized(w, r)
+			return
+		}
+		user, err := h.authenticator.Authenticate(r.Context(), strings.TrimSpace(token))
+		if err != nil {
+			h.writeUnauthorized(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(port.WithAuthenticatedUser(r.Context(), user)))
+	})
+}
+
+// writeUnauthorized responds 401 with a bearer challenge
+func (h *TodoHTTPAdapter) writeUnauthorized(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	h.writeDomainError(w, r, model.ErrUnauthorized)
+}
+
+// adminAuthMiddleware rejects requests whose X-Admin-Token does not match the configured
+// admin token. Guarded routes are unreachable while no token is configured.
+func (h *responder) adminAuthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(adminTokenHeader)
+		if h.config.AdminToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.config.AdminToken)) != 1 {
+			h.writeDomainError(w, r, model.ErrAdminAuthRequired)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// negotiateFormat picks the response format from an Accept header, preferring
+// higher quality values and then header order. JSON is used for a missing
+// header and for wildcards. The boolean is false when no format is acceptable.
+func negotiateFormat(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return formatJSON, true
+	}
+
+	for _, mr := range parseAccept(accept) {
+		switch mr.mediaType {
+		case "application/json", "application/*", "*/*":
+			return formatJSON, true
+		case "application/xml", "text/xml":
+			return formatXML, true
+		}
+	}
+	return formatJSON, false
+}
+
+// mediaRange is one entry of an Accept header
+type mediaRange struct {
+	mediaType string
+	quality   float64
+}
+
+// parseAccept returns the acceptable media ranges of an Accept header, highest
+// quality first and in header order among equal qualities. Malformed entries
+// and entries with quality 0 are dropped.
+func parseAccept(accept string) []mediaRange {
+	var ranges []mediaRange
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if weight, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = weight
+			}
+		}
+		if quality > 0 {
+			ranges = append(ranges, mediaRange{mediaType: mediaType, quality: quality})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+	return ranges
+}
+
+// xmlMap encodes a flat string map as <response><key>value</key></response>,
+// since encoding/xml cannot marshal maps directly
+type xmlMap map[string]string
+
+// MarshalXML implements xml.Marshaler
+func (m xmlMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "response"}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := encodeXMLFields(e, m); err != nil {
+		return err
+	}
+	return e.EncodeToken(start.End())
+}
+
+// encodeXMLFields writes each map entry as an element, in key order for stable output
+func encodeXMLFields(e *xml.Encoder, fields map[string]string) error {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := e.EncodeElement(fields[key], xml.StartElement{Name: xml.Name{Local: key}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
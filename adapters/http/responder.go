@@ -0,0 +1,119 @@
+package http
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+// responder holds the response and request-body helpers shared by the HTTP adapters
+type responder struct {
+	config *config.Config
+	// logger receives one line per request from requestLoggingMiddleware and the
+	// real message of every error sanitized for production
+	logger *slog.Logger
+}
+
+// writeResponse writes a response in the format negotiated from the request's Accept header
+func (h *responder) writeResponse(w http.ResponseWriter, r *http.Request, statusCode int, data interface{}) {
+	if format, _ := negotiateFormat(r.Header.Get("Accept")); format == formatXML {
+		h.writeXMLResponse(w, statusCode, data)
+		return
+	}
+	h.writeJSONResponse(w, statusCode, data)
+}
+
+// writeJSONResponse writes a JSON response with the given status code
+func (h *responder) writeJSONResponse(w http.ResponseWriter, statusCode int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(data)
+}
+
+// writeXMLResponse writes an XML response with the given status code
+func (h *responder) writeXMLResponse(w http.ResponseWriter, statusCode int, data interface{}) {
+	if fields, ok := data.(map[string]string); ok {
+		data = xmlMap(fields)
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	w.Write([]byte(xml.Header))
+	xml.NewEncoder(w).Encode(data)
+}
+
+// writeDomainError writes a domain error in the negotiated response format
+func (h *responder) writeDomainError(w http.ResponseWriter, r *http.Request, err model.DomainErrorPort) {
+	errorResponse := err.ToResponse()
+	if message, ok := h.publicErrorMessage(err); ok {
+		h.logger.ErrorContext(r.Context(), "domain error sanitized",
+			slog.Int("error_code", err.GetErrorCode()),
+			slog.String("error_message", err.GetErrorMessage()),
+			slog.String("internal_reason", err.GetInternalReason()),
+			slog.Any("details", err.GetDetails()),
+			slog.String("request_id", RequestIDFromContext(r.Context())),
+		)
+		errorResponse.ErrorMessage = message
+		errorResponse.Details = nil
+	}
+	w.Header().Set("X-Error-Type", "domain-error")
+	h.setRetryAfter(w, err.GetHttpStatus())
+	h.writeResponse(w, r, err.GetHttpStatus(), errorResponse)
+}
+
+// publicErrorMessage returns the generic message to show instead of err's own
+// in production, where the messages and details of listed errors are not exposed
+func (h *responder) publicErrorMessage(err model.DomainErrorPort) (string, bool) {
+	if h.config.Environment != config.EnvironmentProduction {
+		return "", false
+	}
+	message, ok := h.config.PublicErrorMessages[err.GetErrorCode()]
+	return message, ok
+}
+
+// retryableStatuses are the statuses that always carry a Retry-After hint
+var retryableStatuses = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// setRetryAfter adds the configured Retry-After default to retryable statuses,
+// keeping any more precise value a middleware has already set
+func (h *responder) setRetryAfter(w http.ResponseWriter, statusCode int) {
+	if !retryableStatuses[statusCode] || w.Header().Get("Retry-After") != "" {
+		return
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(h.config.RetryAfterSeconds))
+}
+
+// parseJSON parses JSON from request body, mapping decode failures to distinct domain errors
+func (h *responder) parseJSON(r *http.Request, v interface{}) *model.DomainError {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err == nil {
+		return nil
+	}
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.Is(err, io.EOF):
+		return model.ErrEmptyBody
+	case errors.As(err, &syntaxErr), errors.Is(err, io.ErrUnexpectedEOF):
+		return model.ErrMalformedJSON
+	case errors.As(err, &typeErr):
+		return model.ErrJSONTypeMismatch.WithDetails(map[string]string{
+			"field":    typeErr.Field,
+			"expected": typeErr.Type.String(),
+			"actual":   typeErr.Value,
+		})
+	default:
+		return model.ErrInvalidJSON
+	}
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+// TodoDependencyHTTPAdapter serves todo dependency endpoints using the TodoDependencyUseCasePort
+type TodoDependencyHTTPAdapter struct {
+	dependencies port.TodoDependencyUseCasePort
+	responder
+}
+
+var _ RouteRegistrar = (*TodoDependencyHTTPAdapter)(nil)
+
+// NewTodoDependencyHTTPAdapter creates a new todo dependency HTTP handler
+func NewTodoDependencyHTTPAdapter(dependencies port.TodoDependencyUseCasePort, cfg *config.Config) *TodoDependencyHTTPAdapter {
+	return &TodoDependencyHTTPAdapter{
+		dependencies: dependencies,
+		responder:    responder{config: cfg, logger: slog.Default()},
+	}
+}
+
+// RegisterRoutes adds the dependency endpoints to the given router
+func (h *TodoDependencyHTTPAdapter) RegisterRoutes(r chi.Router) {
+	r.Get("/todos/actionable", h.HandleListActionableTodos)
+	r.Post("/todos/{id}/dependencies", h.HandleAddDependency)
+	r.Delete("/todos/{id}/dependencies/{dependsOn}", h.HandleRemoveDependency)
+}
+
+// HandleListActionableTodos handles GET /todos/actionable
+// @Summary List actionable todos
+// @Description List the pending todos whose dependencies are all completed
+// @Tags todos
+// @Produce json
+// @Success 200 {object} appmodel.TodoListResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/actionable [get]
+func (h *TodoDependencyHTTPAdapter) HandleListActionableTodos(w http.ResponseWriter, r *http.Request) {
+	response, err := h.dependencies.ListActionableTodosUseCase(r.Context())
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// HandleAddDependency handles POST /todos/{id}/dependencies
+// @Summary Add a dependency
+// @Description Block a todo until another todo is completed. A todo cannot depend on itself or on a todo that depends on it.
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param dependency body command.AddDependencyCommand true "ID of the todo to depend on"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Failure 409 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/{id}/dependencies [post]
+func (h *TodoDependencyHTTPAdapter) HandleAddDependency(w http.ResponseWriter, r *http.Request) {
+	var cmd command.AddDependencyCommand
+	if err := h.parseJSON(r, &cmd); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+	dependsOn := strings.TrimSpace(cmd.DependsOn)
+	if dependsOn == "" {
+		h.writeDomainError(w, r, model.ErrInvalidDependency)
+		return
+	}
+
+	id := model.TodoID(chi.URLParam(r, "id"))
+	if err := h.dependencies.AddDependencyUseCase(r.Context(), id, model.TodoID(dependsOn)); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Dependency added successfully"})
+}
+
+// HandleRemoveDependency handles DELETE /todos/{id}/dependencies/{dependsOn}
+// @Summary Remove a dependency
+// @Description Stop a todo from waiting on another todo
+// @Tags todos
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Param dependsOn path string true "ID of the todo it depends on"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/{id}/dependencies/{dependsOn} [delete]
+func (h *TodoDependencyHTTPAdapter) HandleRemoveDependency(w http.ResponseWriter, r *http.Request) {
+	id := model.TodoID(chi.URLParam(r, "id"))
+	dependsOn := model.TodoID(chi.URLParam(r, "dependsOn"))
+	if err := h.dependencies.RemoveDependencyUseCase(r.Context(), id, dependsOn); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Dependency removed successfully"})
+}
@@ -0,0 +1,123 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+type MockTodoDependencyUseCase struct {
+	mock.Mock
+}
+
+func (m *MockTodoDependencyUseCase) AddDependencyUseCase(ctx context.Context, id, dependsOn model.TodoID) *model.DomainError {
+	args := m.Called(id, dependsOn)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoDependencyUseCase) RemoveDependencyUseCase(ctx context.Context, id, dependsOn model.TodoID) *model.DomainError {
+	args := m.Called(id, dependsOn)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoDependencyUseCase) ListActionableTodosUseCase(ctx context.Context) (*appmodel.TodoListResponse, *model.DomainError) {
+	args := m.Called()
+	if resp, ok := args.Get(0).(*appmodel.TodoListResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+// newDependencyRouter serves the dependency routes of mockUseCase alongside the todo routes
+func newDependencyRouter(mockUseCase *MockTodoDependencyUseCase) http.Handler {
+	cfg := config.Default()
+	return NewTodoHTTPAdapter(new(MockTodoUseCase), cfg).Router(NewTodoDependencyHTTPAdapter(mockUseCase, cfg))
+}
+
+func TestHandleListActionableTodos(t *testing.T) {
+	mockUseCase := new(MockTodoDependencyUseCase)
+	mockUseCase.On("ListActionableTodosUseCase").Return(&appmodel.TodoListResponse{
+		Todos: []appmodel.TodoResponse{{ID: "todo-1", DependsOn: []string{"todo-0"}}},
+		Count: 1,
+		Total: 1,
+	}, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/todos/actionable", nil)
+	w := httptest.NewRecorder()
+	newDependencyRouter(mockUseCase).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response appmodel.TodoListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "todo-1", response.Todos[0].ID)
+	assert.Equal(t, []string{"todo-0"}, response.Todos[0].DependsOn)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleAddDependency(t *testing.T) {
+	mockUseCase := new(MockTodoDependencyUseCase)
+	mockUseCase.On("AddDependencyUseCase", model.TodoID("todo-1"), model.TodoID("todo-0")).Return((*model.DomainError)(nil))
+
+	req := httptest.NewRequest("POST", "/todos/todo-1/dependencies", bytes.NewBufferString(`{"depends-on":"todo-0"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	newDependencyRouter(mockUseCase).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleAddDependency_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		useCaseErr *model.DomainError
+		wantStatus int
+		wantCode   int
+	}{
+		{"missing depends-on", `{}`, nil, http.StatusBadRequest, model.ErrInvalidDependency.GetErrorCode()},
+		{"rejected by use case", `{"depends-on":"todo-1"}`, model.ErrInvalidDependency, http.StatusBadRequest, model.ErrInvalidDependency.GetErrorCode()},
+		{"unknown todo", `{"depends-on":"todo-0"}`, model.ErrTodoNotFound, http.StatusNotFound, model.ErrTodoNotFound.GetErrorCode()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoDependencyUseCase)
+			if tt.useCaseErr != nil {
+				mockUseCase.On("AddDependencyUseCase", model.TodoID("todo-1"), mock.Anything).Return(tt.useCaseErr)
+			}
+
+			req := httptest.NewRequest("POST", "/todos/todo-1/dependencies", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			newDependencyRouter(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			var response model.DomainErrorResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tt.wantCode, response.ErrorCode)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleRemoveDependency(t *testing.T) {
+	mockUseCase := new(MockTodoDependencyUseCase)
+	mockUseCase.On("RemoveDependencyUseCase", model.TodoID("todo-1"), model.TodoID("todo-0")).Return((*model.DomainError)(nil))
+
+	req := httptest.NewRequest("DELETE", "/todos/todo-1/dependencies/todo-0", nil)
+	w := httptest.NewRecorder()
+	newDependencyRouter(mockUseCase).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockUseCase.AssertExpectations(t)
+}
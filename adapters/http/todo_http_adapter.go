@@ -1,90 +1,458 @@
 package http
 
 import (
-	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"log/slog"
+	"math"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 
+	"github.com/mr3iscuit/ddd-golang/application/bus"
 	"github.com/mr3iscuit/ddd-golang/application/command"
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
 	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/application/query"
 	"github.com/mr3iscuit/ddd-golang/domain/model"
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 
 	"github.com/mr3iscuit/ddd-golang/pkg/config"
 )
 
-// TodoHTTPAdapter implements HTTP endpoints using the TodoUseCasePort
+// apiVersion is the version reported by the root index
+const apiVersion = "1.0"
+
+// maxPageSize caps the limit accepted by paginated list endpoints
+const maxPageSize = 100
+
+// servedStaleHeader marks responses served from the last-known-good cache
+const servedStaleHeader = "X-Served-Stale"
+
+// APIIndexResponse describes the API when GET / is configured to return an index
+type APIIndexResponse struct {
+	XMLName   xml.Name `json:"-" xml:"api"`
+	Name      string   `json:"name" xml:"name"`
+	Version   string   `json:"version" xml:"version"`
+	Endpoints []string `json:"endpoints" xml:"endpoints>endpoint"`
+}
+
+// TodoHTTPAdapter implements HTTP endpoints using the TodoUseCasePort.
+// Commands and the list/get queries are dispatched through buses; other reads call the port directly.
 type TodoHTTPAdapter struct {
-	usecase port.TodoUseCasePort
-	config  *config.Config
+	usecase  port.TodoUseCasePort
+	commands *bus.CommandBus
+	queries  *bus.QueryBus
+	// swagger is false when the adapter was built without a config, so there is no server port to point the docs at
+	swagger bool
+	// authenticator checks bearer tokens; nil leaves the API unauthenticated
+	authenticator Authenticator
+	responder
 }
 
-// NewTodoHTTPAdapter creates a new Todo HTTP handler
+// NewTodoHTTPAdapter creates a new Todo HTTP handler. A nil cfg falls back to
+// config.Default() and leaves the Swagger route unregistered.
 func NewTodoHTTPAdapter(usecase port.TodoUseCasePort, cfg *config.Config) *TodoHTTPAdapter {
-	return &TodoHTTPAdapter{usecase: usecase, config: cfg}
+	swagger := cfg != nil
+	if cfg == nil {
+		cfg = config.Default()
+	}
+	h := &TodoHTTPAdapter{
+		usecase:   usecase,
+		commands:  bus.NewTodoCommandBus(usecase),
+		queries:   bus.NewTodoQueryBus(usecase),
+		swagger:   swagger,
+		responder: responder{config: cfg, logger: slog.Default()},
+	}
+	if cfg.APIToken != "" {
+		h.authenticator = NewStaticTokenAuthenticator(cfg.APIToken)
+	}
+	return h
 }
 
-// writeJSONResponse writes a JSON response with the given status code
-func (h *TodoHTTPAdapter) writeJSONResponse(w http.ResponseWriter, statusCode int, data interface{}) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(data)
+// UseAuthenticator requires every request outside the public paths to carry a bearer
+// token accepted by a, replacing the static APIToken check. Call it before Router.
+func (h *TodoHTTPAdapter) UseAuthenticator(a Authenticator) {
+	h.authenticator = a
 }
 
-// writeDomainError writes a domain error as JSON response
-func (h *TodoHTTPAdapter) writeDomainError(w http.ResponseWriter, err model.DomainErrorPort) {
-	errorResponse := err.ToResponse()
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("X-Error-Type", "domain-error")
-	w.WriteHeader(err.GetHttpStatus())
-	json.NewEncoder(w).Encode(errorResponse)
+// parseIntParam reads an integer query parameter, returning def when it is absent
+// and clamping it to [min, max]. Surrounding whitespace and integral decimals such
+// as "10.0" are accepted; anything else is an ErrInvalidQueryParam.
+func parseIntParam(r *http.Request, name string, def, min, max int) (int, *model.DomainError) {
+	raw := strings.TrimSpace(r.URL.Query().Get(name))
+	if raw == "" {
+		return def, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		parsed, floatErr := strconv.ParseFloat(raw, 64)
+		if floatErr != nil || parsed != math.Trunc(parsed) || math.IsInf(parsed, 0) {
+			return 0, model.ErrInvalidQueryParam.WithDetails(map[string]string{"param": name, "value": raw})
+		}
+		value = int(math.Max(math.Min(parsed, float64(max)), float64(min)))
+	}
+
+	if value < min {
+		return min, nil
+	}
+	if value > max {
+		return max, nil
+	}
+	return value, nil
 }
 
-// parseJSON parses JSON from request body
-func (h *TodoHTTPAdapter) parseJSON(r *http.Request, v interface{}) error {
-	return json.NewDecoder(r.Body).Decode(v)
+// RouteRegistrar is implemented by adapters that serve their routes on the todo router
+type RouteRegistrar interface {
+	RegisterRoutes(r chi.Router)
 }
 
-func (h *TodoHTTPAdapter) Router() http.Handler {
+// Router builds the HTTP router, adding the routes of any extra adapters alongside the todo routes
+func (h *TodoHTTPAdapter) Router(extra ...RouteRegistrar) http.Handler {
 	r := chi.NewRouter()
 
+	r.Use(h.requestIDMiddleware)
+	r.Use(h.requestLoggingMiddleware)
+	r.Use(h.recoveryMiddleware)
+	r.Use(h.corsMiddleware)
+	r.Use(h.urlGuardMiddleware)
+	r.Use(h.consistencyMiddleware)
+	if h.authenticator != nil {
+		r.Use(h.bearerAuthMiddleware)
+	}
+
+	if h.config.StrictContentNegotiation {
+		r.Use(h.contentNegotiationMiddleware)
+	}
+
 	// Swagger documentation
-	r.Get("/swagger/*", httpSwagger.Handler(
-		httpSwagger.URL(fmt.Sprintf("http://localhost:%s/swagger/doc.json", h.config.ServerPort)),
-	))
+	if h.swagger {
+		r.Get("/swagger/*", httpSwagger.Handler(
+			httpSwagger.URL(fmt.Sprintf("http://localhost:%s/swagger/doc.json", h.config.ServerPort)),
+		))
+	}
 
 	// Todo endpoints
 	r.Get("/todos", h.HandleListTodos)
 	r.Post("/todos", h.HandleCreateTodo)
+	r.Post("/todos/delete-batch", h.HandleDeleteTodos)
+	r.Post("/todos/uncomplete-batch", h.HandleUncompleteTodos)
+	r.Post("/todos/bulk-complete", h.HandleBulkCompleteTodos)
+	r.Post("/todos/validate-field", h.HandleValidateField)
+	r.Get("/todos/random", h.HandleGetRandomTodo)
+	r.Get("/todos/example", h.HandleGetExamplePayloads)
+	r.Get("/todos/stale", h.HandleListStaleTodos)
+	r.Get("/todos/search", h.HandleSearchTodos)
+	r.Get("/todos/by-priority", h.HandleListTodosByPriority)
+	r.Get("/todos/count", h.HandleCountTodos)
 	r.Get("/todos/{id}", h.HandleGetTodo)
 	r.Put("/todos/{id}", h.HandleUpdateTodo)
+	r.Delete("/todos/{id}", h.HandleDeleteTodo)
 	r.Put("/todos/{id}/complete", h.HandleCompleteTodo)
+	r.Put("/todos/{id}/uncomplete", h.HandleUncompleteTodo)
+	r.Put("/todos/{id}/reopen", h.HandleReopenTodo)
 	r.Put("/todos/{id}/archive", h.HandleArchiveTodo)
+	r.Put("/todos/{id}/unarchive", h.HandleUnarchiveTodo)
+
+	// Statistics endpoints
+	r.Get("/stats/completion-time", h.HandleCompletionTimeStats)
+
+	// User endpoints
+	r.Get("/users/{id}/dashboard", h.HandleGetDashboard)
+
+	// Error catalog
+	r.Get("/errors", h.HandleGetErrorCatalog)
+	r.Get("/errors.ts", h.HandleGetErrorCatalog)
+
+	// Probes
+	r.Get("/healthz", h.HandleHealthz)
+	r.Get("/readyz", h.HandleReadyz)
 
 	// Test endpoint that always returns an error
 	r.Get("/test-error", h.HandleTestError)
+
+	for _, registrar := range extra {
+		registrar.RegisterRoutes(r)
+	}
+
+	r.NotFound(h.handleNotFound)
+	r.MethodNotAllowed(h.handleMethodNotAllowed(r))
+
+	// Root path
+	switch h.config.RootBehavior {
+	case config.RootBehaviorDisabled:
+	case config.RootBehaviorRedirect:
+		r.Get("/", http.RedirectHandler("/swagger/index.html", http.StatusFound).ServeHTTP)
+	default:
+		r.Get("/", h.handleRootIndex(r))
+	}
 	return r
 }
 
+// handleRootIndex returns a handler listing the routes registered on the given router
+func (h *TodoHTTPAdapter) handleRootIndex(routes chi.Routes) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		endpoints := []string{}
+		chi.Walk(routes, func(method string, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+			if route != "/" {
+				endpoints = append(endpoints, fmt.Sprintf("%s %s", method, route))
+			}
+			return nil
+		})
+		sort.Strings(endpoints)
+
+		h.writeResponse(w, r, http.StatusOK, APIIndexResponse{
+			Name:      "Todo API",
+			Version:   apiVersion,
+			Endpoints: endpoints,
+		})
+	}
+}
+
+// handleNotFound answers requests for unknown paths with a JSON error
+func (h *TodoHTTPAdapter) handleNotFound(w http.ResponseWriter, r *http.Request) {
+	h.writeDomainError(w, r, model.ErrRouteNotFound.WithDetails(map[string]string{"path": r.URL.Path}))
+}
+
+// routeMethods lists the methods probed when building an Allow header
+var routeMethods = []string{
+	http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete,
+}
+
+// handleMethodNotAllowed returns a handler answering 405 with the methods the path supports
+func (h *TodoHTTPAdapter) handleMethodNotAllowed(routes chi.Routes) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		allowed := []string{}
+		for _, method := range routeMethods {
+			if routes.Match(chi.NewRouteContext(), method, r.URL.Path) {
+				allowed = append(allowed, method)
+			}
+		}
+		allow := strings.Join(allowed, ", ")
+
+		w.Header().Set("Allow", allow)
+		h.writeDomainError(w, r, model.ErrMethodNotAllowed.WithDetails(map[string]string{
+			"method":  r.Method,
+			"allowed": allow,
+		}))
+	}
+}
+
 // HandleListTodos handles GET /todos
 // @Summary List all todos
-// @Description Get all todos
+// @Description Get all todos, optionally one page at a time
 // @Tags todos
 // @Accept json
 // @Produce json
-// @Success 200 {array} appmodel.TodoResponse
+// @Param limit query int false "Maximum number of todos to return; 0 returns all"
+// @Param offset query int false "Number of todos to skip"
+// @Param status query string false "Status filter (pending, completed or archived)"
+// @Param priority query string false "Priority filter (low, medium or high)"
+// @Param sort_by query string false "Sort field (created_at, updated_at, priority or title)"
+// @Param sort_order query string false "Sort order (asc or desc)"
+// @Param overdue query bool false "Only list pending todos past their due date"
+// @Param tag query string false "Only list todos carrying this tag"
+// @Param source query string false "Only list todos created through this source (http, cli, grpc or import)"
+// @Param q query string false "Only list todos whose title or description contains this text, ignoring case"
+// @Param category query string false "Only list todos assigned to this category"
+// @Param include_deleted query bool false "Append a tombstone ({id, deleted: true}) for every deleted todo"
+// @Success 200 {object} appmodel.TodoListResponse
+// @Failure 400 {object} appmodel.ErrorResponse
 // @Failure 500 {object} appmodel.ErrorResponse
 // @Router /todos [get]
 func (h *TodoHTTPAdapter) HandleListTodos(w http.ResponseWriter, r *http.Request) {
-	response, err := h.usecase.ListTodosUseCase()
+	q, err := parseListTodosQuery(r)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	response, err := bus.DispatchQuery[*appmodel.TodoListResponse](r.Context(), h.queries, q)
 	if err != nil {
-		h.writeDomainError(w, err)
+		h.writeDomainError(w, r, err)
 		return
 	}
 
-	h.writeJSONResponse(w, http.StatusOK, response)
+	w.Header().Set("X-Total-Count", strconv.Itoa(response.Total))
+	if response.Stale {
+		w.Header().Set(servedStaleHeader, "true")
+	}
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// parseListTodosQuery reads the paging, sorting and filter query parameters of
+// GET /todos. GET /todos/count shares it so both endpoints filter identically.
+func parseListTodosQuery(r *http.Request) (query.ListTodosQuery, *model.DomainError) {
+	limit, err := parseIntParam(r, "limit", 0, 0, maxPageSize)
+	if err != nil {
+		return query.ListTodosQuery{}, err
+	}
+	offset, err := parseIntParam(r, "offset", 0, 0, math.MaxInt32)
+	if err != nil {
+		return query.ListTodosQuery{}, err
+	}
+	overdue, err := parseBoolParam(r, "overdue")
+	if err != nil {
+		return query.ListTodosQuery{}, err
+	}
+	includeDeleted, err := parseBoolParam(r, "include_deleted")
+	if err != nil {
+		return query.ListTodosQuery{}, err
+	}
+
+	params := r.URL.Query()
+	return query.ListTodosQuery{
+		Limit:          limit,
+		Offset:         offset,
+		StatusFilter:   strings.TrimSpace(params.Get("status")),
+		PriorityFilter: strings.TrimSpace(params.Get("priority")),
+		SortBy:         strings.TrimSpace(params.Get("sort_by")),
+		SortOrder:      strings.TrimSpace(params.Get("sort_order")),
+		Overdue:        overdue,
+		TagFilter:      strings.TrimSpace(params.Get("tag")),
+		SourceFilter:   strings.TrimSpace(params.Get("source")),
+		SearchTerm:     strings.TrimSpace(params.Get("q")),
+		CategoryFilter: strings.TrimSpace(params.Get("category")),
+		IncludeDeleted: includeDeleted,
+	}, nil
+}
+
+// parseBoolParam reads a boolean query parameter, returning false when it is absent
+func parseBoolParam(r *http.Request, name string) (bool, *model.DomainError) {
+	raw := strings.TrimSpace(r.URL.Query().Get(name))
+	if raw == "" {
+		return false, nil
+	}
+	parsed, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, model.ErrInvalidQueryParam.WithDetails(map[string]string{"param": name, "value": raw})
+	}
+	return parsed, nil
+}
+
+// HandleCountTodos handles GET /todos/count
+// @Summary Count todos
+// @Description Count the todos matching the given filters, which behave exactly as on GET /todos
+// @Tags todos
+// @Produce json
+// @Param status query string false "Status filter (pending, completed or archived)"
+// @Param priority query string false "Priority filter (low, medium or high)"
+// @Param overdue query bool false "Only count pending todos past their due date"
+// @Param tag query string false "Only count todos carrying this tag"
+// @Param source query string false "Only count todos created through this source (http, cli, grpc or import)"
+// @Param q query string false "Only count todos whose title or description contains this text, ignoring case"
+// @Param category query string false "Only count todos assigned to this category"
+// @Success 200 {object} appmodel.CountResponse
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/count [get]
+func (h *TodoHTTPAdapter) HandleCountTodos(w http.ResponseWriter, r *http.Request) {
+	q, err := parseListTodosQuery(r)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+	filter, err := q.Filter(time.Now())
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	response, err := h.usecase.CountTodosUseCase(r.Context(), filter)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// defaultStaleAge is the age used by GET /todos/stale when older_than is omitted
+const defaultStaleAge = 30 * 24 * time.Hour
+
+// HandleListStaleTodos handles GET /todos/stale
+// @Summary List stale todos
+// @Description List pending todos that have not been updated within the given duration
+// @Tags todos
+// @Produce json
+// @Param older_than query string false "Minimum age since last update, as a Go duration (default 720h)"
+// @Success 200 {object} appmodel.TodoListResponse
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/stale [get]
+func (h *TodoHTTPAdapter) HandleListStaleTodos(w http.ResponseWriter, r *http.Request) {
+	olderThan := defaultStaleAge
+	if raw := strings.TrimSpace(r.URL.Query().Get("older_than")); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil || parsed <= 0 {
+			h.writeDomainError(w, r, model.ErrInvalidQueryParam.WithDetails(map[string]string{"param": "older_than", "value": raw}))
+			return
+		}
+		olderThan = parsed
+	}
+
+	response, err := h.usecase.ListStaleTodosUseCase(r.Context(), olderThan)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// HandleSearchTodos handles GET /todos/search
+// @Summary Search todos by title
+// @Description List unarchived todos whose titles contain every word of q, ignoring case and punctuation
+// @Tags todos
+// @Produce json
+// @Param q query string true "Words to search for"
+// @Success 200 {object} appmodel.TodoListResponse
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/search [get]
+func (h *TodoHTTPAdapter) HandleSearchTodos(w http.ResponseWriter, r *http.Request) {
+	response, err := h.usecase.SearchTodosUseCase(r.Context(), r.URL.Query().Get("q"))
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// HandleListTodosByPriority handles GET /todos/by-priority
+// @Summary List todos grouped by priority
+// @Description List todos bucketed into high, medium and low priority; archived todos are excluded unless requested
+// @Tags todos
+// @Produce json
+// @Param include_archived query bool false "Include archived todos"
+// @Success 200 {object} appmodel.TodosByPriorityResponse
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/by-priority [get]
+func (h *TodoHTTPAdapter) HandleListTodosByPriority(w http.ResponseWriter, r *http.Request) {
+	includeArchived := false
+	if raw := strings.TrimSpace(r.URL.Query().Get("include_archived")); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			h.writeDomainError(w, r, model.ErrInvalidQueryParam.WithDetails(map[string]string{"param": "include_archived", "value": raw}))
+			return
+		}
+		includeArchived = parsed
+	}
+
+	response, err := h.usecase.ListTodosByPriorityUseCase(r.Context(), includeArchived)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
 }
 
 // HandleCreateTodo handles POST /todos
@@ -101,17 +469,128 @@ func (h *TodoHTTPAdapter) HandleListTodos(w http.ResponseWriter, r *http.Request
 func (h *TodoHTTPAdapter) HandleCreateTodo(w http.ResponseWriter, r *http.Request) {
 	var cmd command.CreateTodoCommand
 	if err := h.parseJSON(r, &cmd); err != nil {
-		h.writeDomainError(w, model.ErrInvalidJSON)
+		h.writeDomainError(w, r, err)
+		return
+	}
+	cmd.Source = string(model.TodoSourceHTTP)
+
+	id, err := bus.DispatchCommand[model.TodoID](r.Context(), h.commands, cmd)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusCreated, map[string]string{"id": string(id)})
+}
+
+// HandleDeleteTodos handles POST /todos/delete-batch
+// @Summary Delete several todos
+// @Description Delete all todos with the given IDs and report the IDs that could not be deleted
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param ids body command.DeleteTodosCommand true "IDs of the todos to delete"
+// @Success 200 {object} appmodel.BatchResponse
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/delete-batch [post]
+func (h *TodoHTTPAdapter) HandleDeleteTodos(w http.ResponseWriter, r *http.Request) {
+	var cmd command.DeleteTodosCommand
+	if err := h.parseJSON(r, &cmd); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	failed, err := bus.DispatchCommand[[]model.TodoID](r.Context(), h.commands, cmd)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, appmodel.BatchResponseMapper(failed))
+}
+
+// HandleUncompleteTodos handles POST /todos/uncomplete-batch
+// @Summary Reopen several completed todos
+// @Description Return all given completed todos to pending and report the IDs that could not be reopened
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param ids body command.UncompleteTodosCommand true "IDs of the todos to reopen"
+// @Success 200 {object} appmodel.BatchResponse
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Router /todos/uncomplete-batch [post]
+func (h *TodoHTTPAdapter) HandleUncompleteTodos(w http.ResponseWriter, r *http.Request) {
+	var cmd command.UncompleteTodosCommand
+	if err := h.parseJSON(r, &cmd); err != nil {
+		h.writeDomainError(w, r, err)
 		return
 	}
 
-	id, err := h.usecase.CreateTodoUseCase(cmd)
+	failed, err := bus.DispatchCommand[[]model.TodoID](r.Context(), h.commands, cmd)
 	if err != nil {
-		h.writeDomainError(w, err)
+		h.writeDomainError(w, r, err)
 		return
 	}
 
-	h.writeJSONResponse(w, http.StatusCreated, map[string]string{"id": string(id)})
+	h.writeResponse(w, r, http.StatusOK, appmodel.BatchResponseMapper(failed))
+}
+
+// HandleBulkCompleteTodos handles POST /todos/bulk-complete
+// @Summary Complete several todos
+// @Description Complete all given todos and report the outcome per todo; responds 207 when some could not be completed
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param ids body command.BulkCompleteTodosCommand true "IDs of the todos to complete"
+// @Success 200 {object} appmodel.BulkResult
+// @Success 207 {object} appmodel.BulkResult
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Router /todos/bulk-complete [post]
+func (h *TodoHTTPAdapter) HandleBulkCompleteTodos(w http.ResponseWriter, r *http.Request) {
+	var cmd command.BulkCompleteTodosCommand
+	if err := h.parseJSON(r, &cmd); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	result, err := bus.DispatchCommand[*appmodel.BulkResult](r.Context(), h.commands, cmd)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	status := http.StatusOK
+	if len(result.Failed) > 0 {
+		status = http.StatusMultiStatus
+	}
+	h.writeResponse(w, r, status, result)
+}
+
+// HandleValidateField handles POST /todos/validate-field
+// @Summary Validate a single field
+// @Description Validate one todo field value, for inline form validation
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param field body command.ValidateFieldCommand true "Field name and value"
+// @Success 200 {object} appmodel.FieldValidationResponse
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Router /todos/validate-field [post]
+func (h *TodoHTTPAdapter) HandleValidateField(w http.ResponseWriter, r *http.Request) {
+	var cmd command.ValidateFieldCommand
+	if err := h.parseJSON(r, &cmd); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	response, err := bus.DispatchCommand[*appmodel.FieldValidationResponse](r.Context(), h.commands, cmd)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
 }
 
 // HandleGetTodo handles GET /todos/{id}
@@ -128,17 +607,49 @@ func (h *TodoHTTPAdapter) HandleCreateTodo(w http.ResponseWriter, r *http.Reques
 func (h *TodoHTTPAdapter) HandleGetTodo(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 	if id == "" {
-		h.writeDomainError(w, model.ErrTodoNotFound)
+		h.writeDomainError(w, r, model.ErrTodoNotFound)
+		return
+	}
+
+	response, err := bus.DispatchQuery[*appmodel.TodoResponse](r.Context(), h.queries, query.GetTodoQuery{ID: id})
+	if err != nil {
+		h.writeDomainError(w, r, err)
 		return
 	}
 
-	response, err := h.usecase.GetTodoUseCase(model.TodoID(id))
+	if response.Stale {
+		w.Header().Set(servedStaleHeader, "true")
+	}
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// HandleGetRandomTodo handles GET /todos/random
+// @Summary Get a random pending todo
+// @Description Pick a random pending todo to work on
+// @Tags todos
+// @Produce json
+// @Success 200 {object} appmodel.TodoResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Router /todos/random [get]
+func (h *TodoHTTPAdapter) HandleGetRandomTodo(w http.ResponseWriter, r *http.Request) {
+	response, err := h.usecase.GetRandomTodoUseCase(r.Context())
 	if err != nil {
-		h.writeDomainError(w, err)
+		h.writeDomainError(w, r, err)
 		return
 	}
 
-	h.writeJSONResponse(w, http.StatusOK, response)
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// HandleGetExamplePayloads handles GET /todos/example
+// @Summary Get example payloads
+// @Description Get a valid example create request and the shape of a todo response
+// @Tags todos
+// @Produce json
+// @Success 200 {object} appmodel.ExamplePayloadsResponse
+// @Router /todos/example [get]
+func (h *TodoHTTPAdapter) HandleGetExamplePayloads(w http.ResponseWriter, r *http.Request) {
+	h.writeResponse(w, r, http.StatusOK, appmodel.ExamplePayloads())
 }
 
 // HandleUpdateTodo handles PUT /todos/{id}
@@ -157,24 +668,24 @@ func (h *TodoHTTPAdapter) HandleGetTodo(w http.ResponseWriter, r *http.Request)
 func (h *TodoHTTPAdapter) HandleUpdateTodo(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 	if id == "" {
-		h.writeDomainError(w, model.ErrTodoNotFound)
+		h.writeDomainError(w, r, model.ErrTodoNotFound)
 		return
 	}
 
 	var cmd command.UpdateTodoCommand
 	if err := h.parseJSON(r, &cmd); err != nil {
-		h.writeDomainError(w, model.ErrInvalidJSON)
+		h.writeDomainError(w, r, err)
 		return
 	}
 
 	cmd.ID = id
-	err := h.usecase.UpdateTodoUseCase(cmd)
+	_, err := bus.DispatchCommand[bus.NoResult](r.Context(), h.commands, cmd)
 	if err != nil {
-		h.writeDomainError(w, err)
+		h.writeDomainError(w, r, err)
 		return
 	}
 
-	h.writeJSONResponse(w, http.StatusOK, map[string]string{"message": "Todo updated successfully"})
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Todo updated successfully"})
 }
 
 // HandleCompleteTodo handles PUT /todos/{id}/complete
@@ -192,17 +703,100 @@ func (h *TodoHTTPAdapter) HandleUpdateTodo(w http.ResponseWriter, r *http.Reques
 func (h *TodoHTTPAdapter) HandleCompleteTodo(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 	if id == "" {
-		h.writeDomainError(w, model.ErrTodoNotFound)
+		h.writeDomainError(w, r, model.ErrTodoNotFound)
+		return
+	}
+
+	_, err := bus.DispatchCommand[bus.NoResult](r.Context(), h.commands, command.CompleteTodoCommand{ID: id})
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Todo completed successfully"})
+}
+
+// HandleUncompleteTodo handles PUT /todos/{id}/uncomplete
+// @Summary Uncomplete a todo
+// @Description Return a completed todo to pending and clear its completion time
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/{id}/uncomplete [put]
+func (h *TodoHTTPAdapter) HandleUncompleteTodo(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		h.writeDomainError(w, r, model.ErrTodoNotFound)
+		return
+	}
+
+	_, err := bus.DispatchCommand[bus.NoResult](r.Context(), h.commands, command.UncompleteTodoCommand{ID: id})
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Todo uncompleted successfully"})
+}
+
+// HandleReopenTodo handles PUT /todos/{id}/reopen
+// @Summary Reopen a todo
+// @Description Reset a completed todo to pending; archived todos cannot be reopened
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/{id}/reopen [put]
+func (h *TodoHTTPAdapter) HandleReopenTodo(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		h.writeDomainError(w, r, model.ErrTodoNotFound)
 		return
 	}
 
-	err := h.usecase.CompleteTodoUseCase(model.TodoID(id))
+	_, err := bus.DispatchCommand[bus.NoResult](r.Context(), h.commands, command.ReopenTodoCommand{ID: id})
 	if err != nil {
-		h.writeDomainError(w, err)
+		h.writeDomainError(w, r, err)
 		return
 	}
 
-	h.writeJSONResponse(w, http.StatusOK, map[string]string{"message": "Todo completed successfully"})
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Todo reopened successfully"})
+}
+
+// HandleDeleteTodo handles DELETE /todos/{id}
+// @Summary Delete a todo
+// @Description Delete a specific todo by its ID
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Success 200 {object} map[string]string
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/{id} [delete]
+func (h *TodoHTTPAdapter) HandleDeleteTodo(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		h.writeDomainError(w, r, model.ErrTodoNotFound)
+		return
+	}
+
+	_, err := bus.DispatchCommand[bus.NoResult](r.Context(), h.commands, command.DeleteTodoCommand{ID: id})
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Todo deleted successfully"})
 }
 
 // HandleArchiveTodo handles PUT /todos/{id}/archive
@@ -220,17 +814,119 @@ func (h *TodoHTTPAdapter) HandleCompleteTodo(w http.ResponseWriter, r *http.Requ
 func (h *TodoHTTPAdapter) HandleArchiveTodo(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
 	if id == "" {
-		h.writeDomainError(w, model.ErrTodoNotFound)
+		h.writeDomainError(w, r, model.ErrTodoNotFound)
 		return
 	}
 
-	err := h.usecase.ArchiveTodoUseCase(model.TodoID(id))
+	_, err := bus.DispatchCommand[bus.NoResult](r.Context(), h.commands, command.ArchiveTodoCommand{ID: id})
 	if err != nil {
-		h.writeDomainError(w, err)
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Todo archived successfully"})
+}
+
+// HandleUnarchiveTodo handles PUT /todos/{id}/unarchive
+// @Summary Unarchive a todo
+// @Description Return an archived todo to pending
+// @Tags todos
+// @Accept json
+// @Produce json
+// @Param id path string true "Todo ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /todos/{id}/unarchive [put]
+func (h *TodoHTTPAdapter) HandleUnarchiveTodo(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		h.writeDomainError(w, r, model.ErrTodoNotFound)
+		return
+	}
+
+	_, err := bus.DispatchCommand[bus.NoResult](r.Context(), h.commands, command.UnarchiveTodoCommand{ID: id})
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "Todo unarchived successfully"})
+}
+
+// HandleGetDashboard handles GET /users/{id}/dashboard
+// @Summary Get a user's dashboard
+// @Description Get status counts, overdue and due-today counts and weekly completion figures for the todos owned by a user
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} appmodel.DashboardResponse
+// @Failure 403 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /users/{id}/dashboard [get]
+func (h *TodoHTTPAdapter) HandleGetDashboard(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	// An authenticated user may only see their own dashboard
+	if caller, ok := port.AuthenticatedUserFromContext(r.Context()); ok && caller != model.UserID(id) {
+		h.writeDomainError(w, r, model.ErrForbidden.WithDetails(map[string]string{"user-id": id}))
+		return
+	}
+
+	response, err := h.usecase.GetDashboardUseCase(r.Context(), model.UserID(id))
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// HandleCompletionTimeStats handles GET /stats/completion-time
+// @Summary Get completion time statistics
+// @Description Get the average time from creation to completion of todos, grouped by priority
+// @Tags stats
+// @Produce json
+// @Success 200 {object} appmodel.CompletionTimeStatsResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /stats/completion-time [get]
+func (h *TodoHTTPAdapter) HandleCompletionTimeStats(w http.ResponseWriter, r *http.Request) {
+	response, err := h.usecase.CompletionTimeStatsUseCase(r.Context())
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// HandleHealthz handles GET /healthz
+// @Summary Liveness probe
+// @Description Reports that the process is serving requests
+// @Tags probes
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Router /healthz [get]
+func (h *TodoHTTPAdapter) HandleHealthz(w http.ResponseWriter, r *http.Request) {
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// HandleReadyz handles GET /readyz
+// @Summary Readiness probe
+// @Description Reports whether the database is reachable
+// @Tags probes
+// @Produce json
+// @Success 200 {object} map[string]string
+// @Failure 503 {object} appmodel.ErrorResponse
+// @Router /readyz [get]
+func (h *TodoHTTPAdapter) HandleReadyz(w http.ResponseWriter, r *http.Request) {
+	if err := h.usecase.ReadinessUseCase(r.Context()); err != nil {
+		h.writeDomainError(w, r, err)
 		return
 	}
 
-	h.writeJSONResponse(w, http.StatusOK, map[string]string{"message": "Todo archived successfully"})
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"status": "ready"})
 }
 
 // HandleTestError handles GET /test-error
@@ -241,6 +937,6 @@ func (h *TodoHTTPAdapter) HandleArchiveTodo(w http.ResponseWriter, r *http.Reque
 // @Success 400 {object} appmodel.ErrorResponse
 // @Router /test-error [get]
 func (h *TodoHTTPAdapter) HandleTestError(w http.ResponseWriter, r *http.Request) {
-	err := h.usecase.TestErrorUseCase()
-	h.writeDomainError(w, err)
+	err := h.usecase.TestErrorUseCase(r.Context())
+	h.writeDomainError(w, r, err)
 }
@@ -2,10 +2,16 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"encoding/xml"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
@@ -13,7 +19,12 @@ import (
 
 	"github.com/mr3iscuit/ddd-golang/application/command"
 	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/application/query"
+	"github.com/mr3iscuit/ddd-golang/application/usecase"
 	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/domain/service"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository"
 	"github.com/mr3iscuit/ddd-golang/pkg/config"
 )
 
@@ -21,27 +32,42 @@ type MockTodoUseCase struct {
 	mock.Mock
 }
 
-func (m *MockTodoUseCase) CreateTodoUseCase(cmd command.CreateTodoCommand) (model.TodoID, *model.DomainError) {
+func (m *MockTodoUseCase) CreateTodoUseCase(ctx context.Context, cmd command.CreateTodoCommand) (model.TodoID, *model.DomainError) {
 	args := m.Called(cmd)
 	return args.Get(0).(model.TodoID), args.Get(1).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) UpdateTodoUseCase(cmd command.UpdateTodoCommand) *model.DomainError {
+func (m *MockTodoUseCase) UpdateTodoUseCase(ctx context.Context, cmd command.UpdateTodoCommand) *model.DomainError {
 	args := m.Called(cmd)
 	return args.Get(0).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) CompleteTodoUseCase(id model.TodoID) *model.DomainError {
+func (m *MockTodoUseCase) CompleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
 	args := m.Called(id)
 	return args.Get(0).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) ArchiveTodoUseCase(id model.TodoID) *model.DomainError {
+func (m *MockTodoUseCase) UncompleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
 	args := m.Called(id)
 	return args.Get(0).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) GetTodoUseCase(id model.TodoID) (*appmodel.TodoResponse, *model.DomainError) {
+func (m *MockTodoUseCase) ReopenTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	args := m.Called(id)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) UnarchiveTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	args := m.Called(id)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) ArchiveTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	args := m.Called(id)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) GetTodoUseCase(ctx context.Context, id model.TodoID) (*appmodel.TodoResponse, *model.DomainError) {
 	args := m.Called(id)
 	if resp, ok := args.Get(0).(*appmodel.TodoResponse); ok {
 		return resp, args.Get(1).(*model.DomainError)
@@ -49,15 +75,126 @@ func (m *MockTodoUseCase) GetTodoUseCase(id model.TodoID) (*appmodel.TodoRespons
 	return nil, args.Get(1).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) ListTodosUseCase() (*appmodel.TodoListResponse, *model.DomainError) {
+func (m *MockTodoUseCase) ListTodosUseCase(ctx context.Context, q query.ListTodosQuery) (*appmodel.TodoListResponse, *model.DomainError) {
+	args := m.Called(q)
+	if resp, ok := args.Get(0).(*appmodel.TodoListResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) DeleteTodosUseCase(ctx context.Context, ids []model.TodoID) ([]model.TodoID, *model.DomainError) {
+	args := m.Called(ids)
+	if failed, ok := args.Get(0).([]model.TodoID); ok {
+		return failed, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) GetDashboardUseCase(ctx context.Context, owner model.UserID) (*appmodel.DashboardResponse, *model.DomainError) {
+	args := m.Called(owner)
+	if resp, ok := args.Get(0).(*appmodel.DashboardResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) CompletionTimeStatsUseCase(ctx context.Context) (*appmodel.CompletionTimeStatsResponse, *model.DomainError) {
+	args := m.Called()
+	if resp, ok := args.Get(0).(*appmodel.CompletionTimeStatsResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) GetRandomTodoUseCase(ctx context.Context) (*appmodel.TodoResponse, *model.DomainError) {
 	args := m.Called()
+	if resp, ok := args.Get(0).(*appmodel.TodoResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) UncompleteBatchUseCase(ctx context.Context, ids []model.TodoID) ([]model.TodoID, *model.DomainError) {
+	args := m.Called(ids)
+	if failed, ok := args.Get(0).([]model.TodoID); ok {
+		return failed, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) BulkCompleteTodosUseCase(ctx context.Context, ids []model.TodoID) (*appmodel.BulkResult, *model.DomainError) {
+	args := m.Called(ids)
+	if result, ok := args.Get(0).(*appmodel.BulkResult); ok {
+		return result, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) ListStaleTodosUseCase(ctx context.Context, olderThan time.Duration) (*appmodel.TodoListResponse, *model.DomainError) {
+	args := m.Called(olderThan)
+	if resp, ok := args.Get(0).(*appmodel.TodoListResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) ListTodosByPriorityUseCase(ctx context.Context, includeArchived bool) (*appmodel.TodosByPriorityResponse, *model.DomainError) {
+	args := m.Called(includeArchived)
+	if resp, ok := args.Get(0).(*appmodel.TodosByPriorityResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) SearchTodosUseCase(ctx context.Context, text string) (*appmodel.TodoListResponse, *model.DomainError) {
+	args := m.Called(text)
 	if resp, ok := args.Get(0).(*appmodel.TodoListResponse); ok {
 		return resp, args.Get(1).(*model.DomainError)
 	}
 	return nil, args.Get(1).(*model.DomainError)
 }
 
-func (m *MockTodoUseCase) TestErrorUseCase() *model.DomainError {
+func (m *MockTodoUseCase) CountTodosUseCase(ctx context.Context, filter model.TodoFilter) (*appmodel.CountResponse, *model.DomainError) {
+	args := m.Called(filter)
+	if resp, ok := args.Get(0).(*appmodel.CountResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) ValidateFieldUseCase(ctx context.Context, cmd command.ValidateFieldCommand) (*appmodel.FieldValidationResponse, *model.DomainError) {
+	args := m.Called(cmd)
+	if resp, ok := args.Get(0).(*appmodel.FieldValidationResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) SnapshotUseCase(ctx context.Context) ([]byte, *model.DomainError) {
+	args := m.Called()
+	if data, ok := args.Get(0).([]byte); ok {
+		return data, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) RestoreSnapshotUseCase(ctx context.Context, data []byte, replace bool) *model.DomainError {
+	args := m.Called(data, replace)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) DeleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	args := m.Called(id)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) TestErrorUseCase(ctx context.Context) *model.DomainError {
+	args := m.Called()
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockTodoUseCase) ReadinessUseCase(ctx context.Context) *model.DomainError {
 	args := m.Called()
 	return args.Get(0).(*model.DomainError)
 }
@@ -71,10 +208,12 @@ func TestHandleCreateTodo_Success(t *testing.T) {
 		Description: "Test Description",
 		Priority:    "high",
 	}
+	body, _ := json.Marshal(cmd)
 
+	// The adapter stamps the source; clients cannot set it
+	cmd.Source = "http"
 	mockUseCase.On("CreateTodoUseCase", cmd).Return(model.TodoID("test-id"), (*model.DomainError)(nil))
 
-	body, _ := json.Marshal(cmd)
 	req := httptest.NewRequest("POST", "/todos", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
@@ -104,7 +243,64 @@ func TestHandleCreateTodo_InvalidJSON(t *testing.T) {
 
 	var response appmodel.ErrorResponse
 	json.Unmarshal(w.Body.Bytes(), &response)
-	assert.Equal(t, "Invalid JSON", response.ErrorMessage)
+	assert.Equal(t, "Malformed JSON", response.ErrorMessage)
+}
+
+func TestHandleCreateTodo_DecodeFailures(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		expectedCode int
+		details      map[string]string
+	}{
+		{name: "empty body", body: "", expectedCode: 5005},
+		{name: "syntax error", body: `{"title": }`, expectedCode: 5006},
+		{name: "truncated", body: `{"title": "abc"`, expectedCode: 5006},
+		{
+			name:         "type mismatch",
+			body:         `{"title": 42}`,
+			expectedCode: 5007,
+			details:      map[string]string{"field": "title", "expected": "string", "actual": "number"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			handler := NewTodoHTTPAdapter(mockUseCase, &config.Config{ServerPort: "8080"})
+
+			req := httptest.NewRequest("POST", "/todos", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.HandleCreateTodo(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var response appmodel.ErrorResponse
+			json.Unmarshal(w.Body.Bytes(), &response)
+			assert.Equal(t, tt.expectedCode, response.ErrorCode)
+			if tt.details != nil {
+				assert.Equal(t, tt.details, map[string]string(response.Details))
+			}
+			mockUseCase.AssertNotCalled(t, "CreateTodoUseCase")
+		})
+	}
+}
+
+func TestHandleCreateTodo_ClientCannotSetSource(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+	mockUseCase.On("CreateTodoUseCase", command.CreateTodoCommand{Title: "Test", Source: "http"}).Return(model.TodoID("test-id"), (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("POST", "/todos", strings.NewReader(`{"title": "Test", "source": "import"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.HandleCreateTodo(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	mockUseCase.AssertExpectations(t)
 }
 
 func TestHandleCreateTodo_UseCaseError(t *testing.T) {
@@ -113,10 +309,11 @@ func TestHandleCreateTodo_UseCaseError(t *testing.T) {
 
 	cmd := command.CreateTodoCommand{Title: "Test"}
 	domainError := model.NewDomainError(1001, 400, "Validation failed", "Title too short", nil)
+	body, _ := json.Marshal(cmd)
 
+	cmd.Source = "http"
 	mockUseCase.On("CreateTodoUseCase", cmd).Return(model.TodoID(""), domainError)
 
-	body, _ := json.Marshal(cmd)
 	req := httptest.NewRequest("POST", "/todos", bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
@@ -142,7 +339,7 @@ func TestHandleListTodos_Success(t *testing.T) {
 	}
 	response := &appmodel.TodoListResponse{Todos: todos, Count: 2}
 
-	mockUseCase.On("ListTodosUseCase").Return(response, (*model.DomainError)(nil))
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{}).Return(response, (*model.DomainError)(nil))
 
 	req := httptest.NewRequest("GET", "/todos", nil)
 	w := httptest.NewRecorder()
@@ -164,7 +361,7 @@ func TestHandleListTodos_UseCaseError(t *testing.T) {
 	handler := NewTodoHTTPAdapter(mockUseCase, &config.Config{ServerPort: "8080"})
 
 	domainError := model.NewDomainError(4001, 500, "Database error", "Connection failed", nil)
-	mockUseCase.On("ListTodosUseCase").Return((*appmodel.TodoListResponse)(nil), domainError)
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{}).Return((*appmodel.TodoListResponse)(nil), domainError)
 
 	req := httptest.NewRequest("GET", "/todos", nil)
 	w := httptest.NewRecorder()
@@ -240,6 +437,62 @@ func TestHandleCompleteTodo_Success(t *testing.T) {
 	mockUseCase.AssertExpectations(t)
 }
 
+func TestHandleReopenTodo(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *model.DomainError
+		wantCode int
+	}{
+		{name: "reopened", err: nil, wantCode: http.StatusOK},
+		{name: "archived", err: model.ErrCannotReopenTodo, wantCode: http.StatusBadRequest},
+		{name: "missing", err: model.ErrTodoNotFound, wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+			mockUseCase.On("ReopenTodoUseCase", model.TodoID("test-id")).Return(tt.err)
+
+			req := httptest.NewRequest("PUT", "/todos/test-id/reopen", nil)
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleUnarchiveTodo(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *model.DomainError
+		wantCode int
+	}{
+		{name: "unarchived", err: nil, wantCode: http.StatusOK},
+		{name: "not archived", err: model.ErrCannotUnarchiveTodo, wantCode: http.StatusBadRequest},
+		{name: "missing", err: model.ErrTodoNotFound, wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+			mockUseCase.On("UnarchiveTodoUseCase", model.TodoID("test-id")).Return(tt.err)
+
+			req := httptest.NewRequest("PUT", "/todos/test-id/unarchive", nil)
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
 func TestHandleArchiveTodo_Success(t *testing.T) {
 	mockUseCase := new(MockTodoUseCase)
 	handler := NewTodoHTTPAdapter(mockUseCase, &config.Config{ServerPort: "8080"})
@@ -320,3 +573,1384 @@ func TestHandleTestError(t *testing.T) {
 
 	mockUseCase.AssertExpectations(t)
 }
+
+func TestRouter_RootIndex(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, &config.Config{ServerPort: "8080", RootBehavior: config.RootBehaviorIndex})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response APIIndexResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, "1.0", response.Version)
+	assert.Contains(t, response.Endpoints, "GET /todos")
+	assert.Contains(t, response.Endpoints, "POST /todos")
+	assert.NotContains(t, response.Endpoints, "GET /")
+}
+
+func TestRouter_RootRedirect(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, &config.Config{ServerPort: "8080", RootBehavior: config.RootBehaviorRedirect})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, "/swagger/index.html", w.Header().Get("Location"))
+}
+
+func TestRouter_RootDisabled(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, &config.Config{ServerPort: "8080", RootBehavior: config.RootBehaviorDisabled})
+
+	req := httptest.NewRequest("GET", "/", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandleDeleteTodos_ReportsFailed(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, &config.Config{ServerPort: "8080"})
+
+	ids := []model.TodoID{"present", "absent"}
+	mockUseCase.On("DeleteTodosUseCase", ids).Return([]model.TodoID{"absent"}, (*model.DomainError)(nil))
+
+	body, _ := json.Marshal(command.DeleteTodosCommand{IDs: []string{"present", "absent"}})
+	req := httptest.NewRequest("POST", "/todos/delete-batch", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.HandleDeleteTodos(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response appmodel.BatchResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, []string{"absent"}, response.Failed)
+
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestRouter_StrictContentNegotiation(t *testing.T) {
+	tests := []struct {
+		name         string
+		accept       string
+		expectedCode int
+	}{
+		{name: "json", accept: "application/json", expectedCode: http.StatusOK},
+		{name: "wildcard", accept: "*/*", expectedCode: http.StatusOK},
+		{name: "missing", accept: "", expectedCode: http.StatusOK},
+		{name: "html only", accept: "text/html", expectedCode: http.StatusNotAcceptable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			handler := NewTodoHTTPAdapter(mockUseCase, &config.Config{ServerPort: "8080", StrictContentNegotiation: true})
+
+			response := &appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{}, Count: 0}
+			mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{}).Return(response, (*model.DomainError)(nil)).Maybe()
+
+			req := httptest.NewRequest("GET", "/todos", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestHandleListTodos_XML(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, &config.Config{ServerPort: "8080"})
+
+	todos := []appmodel.TodoResponse{
+		{ID: "1", Title: "Todo 1", Status: "pending", Priority: "high"},
+		{ID: "2", Title: "Todo 2", Status: "completed", Priority: "medium"},
+	}
+	response := &appmodel.TodoListResponse{Todos: todos, Count: 2}
+
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{}).Return(response, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/todos", nil)
+	req.Header.Set("Accept", "application/xml")
+	w := httptest.NewRecorder()
+
+	handler.HandleListTodos(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/xml", w.Header().Get("Content-Type"))
+
+	var result appmodel.TodoListResponse
+	assert.NoError(t, xml.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, 2, result.Count)
+	assert.Equal(t, "Todo 1", result.Todos[0].Title)
+	assert.Equal(t, "completed", result.Todos[1].Status)
+
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestRouter_Probes(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		readiness  *model.DomainError
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "healthz", path: "/healthz", wantStatus: http.StatusOK, wantBody: `{"status":"ok"}`},
+		{name: "readyz ready", path: "/readyz", wantStatus: http.StatusOK, wantBody: `{"status":"ready"}`},
+		{name: "readyz database down", path: "/readyz", readiness: model.ErrDatabaseUnavailable, wantStatus: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			mockUseCase.On("ReadinessUseCase").Return(tt.readiness)
+			cfg := config.Default()
+			cfg.APIToken = "s3cret"
+			handler := NewTodoHTTPAdapter(mockUseCase, cfg)
+
+			req := httptest.NewRequest("GET", tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandleTestError_XML(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, &config.Config{ServerPort: "8080"})
+
+	domainError := model.NewDomainError(9001, 400, "Test error", "Test reason", map[string]string{"test": "true"})
+	mockUseCase.On("TestErrorUseCase").Return(domainError)
+
+	req := httptest.NewRequest("GET", "/test-error", nil)
+	req.Header.Set("Accept", "application/json;q=0.5, application/xml")
+	w := httptest.NewRecorder()
+
+	handler.HandleTestError(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "application/xml", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "<error-message>Test error</error-message>")
+	assert.Contains(t, w.Body.String(), "<details><test>true</test></details>")
+
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleGetDashboard_Success(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, &config.Config{ServerPort: "8080"})
+
+	dashboard := &appmodel.DashboardResponse{UserID: "user-1", Total: 3, Pending: 2, Completed: 1}
+	mockUseCase.On("GetDashboardUseCase", model.UserID("user-1")).Return(dashboard, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/users/user-1/dashboard", nil)
+	w := httptest.NewRecorder()
+
+	r := chi.NewRouter()
+	r.Get("/users/{id}/dashboard", handler.HandleGetDashboard)
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var result appmodel.DashboardResponse
+	json.Unmarshal(w.Body.Bytes(), &result)
+	assert.Equal(t, 3, result.Total)
+	assert.Equal(t, 2, result.Pending)
+
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleGetDashboard_ScopedToAuthenticatedUser(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+	mockUseCase.On("GetDashboardUseCase", model.UserID("user-1")).Return(&appmodel.DashboardResponse{UserID: "user-1"}, (*model.DomainError)(nil))
+	r := chi.NewRouter()
+	r.Get("/users/{id}/dashboard", handler.HandleGetDashboard)
+
+	for id, wantCode := range map[string]int{"user-1": http.StatusOK, "user-2": http.StatusForbidden} {
+		req := httptest.NewRequest("GET", "/users/"+id+"/dashboard", nil)
+		req = req.WithContext(port.WithAuthenticatedUser(req.Context(), "user-1"))
+		w := httptest.NewRecorder()
+
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, wantCode, w.Code, id)
+	}
+	mockUseCase.AssertNotCalled(t, "GetDashboardUseCase", model.UserID("user-2"))
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestRouter_CORSExposedHeaders(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	cfg := config.Default()
+	cfg.CORSAllowedOrigins = []string{"https://app.example.com"}
+	handler := NewTodoHTTPAdapter(mockUseCase, cfg)
+
+	domainError := model.NewDomainError(9001, 400, "Test error", "Test reason", nil)
+	mockUseCase.On("TestErrorUseCase").Return(domainError)
+
+	req := httptest.NewRequest("GET", "/test-error", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	exposed := w.Header().Get("Access-Control-Expose-Headers")
+	assert.Contains(t, exposed, "X-Total-Count")
+	assert.Contains(t, exposed, "X-Request-ID")
+	assert.Contains(t, exposed, "X-Error-Type")
+}
+
+func TestRouter_CORSExposedHeaders_OnlyForAllowedOrigins(t *testing.T) {
+	allowing := config.Default()
+	allowing.CORSAllowedOrigins = []string{"https://app.example.com"}
+
+	tests := []struct {
+		name   string
+		cfg    *config.Config
+		origin string
+	}{
+		{name: "no origins configured", cfg: config.Default(), origin: "https://app.example.com"},
+		{name: "disallowed origin", cfg: allowing, origin: "https://evil.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			mockUseCase.On("CountTodosUseCase", model.TodoFilter{}).Return(&appmodel.CountResponse{}, (*model.DomainError)(nil))
+
+			req := httptest.NewRequest("GET", "/todos/count", nil)
+			req.Header.Set("Origin", tt.origin)
+			w := httptest.NewRecorder()
+			NewTodoHTTPAdapter(mockUseCase, tt.cfg).Router().ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Empty(t, w.Header().Get("Access-Control-Expose-Headers"))
+			assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+		})
+	}
+}
+
+func TestRouter_CORSExposedHeaders_SameOrigin(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	domainError := model.NewDomainError(9001, 400, "Test error", "Test reason", nil)
+	mockUseCase.On("TestErrorUseCase").Return(domainError)
+
+	req := httptest.NewRequest("GET", "/test-error", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Empty(t, w.Header().Get("Access-Control-Expose-Headers"))
+}
+
+func TestRouter_CORSAllowedOrigins(t *testing.T) {
+	cfg := config.Default()
+	cfg.CORSAllowedOrigins = []string{"https://app.example.com"}
+
+	tests := []struct {
+		name       string
+		method     string
+		origin     string
+		preflight  bool
+		wantStatus int
+		wantOrigin string
+	}{
+		{name: "allowed preflight", method: "OPTIONS", origin: "https://app.example.com", preflight: true, wantStatus: http.StatusNoContent, wantOrigin: "https://app.example.com"},
+		{name: "disallowed preflight", method: "OPTIONS", origin: "https://evil.example.com", preflight: true, wantStatus: http.StatusNoContent},
+		{name: "allowed request", method: "GET", origin: "https://app.example.com", wantStatus: http.StatusOK, wantOrigin: "https://app.example.com"},
+		{name: "disallowed request", method: "GET", origin: "https://evil.example.com", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			mockUseCase.On("CountTodosUseCase", model.TodoFilter{}).Return(&appmodel.CountResponse{}, (*model.DomainError)(nil))
+			handler := NewTodoHTTPAdapter(mockUseCase, cfg)
+
+			req := httptest.NewRequest(tt.method, "/todos/count", nil)
+			req.Header.Set("Origin", tt.origin)
+			if tt.preflight {
+				req.Header.Set("Access-Control-Request-Method", "GET")
+			}
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantOrigin, w.Header().Get("Access-Control-Allow-Origin"))
+			if tt.preflight && tt.wantOrigin != "" {
+				assert.Equal(t, "GET, POST, PUT, DELETE", w.Header().Get("Access-Control-Allow-Methods"))
+				assert.Equal(t, "Accept, Content-Type, X-Consistency, X-Request-ID", w.Header().Get("Access-Control-Allow-Headers"))
+			} else {
+				assert.Empty(t, w.Header().Get("Access-Control-Allow-Methods"))
+			}
+			if tt.preflight {
+				mockUseCase.AssertNotCalled(t, "CountTodosUseCase", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestRouter_ConsistencyHeader(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     string
+		wantStatus int
+		want       port.ReadConsistency
+	}{
+		{name: "absent", wantStatus: http.StatusOK, want: port.ReadConsistencyEventual},
+		{name: "strong", header: "Strong", wantStatus: http.StatusOK, want: port.ReadConsistencyStrong},
+		{name: "eventual", header: "eventual", wantStatus: http.StatusOK, want: port.ReadConsistencyEventual},
+		{name: "unsupported", header: "linearizable", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewTodoHTTPAdapter(new(MockTodoUseCase), config.Default())
+			var got port.ReadConsistency
+			probe := routeFunc(func(r chi.Router) {
+				r.Get("/probe", func(w http.ResponseWriter, r *http.Request) {
+					got = port.ReadConsistencyFromContext(r.Context())
+				})
+			})
+
+			req := httptest.NewRequest("GET", "/probe", nil)
+			if tt.header != "" {
+				req.Header.Set("X-Consistency", tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			handler.Router(probe).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRouter_BearerAuth(t *testing.T) {
+	cfg := config.Default()
+	cfg.APIToken = "s3cret"
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		wantStatus    int
+	}{
+		{name: "valid token", path: "/todos/count", authorization: "Bearer s3cret", wantStatus: http.StatusOK},
+		{name: "scheme is case-insensitive", path: "/todos/count", authorization: "bearer s3cret", wantStatus: http.StatusOK},
+		{name: "missing header", path: "/todos/count", wantStatus: http.StatusUnauthorized},
+		{name: "wrong token", path: "/todos/count", authorization: "Bearer guess", wantStatus: http.StatusUnauthorized},
+		{name: "wrong scheme", path: "/todos/count", authorization: "Basic s3cret", wantStatus: http.StatusUnauthorized},
+		{name: "test-error is public", path: "/test-error", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			mockUseCase.On("CountTodosUseCase", model.TodoFilter{}).Return(&appmodel.CountResponse{}, (*model.DomainError)(nil))
+			mockUseCase.On("TestErrorUseCase").Return(model.ErrTestError)
+			handler := NewTodoHTTPAdapter(mockUseCase, cfg)
+
+			req := httptest.NewRequest("GET", tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+				assert.Contains(t, w.Body.String(), `"error_code":5003`)
+				mockUseCase.AssertNotCalled(t, "CountTodosUseCase", mock.Anything)
+			}
+		})
+	}
+}
+
+// acceptAuthenticator accepts a single token, standing in for a custom Authenticator
+type acceptAuthenticator string
+
+func (a acceptAuthenticator) Authenticate(ctx context.Context, token string) (model.UserID, error) {
+	if token != string(a) {
+		return "", errInvalidToken
+	}
+	return "", nil
+}
+
+func TestRouter_CustomAuthenticator(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	mockUseCase.On("CountTodosUseCase", model.TodoFilter{}).Return(&appmodel.CountResponse{}, (*model.DomainError)(nil))
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+	handler.UseAuthenticator(acceptAuthenticator("custom"))
+	router := handler.Router()
+
+	req := httptest.NewRequest("GET", "/todos/count", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req.Header.Set("Authorization", "Bearer custom")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestRouter_CORSDisabledByDefault(t *testing.T) {
+	handler := NewTodoHTTPAdapter(new(MockTodoUseCase), config.Default())
+
+	req := httptest.NewRequest("OPTIONS", "/todos", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.NotEqual(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Methods"))
+}
+
+func TestHandleGetErrorCatalog_TypeScript(t *testing.T) {
+	handler := NewTodoHTTPAdapter(new(MockTodoUseCase), config.Default())
+
+	for _, target := range []string{"/errors.ts", "/errors?format=ts"} {
+		t.Run(target, func(t *testing.T) {
+			req := httptest.NewRequest("GET", target, nil)
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Contains(t, w.Header().Get("Content-Type"), "text/typescript")
+			assert.Contains(t, w.Body.String(), "export const ErrorMessages = {")
+			assert.Contains(t, w.Body.String(), `2001: "Todo not found",`)
+			assert.Contains(t, w.Body.String(), "export type ErrorCode = keyof typeof ErrorMessages;")
+		})
+	}
+}
+
+func TestHandleGetErrorCatalog_JSON(t *testing.T) {
+	handler := NewTodoHTTPAdapter(new(MockTodoUseCase), config.Default())
+
+	req := httptest.NewRequest("GET", "/errors?format=json", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response ErrorCatalogResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(t, response.Errors, len(model.ErrorCatalog()))
+	assert.Contains(t, response.Errors, model.ErrTodoNotFound.ToResponse())
+}
+
+func TestHandleGetErrorCatalog_UnsupportedFormat(t *testing.T) {
+	handler := NewTodoHTTPAdapter(new(MockTodoUseCase), config.Default())
+
+	req := httptest.NewRequest("GET", "/errors?format=yaml", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Unsupported format")
+}
+
+func TestRouter_RequestID(t *testing.T) {
+	cfg := config.Default()
+	cfg.RequestIDHeader = []string{"X-Request-ID", "X-Correlation-ID"}
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    string
+	}{
+		{name: "canonical header", headers: map[string]string{"X-Request-ID": "req-1"}, want: "req-1"},
+		{name: "alternate header", headers: map[string]string{"X-Correlation-ID": "corr-1"}, want: "corr-1"},
+		{name: "first match wins", headers: map[string]string{"X-Request-ID": "req-1", "X-Correlation-ID": "corr-1"}, want: "req-1"},
+		{name: "unconfigured header ignored", headers: map[string]string{"Traceparent": "00-abc-def-01"}},
+		{name: "generated when absent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			mockUseCase.On("TestErrorUseCase").Return(model.ErrTestError)
+			handler := NewTodoHTTPAdapter(mockUseCase, cfg)
+
+			req := httptest.NewRequest("GET", "/test-error", nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			got := w.Header().Get("X-Request-ID")
+			if tt.want != "" {
+				assert.Equal(t, tt.want, got)
+			} else {
+				assert.NotEmpty(t, got)
+				assert.NotEqual(t, "00-abc-def-01", got)
+			}
+		})
+	}
+}
+
+func TestHandleSearchTodos(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+	mockUseCase.On("SearchTodosUseCase", "buy milk").Return(&appmodel.TodoListResponse{
+		Todos: []appmodel.TodoResponse{{ID: "a", Title: "Buy milk"}},
+		Count: 1,
+	}, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/todos/search?q=buy+milk", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response appmodel.TodoListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Count)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestRouter_RequestLogging(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	mockUseCase.On("TestErrorUseCase").Return(model.ErrTestError)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+	var logs bytes.Buffer
+	handler.logger = slog.New(slog.NewJSONHandler(&logs, nil))
+
+	req := httptest.NewRequest("GET", "/test-error", nil)
+	req.Header.Set("X-Request-ID", "req-1")
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Equal(t, "WARN", entry["level"])
+	assert.Equal(t, "GET", entry["method"])
+	assert.Equal(t, "/test-error", entry["path"])
+	assert.Equal(t, float64(http.StatusBadRequest), entry["status"])
+	assert.Equal(t, "req-1", entry["request_id"])
+	assert.Contains(t, entry, "duration")
+}
+
+func TestWriteDomainError_SanitizedInProduction(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{}).Return((*appmodel.TodoListResponse)(nil), model.ErrFailedToRetrieveTodos)
+	cfg := config.Default()
+	cfg.Environment = config.EnvironmentProduction
+	handler := NewTodoHTTPAdapter(mockUseCase, cfg)
+	var logs bytes.Buffer
+	handler.logger = slog.New(slog.NewJSONHandler(&logs, nil))
+
+	req := httptest.NewRequest("GET", "/todos", nil)
+	w := httptest.NewRecorder()
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	var response appmodel.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, model.ErrFailedToRetrieveTodos.GetErrorCode(), response.ErrorCode)
+	assert.Equal(t, "Service temporarily unavailable", response.ErrorMessage)
+	assert.Empty(t, response.Details)
+	assert.NotEqual(t, model.ErrFailedToRetrieveTodos.GetErrorMessage(), response.ErrorMessage)
+	assert.Contains(t, logs.String(), `"msg":"domain error sanitized"`)
+	assert.Contains(t, logs.String(), `"error_message":"Failed to retrieve todos"`)
+}
+
+func TestHandleReadyz_DatabaseErrorSanitizedInProduction(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	mockUseCase.On("ReadinessUseCase").Return(model.ErrDatabaseUnavailable.WithDetails(map[string]string{"reason": "dial tcp db.internal:5432"}))
+	cfg := config.Default()
+	cfg.Environment = config.EnvironmentProduction
+	handler := NewTodoHTTPAdapter(mockUseCase, cfg)
+	handler.logger = slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+
+	req := httptest.NewRequest("GET", "/readyz", nil)
+	w := httptest.NewRecorder()
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	var response appmodel.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, model.ErrDatabaseUnavailable.GetErrorCode(), response.ErrorCode)
+	assert.Equal(t, "Service temporarily unavailable", response.ErrorMessage)
+	assert.Empty(t, response.Details)
+	assert.NotContains(t, w.Body.String(), "db.internal")
+}
+
+func TestWriteDomainError_NotSanitizedInDevelopment(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{}).Return((*appmodel.TodoListResponse)(nil), model.ErrFailedToRetrieveTodos)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	req := httptest.NewRequest("GET", "/todos", nil)
+	w := httptest.NewRecorder()
+	handler.Router().ServeHTTP(w, req)
+
+	var response appmodel.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "Failed to retrieve todos", response.ErrorMessage)
+}
+
+// routeFunc adapts a function to RouteRegistrar so tests can add ad hoc routes
+type routeFunc func(r chi.Router)
+
+func (f routeFunc) RegisterRoutes(r chi.Router) { f(r) }
+
+func TestRouter_RecoversFromPanic(t *testing.T) {
+	handler := NewTodoHTTPAdapter(new(MockTodoUseCase), config.Default())
+	var logs bytes.Buffer
+	handler.logger = slog.New(slog.NewJSONHandler(&logs, nil))
+	panicking := routeFunc(func(r chi.Router) {
+		r.Get("/panic", func(w http.ResponseWriter, r *http.Request) { panic("boom") })
+	})
+
+	req := httptest.NewRequest("GET", "/panic", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router(panicking).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	var response appmodel.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, model.ErrInternalServer.GetErrorCode(), response.ErrorCode)
+	assert.Equal(t, "Internal server error", response.ErrorMessage)
+	assert.Contains(t, logs.String(), "http handler panicked")
+	assert.Contains(t, logs.String(), "boom")
+}
+
+func TestHandleCompletionTimeStats_Success(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	stats := &appmodel.CompletionTimeStatsResponse{Priorities: []appmodel.PriorityCompletionTime{
+		{Priority: "high", Completed: 2, AverageSeconds: 7200},
+	}}
+	mockUseCase.On("CompletionTimeStatsUseCase").Return(stats, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/stats/completion-time", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var result appmodel.CompletionTimeStatsResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, stats.Priorities, result.Priorities)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleListTodos_ServedStaleHeader(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	stale := &appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{{ID: "1", Title: "Cached"}}, Count: 1, Stale: true}
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{}).Return(stale, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/todos", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "true", w.Header().Get("X-Served-Stale"))
+	assert.Contains(t, w.Body.String(), "Cached")
+}
+
+func TestWriteDomainError_RetryAfter(t *testing.T) {
+	handler := NewTodoHTTPAdapter(new(MockTodoUseCase), config.Default())
+
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/todos", nil)
+			w := httptest.NewRecorder()
+
+			handler.writeDomainError(w, req, model.NewDomainError(9999, status, "Retry later", "", nil))
+
+			assert.Equal(t, status, w.Code)
+			seconds, err := strconv.Atoi(w.Header().Get("Retry-After"))
+			assert.NoError(t, err)
+			assert.Equal(t, 30, seconds)
+		})
+	}
+}
+
+func TestWriteDomainError_RetryAfterKeepsExistingValue(t *testing.T) {
+	handler := NewTodoHTTPAdapter(new(MockTodoUseCase), config.Default())
+
+	req := httptest.NewRequest("GET", "/todos", nil)
+	w := httptest.NewRecorder()
+	w.Header().Set("Retry-After", "5")
+
+	handler.writeDomainError(w, req, model.NewDomainError(9999, http.StatusTooManyRequests, "Slow down", "", nil))
+
+	assert.Equal(t, "5", w.Header().Get("Retry-After"))
+}
+
+func TestWriteDomainError_NoRetryAfterForClientErrors(t *testing.T) {
+	handler := NewTodoHTTPAdapter(new(MockTodoUseCase), config.Default())
+
+	req := httptest.NewRequest("GET", "/todos", nil)
+	w := httptest.NewRecorder()
+
+	handler.writeDomainError(w, req, model.ErrTodoNotFound)
+
+	assert.Empty(t, w.Header().Get("Retry-After"))
+}
+
+func TestHandleUncompleteTodos_ReportsFailed(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	ids := []model.TodoID{"completed", "pending"}
+	mockUseCase.On("UncompleteBatchUseCase", ids).Return([]model.TodoID{"pending"}, (*model.DomainError)(nil))
+
+	body, _ := json.Marshal(command.UncompleteTodosCommand{IDs: []string{"completed", "pending"}})
+	req := httptest.NewRequest("POST", "/todos/uncomplete-batch", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response appmodel.BatchResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, []string{"pending"}, response.Failed)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleBulkCompleteTodos_PartialFailureReturnsMultiStatus(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	ids := []model.TodoID{"pending", "missing"}
+	result := appmodel.NewBulkResult()
+	result.Succeeded = []string{"pending"}
+	result.Failed["missing"] = model.ErrTodoNotFound.GetErrorMessage()
+	mockUseCase.On("BulkCompleteTodosUseCase", ids).Return(result, (*model.DomainError)(nil))
+
+	body, _ := json.Marshal(command.BulkCompleteTodosCommand{IDs: []string{"pending", "missing"}})
+	req := httptest.NewRequest("POST", "/todos/bulk-complete", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusMultiStatus, w.Code)
+	var response appmodel.BulkResult
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(t, []string{"pending"}, response.Succeeded)
+	assert.Equal(t, model.ErrTodoNotFound.GetErrorMessage(), response.Failed["missing"])
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleBulkCompleteTodos_AllSucceededReturnsOK(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	result := appmodel.NewBulkResult()
+	result.Succeeded = []string{"a", "b"}
+	mockUseCase.On("BulkCompleteTodosUseCase", []model.TodoID{"a", "b"}).Return(result, (*model.DomainError)(nil))
+
+	body, _ := json.Marshal(command.BulkCompleteTodosCommand{IDs: []string{"a", "b"}})
+	req := httptest.NewRequest("POST", "/todos/bulk-complete", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"succeeded":["a","b"],"failed":{}}`, w.Body.String())
+}
+
+func TestParseIntParam(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    int
+		wantErr bool
+	}{
+		{name: "absent uses default", query: "", want: 20},
+		{name: "valid", query: "limit=10", want: 10},
+		{name: "whitespace padded", query: "limit=%2010%20", want: 10},
+		{name: "integral decimal", query: "limit=10.0", want: 10},
+		{name: "below minimum clamps", query: "limit=0", want: 1},
+		{name: "above maximum clamps", query: "limit=500", want: 100},
+		{name: "large decimal clamps", query: "limit=1e9", want: 100},
+		{name: "non-numeric", query: "limit=ten", wantErr: true},
+		{name: "fractional", query: "limit=10.5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/todos?"+tt.query, nil)
+
+			got, err := parseIntParam(req, "limit", 20, 1, 100)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				assert.Equal(t, model.ErrInvalidQueryParam.GetErrorCode(), err.GetErrorCode())
+				assert.Equal(t, "limit", err.GetDetails()["param"])
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHandleListStaleTodos(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		olderThan time.Duration
+		wantCode  int
+	}{
+		{name: "default age", query: "", olderThan: 720 * time.Hour, wantCode: http.StatusOK},
+		{name: "explicit age", query: "?older_than=48h", olderThan: 48 * time.Hour, wantCode: http.StatusOK},
+		{name: "invalid duration", query: "?older_than=soon", wantCode: http.StatusBadRequest},
+		{name: "negative duration", query: "?older_than=-1h", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+			if tt.wantCode == http.StatusOK {
+				mockUseCase.On("ListStaleTodosUseCase", tt.olderThan).Return(&appmodel.TodoListResponse{}, (*model.DomainError)(nil))
+			}
+
+			req := httptest.NewRequest("GET", "/todos/stale"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleListTodosByPriority(t *testing.T) {
+	tests := []struct {
+		name            string
+		query           string
+		includeArchived bool
+		wantCode        int
+	}{
+		{name: "archived excluded by default", query: "", includeArchived: false, wantCode: http.StatusOK},
+		{name: "archived included", query: "?include_archived=true", includeArchived: true, wantCode: http.StatusOK},
+		{name: "invalid flag", query: "?include_archived=maybe", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+			if tt.wantCode == http.StatusOK {
+				mockUseCase.On("ListTodosByPriorityUseCase", tt.includeArchived).Return(&appmodel.TodosByPriorityResponse{
+					High: []appmodel.TodoResponse{{ID: "h1"}},
+				}, (*model.DomainError)(nil))
+			}
+
+			req := httptest.NewRequest("GET", "/todos/by-priority"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode == http.StatusOK {
+				var body map[string][]map[string]interface{}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+				assert.Len(t, body["high"], 1)
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleCountTodos(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		filter   model.TodoFilter
+		wantCode int
+	}{
+		{name: "no filters", query: "", filter: model.TodoFilter{}, wantCode: http.StatusOK},
+		{name: "status and priority", query: "?status=pending&priority=high", filter: model.TodoFilter{Status: model.TodoStatusPending, Priority: model.TodoPriorityHigh}, wantCode: http.StatusOK},
+		{name: "search", query: "?q=%20milk%20", filter: model.TodoFilter{Search: "milk"}, wantCode: http.StatusOK},
+		{name: "category", query: "?category=errands", filter: model.TodoFilter{Category: "errands"}, wantCode: http.StatusOK},
+		{name: "invalid overdue", query: "?overdue=sometimes", wantCode: http.StatusBadRequest},
+		{name: "invalid status", query: "?status=done", wantCode: http.StatusBadRequest},
+		{name: "invalid priority", query: "?priority=urgent", wantCode: http.StatusBadRequest},
+		{name: "source", query: "?source=cli", filter: model.TodoFilter{Source: model.TodoSourceCLI}, wantCode: http.StatusOK},
+		{name: "invalid source", query: "?source=fax", wantCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockTodoUseCase)
+			handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+			if tt.wantCode == http.StatusOK {
+				mockUseCase.On("CountTodosUseCase", tt.filter).Return(&appmodel.CountResponse{Count: 2}, (*model.DomainError)(nil))
+			}
+
+			req := httptest.NewRequest("GET", "/todos/count"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode == http.StatusOK {
+				assert.JSONEq(t, `{"count": 2}`, w.Body.String())
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleCountTodos_MatchesListTotal(t *testing.T) {
+	repo := repository.NewInMemoryTodoRepository()
+	ctx := context.Background()
+	seed := func(title string, priority model.TodoPriority, configure func(*model.Todo)) {
+		todo := model.NewTodo(title, title+" notes", priority)
+		configure(todo)
+		assert.NoError(t, repo.Save(ctx, todo))
+	}
+	seed("Buy milk", model.TodoPriorityHigh, func(todo *model.Todo) {
+		assert.NoError(t, todo.AddTag("home"))
+		assert.NoError(t, todo.AssignCategory("errands"))
+	})
+	seed("Pay rent", model.TodoPriorityHigh, func(todo *model.Todo) {
+		assert.NoError(t, todo.AssignCategory("errands"))
+		assert.NoError(t, todo.SetDueDate(time.Now().Add(10*time.Millisecond)))
+	})
+	seed("Write report", model.TodoPriorityLow, func(todo *model.Todo) {
+		assert.NoError(t, todo.SetSource(model.TodoSourceCLI))
+		assert.NoError(t, todo.MarkAsCompleted())
+	})
+	seed("Milk the goat", model.TodoPriorityMedium, func(todo *model.Todo) {
+		assert.NoError(t, todo.AddTag("home"))
+	})
+	time.Sleep(20 * time.Millisecond)
+	handler := NewTodoHTTPAdapter(usecase.NewTodoUseCase(repo, service.NewTodoDomainService()), config.Default())
+
+	for _, filters := range []string{
+		"",
+		"status=pending",
+		"priority=high",
+		"q=milk",
+		"q=MILK&priority=medium",
+		"overdue=true",
+		"tag=home",
+		"source=cli",
+		"category=errands",
+		"category=errands&priority=high&status=pending",
+		"include_deleted=true",
+		"q=notes&status=completed",
+	} {
+		t.Run(filters, func(t *testing.T) {
+			list := httptest.NewRecorder()
+			handler.Router().ServeHTTP(list, httptest.NewRequest("GET", "/todos?"+filters, nil))
+			count := httptest.NewRecorder()
+			handler.Router().ServeHTTP(count, httptest.NewRequest("GET", "/todos/count?"+filters, nil))
+
+			assert.Equal(t, http.StatusOK, list.Code)
+			assert.Equal(t, http.StatusOK, count.Code)
+			var body appmodel.CountResponse
+			assert.NoError(t, json.Unmarshal(count.Body.Bytes(), &body))
+			assert.Equal(t, list.Header().Get("X-Total-Count"), strconv.Itoa(body.Count))
+		})
+	}
+}
+
+func TestHandleValidateField_Invalid(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	cmd := command.ValidateFieldCommand{Field: "priority", Value: "urgent"}
+	errResponse := model.ErrInvalidPriority.ToResponse()
+	mockUseCase.On("ValidateFieldUseCase", cmd).Return(&appmodel.FieldValidationResponse{Error: &errResponse}, (*model.DomainError)(nil))
+
+	body, _ := json.Marshal(cmd)
+	req := httptest.NewRequest("POST", "/todos/validate-field", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var result appmodel.FieldValidationResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.False(t, result.Valid)
+	assert.Equal(t, "Invalid priority", result.Error.ErrorMessage)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleValidateField_UnknownField(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	cmd := command.ValidateFieldCommand{Field: "colour", Value: "red"}
+	mockUseCase.On("ValidateFieldUseCase", cmd).Return(nil, model.ErrUnknownField)
+
+	body, _ := json.Marshal(cmd)
+	req := httptest.NewRequest("POST", "/todos/validate-field", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Unknown field")
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		allow  string
+	}{
+		{name: "todo by id", method: "PATCH", path: "/todos/123", allow: "GET, PUT, DELETE"},
+		{name: "todo collection", method: "DELETE", path: "/todos", allow: "GET, POST"},
+		{name: "complete", method: "GET", path: "/todos/123/complete", allow: "PUT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+			assert.Equal(t, tt.allow, w.Header().Get("Allow"))
+			var result appmodel.ErrorResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+			assert.Equal(t, model.ErrMethodNotAllowed.GetErrorCode(), result.ErrorCode)
+		})
+	}
+	mockUseCase.AssertNotCalled(t, "GetTodoUseCase", mock.Anything)
+}
+
+func TestHandleDeleteTodo_Success(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	mockUseCase.On("DeleteTodoUseCase", model.TodoID("test-id")).Return((*model.DomainError)(nil))
+
+	req := httptest.NewRequest("DELETE", "/todos/test-id", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response map[string]string
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "Todo deleted successfully", response["message"])
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleDeleteTodo_NotFound(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	mockUseCase.On("DeleteTodoUseCase", model.TodoID("missing")).Return(model.ErrTodoNotFound)
+
+	req := httptest.NewRequest("DELETE", "/todos/missing", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestRouteNotFound(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	req := httptest.NewRequest("GET", "/does-not-exist", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	var result appmodel.ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, model.ErrRouteNotFound.GetErrorCode(), result.ErrorCode)
+	assert.Equal(t, "/does-not-exist", result.Details["path"])
+}
+
+func TestRouteNotFound_SwaggerStillServed(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	req := httptest.NewRequest("GET", "/swagger/index.html", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "Route not found")
+}
+
+func TestRouter_NilConfigSkipsSwagger(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, nil)
+	mockUseCase.On("GetTodoUseCase", model.TodoID("abc")).Return(&appmodel.TodoResponse{ID: "abc"}, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/swagger/index.html", nil)
+	w := httptest.NewRecorder()
+	handler.Router().ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	req = httptest.NewRequest("GET", "/todos/abc", nil)
+	w = httptest.NewRecorder()
+	handler.Router().ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandleListTodos_Paginated(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	response := &appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{{ID: "3", Title: "Todo 3"}}, Count: 1, Total: 7}
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{Limit: 1, Offset: 2}).Return(response, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/todos?limit=1&offset=2", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleListTodos(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "7", w.Header().Get("X-Total-Count"))
+	var result appmodel.TodoListResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, 1, result.Count)
+	assert.Equal(t, 7, result.Total)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleListTodos_IncludeDeleted(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	response := &appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{{ID: "gone", Deleted: true}}, Count: 1}
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{IncludeDeleted: true}).Return(response, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/todos?include_deleted=true", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleListTodos(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var result struct {
+		Todos []map[string]interface{} `json:"todos"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, true, result.Todos[0]["deleted"])
+	mockUseCase.AssertExpectations(t)
+
+	req = httptest.NewRequest("GET", "/todos?include_deleted=sometimes", nil)
+	w = httptest.NewRecorder()
+	handler.HandleListTodos(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandleListTodos_InvalidLimit(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	req := httptest.NewRequest("GET", "/todos?limit=ten", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleListTodos(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockUseCase.AssertNotCalled(t, "ListTodosUseCase", mock.Anything)
+}
+
+func TestHandleListTodos_StatusFilter(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	response := &appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{}, Count: 0, Total: 0}
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{StatusFilter: "pending"}).Return(response, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/todos?status=pending", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleListTodos(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleListTodos_PriorityFilterAndSort(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	q := query.ListTodosQuery{PriorityFilter: "high", SortBy: "priority", SortOrder: "desc"}
+	response := &appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{}}
+	mockUseCase.On("ListTodosUseCase", q).Return(response, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/todos?priority=high&sort_by=priority&sort_order=desc", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleListTodos(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleListTodos_Overdue(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	response := &appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{}}
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{Overdue: true}).Return(response, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/todos?overdue=true", nil)
+	w := httptest.NewRecorder()
+	handler.HandleListTodos(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req = httptest.NewRequest("GET", "/todos?overdue=soon", nil)
+	w = httptest.NewRecorder()
+	handler.HandleListTodos(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockUseCase.AssertNumberOfCalls(t, "ListTodosUseCase", 1)
+}
+
+func TestHandleListTodos_SearchTerm(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{SearchTerm: "report"}).
+		Return(&appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{}}, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/todos?q=+report+", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleListTodos(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandleListTodos_TagFilter(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	response := &appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{
+		appmodel.TodoResponseMapper(model.NewSimpleTodo("Untagged")),
+	}, Count: 1, Total: 1}
+	mockUseCase.On("ListTodosUseCase", query.ListTodosQuery{TagFilter: "work"}).Return(response, (*model.DomainError)(nil))
+
+	req := httptest.NewRequest("GET", "/todos?tag=work", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleListTodos(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	// Tags always serialize as an array, even when empty
+	assert.Contains(t, w.Body.String(), `"tags":[]`)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestURLGuard(t *testing.T) {
+	cfg := config.Default()
+	cfg.MaxURLLength = 64
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, cfg)
+
+	tests := []struct {
+		name     string
+		path     string
+		status   int
+		wantCode int
+	}{
+		{name: "over-length URL", path: "/todos/" + strings.Repeat("a", 64), status: http.StatusRequestURITooLong, wantCode: model.ErrURITooLong.GetErrorCode()},
+		{name: "over-length query", path: "/todos?title=" + strings.Repeat("a", 64), status: http.StatusRequestURITooLong, wantCode: model.ErrURITooLong.GetErrorCode()},
+		{name: "control character id", path: "/todos/abc%00def", status: http.StatusBadRequest, wantCode: model.ErrInvalidPathParam.GetErrorCode()},
+		{name: "newline id", path: "/todos/abc%0Adef/complete", status: http.StatusBadRequest, wantCode: model.ErrInvalidPathParam.GetErrorCode()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Router().ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status, w.Code)
+			var result appmodel.ErrorResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+			assert.Equal(t, tt.wantCode, result.ErrorCode)
+		})
+	}
+	mockUseCase.AssertNotCalled(t, "GetTodoUseCase", mock.Anything)
+}
+
+func TestHandleGetExamplePayloads(t *testing.T) {
+	mockUseCase := new(MockTodoUseCase)
+	handler := NewTodoHTTPAdapter(mockUseCase, config.Default())
+
+	req := httptest.NewRequest("GET", "/todos/example", nil)
+	w := httptest.NewRecorder()
+
+	handler.Router().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var result appmodel.ExamplePayloadsResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+
+	cmd := result.CreateTodo
+	assert.Nil(t, service.NewTodoDomainService().ValidateCreateTodoCommand(cmd.Title, cmd.Description, cmd.Priority))
+	assert.Equal(t, cmd.Title, result.Todo.Title)
+	assert.Equal(t, cmd.Priority, result.Todo.Priority)
+	assert.Equal(t, string(model.TodoStatusPending), result.Todo.Status)
+	mockUseCase.AssertNotCalled(t, "GetTodoUseCase", mock.Anything)
+}
@@ -0,0 +1,156 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+// UserHTTPAdapter implements HTTP endpoints using the UserUseCasePort
+type UserHTTPAdapter struct {
+	usecase port.UserUseCasePort
+	responder
+}
+
+var _ RouteRegistrar = (*UserHTTPAdapter)(nil)
+
+// NewUserHTTPAdapter creates a new User HTTP handler
+func NewUserHTTPAdapter(usecase port.UserUseCasePort, cfg *config.Config) *UserHTTPAdapter {
+	return &UserHTTPAdapter{
+		usecase:   usecase,
+		responder: responder{config: cfg, logger: slog.Default()},
+	}
+}
+
+// RegisterRoutes adds the user endpoints to the given router
+func (h *UserHTTPAdapter) RegisterRoutes(r chi.Router) {
+	r.Post("/users", h.HandleCreateUser)
+	r.Get("/users/{id}", h.HandleGetUser)
+	r.Put("/users/{id}/profile", h.HandleUpdateUserProfile)
+	r.Put("/users/{id}/promote", h.HandlePromoteUser)
+	r.Put("/users/{id}/suspend", h.HandleSuspendUser)
+}
+
+// HandleCreateUser handles POST /users
+// @Summary Create a new user
+// @Description Register a new user with a unique email
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param user body command.CreateUserCommand true "User to create"
+// @Success 201 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 409 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /users [post]
+func (h *UserHTTPAdapter) HandleCreateUser(w http.ResponseWriter, r *http.Request) {
+	var cmd command.CreateUserCommand
+	if err := h.parseJSON(r, &cmd); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	id, err := h.usecase.CreateUserUseCase(cmd)
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusCreated, map[string]string{"id": string(id)})
+}
+
+// HandleGetUser handles GET /users/{id}
+// @Summary Get a user
+// @Description Get a user by ID
+// @Tags users
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} appmodel.UserResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Router /users/{id} [get]
+func (h *UserHTTPAdapter) HandleGetUser(w http.ResponseWriter, r *http.Request) {
+	response, err := h.usecase.GetUserUseCase(model.UserID(chi.URLParam(r, "id")))
+	if err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, response)
+}
+
+// HandleUpdateUserProfile handles PUT /users/{id}/profile
+// @Summary Update a user's profile
+// @Description Update a user's name and email; omitted fields are left unchanged
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param profile body command.UpdateUserProfileCommand true "Profile updates"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Failure 409 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /users/{id}/profile [put]
+func (h *UserHTTPAdapter) HandleUpdateUserProfile(w http.ResponseWriter, r *http.Request) {
+	var cmd command.UpdateUserProfileCommand
+	if err := h.parseJSON(r, &cmd); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	cmd.ID = chi.URLParam(r, "id")
+	if err := h.usecase.UpdateUserProfileUseCase(cmd); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "User profile updated successfully"})
+}
+
+// HandlePromoteUser handles PUT /users/{id}/promote
+// @Summary Promote a user
+// @Description Grant a user the admin role
+// @Tags users
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /users/{id}/promote [put]
+func (h *UserHTTPAdapter) HandlePromoteUser(w http.ResponseWriter, r *http.Request) {
+	if err := h.usecase.PromoteUserUseCase(command.PromoteUserCommand{ID: chi.URLParam(r, "id")}); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "User promoted successfully"})
+}
+
+// HandleSuspendUser handles PUT /users/{id}/suspend
+// @Summary Suspend a user
+// @Description Suspend a user account
+// @Tags users
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} appmodel.ErrorResponse
+// @Failure 404 {object} appmodel.ErrorResponse
+// @Failure 500 {object} appmodel.ErrorResponse
+// @Router /users/{id}/suspend [put]
+func (h *UserHTTPAdapter) HandleSuspendUser(w http.ResponseWriter, r *http.Request) {
+	if err := h.usecase.SuspendUserUseCase(command.SuspendUserCommand{ID: chi.URLParam(r, "id")}); err != nil {
+		h.writeDomainError(w, r, err)
+		return
+	}
+
+	h.writeResponse(w, r, http.StatusOK, map[string]string{"message": "User suspended successfully"})
+}
@@ -0,0 +1,155 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+type MockUserUseCase struct {
+	mock.Mock
+}
+
+func (m *MockUserUseCase) CreateUserUseCase(cmd command.CreateUserCommand) (model.UserID, *model.DomainError) {
+	args := m.Called(cmd)
+	return args.Get(0).(model.UserID), args.Get(1).(*model.DomainError)
+}
+
+func (m *MockUserUseCase) GetUserUseCase(id model.UserID) (*appmodel.UserResponse, *model.DomainError) {
+	args := m.Called(id)
+	if resp, ok := args.Get(0).(*appmodel.UserResponse); ok {
+		return resp, args.Get(1).(*model.DomainError)
+	}
+	return nil, args.Get(1).(*model.DomainError)
+}
+
+func (m *MockUserUseCase) UpdateUserProfileUseCase(cmd command.UpdateUserProfileCommand) *model.DomainError {
+	args := m.Called(cmd)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockUserUseCase) PromoteUserUseCase(cmd command.PromoteUserCommand) *model.DomainError {
+	args := m.Called(cmd)
+	return args.Get(0).(*model.DomainError)
+}
+
+func (m *MockUserUseCase) SuspendUserUseCase(cmd command.SuspendUserCommand) *model.DomainError {
+	args := m.Called(cmd)
+	return args.Get(0).(*model.DomainError)
+}
+
+// newUserRouter serves the user routes on the todo router, as main.go does
+func newUserRouter(userUseCase *MockUserUseCase) http.Handler {
+	cfg := config.Default()
+	todoHandler := NewTodoHTTPAdapter(new(MockTodoUseCase), cfg)
+	return todoHandler.Router(NewUserHTTPAdapter(userUseCase, cfg))
+}
+
+func TestHandleCreateUser(t *testing.T) {
+	cmd := command.CreateUserCommand{Email: "ada@example.com", Username: "ada", FirstName: "Ada", LastName: "Lovelace"}
+
+	tests := []struct {
+		name       string
+		err        *model.DomainError
+		wantStatus int
+	}{
+		{name: "created", err: nil, wantStatus: http.StatusCreated},
+		{name: "invalid email", err: model.ErrInvalidEmail, wantStatus: http.StatusBadRequest},
+		{name: "duplicate email", err: model.ErrDuplicateEmail, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockUserUseCase)
+			mockUseCase.On("CreateUserUseCase", cmd).Return(model.UserID("user-1"), tt.err)
+
+			body, _ := json.Marshal(cmd)
+			req := httptest.NewRequest("POST", "/users", bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+
+			newUserRouter(mockUseCase).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.err != nil {
+				var result appmodel.ErrorResponse
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+				assert.Equal(t, tt.err.GetErrorCode(), result.ErrorCode)
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleGetUser(t *testing.T) {
+	mockUseCase := new(MockUserUseCase)
+	mockUseCase.On("GetUserUseCase", model.UserID("user-1")).Return(&appmodel.UserResponse{ID: "user-1", Email: "ada@example.com"}, (*model.DomainError)(nil))
+	mockUseCase.On("GetUserUseCase", model.UserID("missing")).Return(nil, model.ErrUserNotFound)
+	router := newUserRouter(mockUseCase)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/users/user-1", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var user appmodel.UserResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &user))
+	assert.Equal(t, "ada@example.com", user.Email)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/users/missing", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandleUpdateUserProfile(t *testing.T) {
+	mockUseCase := new(MockUserUseCase)
+	mockUseCase.On("UpdateUserProfileUseCase", command.UpdateUserProfileCommand{ID: "user-1", LastName: "King"}).Return((*model.DomainError)(nil))
+
+	req := httptest.NewRequest("PUT", "/users/user-1/profile", bytes.NewBufferString(`{"last-name":"King"}`))
+	w := httptest.NewRecorder()
+
+	newUserRouter(mockUseCase).ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestHandlePromoteAndSuspendUser(t *testing.T) {
+	mockUseCase := new(MockUserUseCase)
+	mockUseCase.On("PromoteUserUseCase", command.PromoteUserCommand{ID: "user-1"}).Return((*model.DomainError)(nil))
+	mockUseCase.On("SuspendUserUseCase", command.SuspendUserCommand{ID: "user-1"}).Return(model.ErrCannotSuspendUser)
+	router := newUserRouter(mockUseCase)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("PUT", "/users/user-1/promote", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("PUT", "/users/user-1/suspend", nil))
+	assert.Equal(t, model.ErrCannotSuspendUser.GetHttpStatus(), w.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestUserRoutesShareTodoRouter(t *testing.T) {
+	router := newUserRouter(new(MockUserUseCase))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+
+	var index APIIndexResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &index))
+	assert.Contains(t, index.Endpoints, "POST /users")
+	assert.Contains(t, index.Endpoints, "GET /todos")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("DELETE", "/users/user-1", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "GET", w.Header().Get("Allow"))
+}
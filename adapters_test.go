@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startAdapters runs the given adapters in the background and returns a stop function
+// that cancels them and reports runAdapters' result
+func startAdapters(t *testing.T, runners map[string]adapterRunner, enabled []string) func() error {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() { result <- runAdapters(ctx, runners, enabled) }()
+
+	return func() error {
+		cancel()
+		select {
+		case err := <-result:
+			return err
+		case <-time.After(5 * time.Second):
+			t.Fatal("adapters did not stop")
+			return nil
+		}
+	}
+}
+
+// httpTestRunners returns runners whose HTTP adapter serves a health handler on a local port,
+// and a CLI stand-in that idles until cancelled
+func httpTestRunners(t *testing.T) (map[string]adapterRunner, string) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})}
+	runners := map[string]adapterRunner{
+		"http": func(ctx context.Context) error { return serveHTTP(ctx, server, ln) },
+		"cli": func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		},
+	}
+	return runners, "http://" + ln.Addr().String()
+}
+
+func TestRunAdapters_StartsHTTPWhenEnabled(t *testing.T) {
+	runners, url := httpTestRunners(t)
+	stop := startAdapters(t, runners, []string{"http", "cli"})
+
+	assert.Eventually(t, func() bool {
+		resp, err := http.Get(url)
+		if err != nil {
+			return false
+		}
+		resp.Body.Close()
+		return resp.StatusCode == http.StatusNoContent
+	}, 2*time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, stop())
+
+	_, err := http.Get(url)
+	assert.Error(t, err, "server should be shut down")
+}
+
+func TestRunAdapters_HTTPStaysDownWhenNotListed(t *testing.T) {
+	runners, url := httpTestRunners(t)
+	httpStarted := false
+	serve := runners["http"]
+	runners["http"] = func(ctx context.Context) error {
+		httpStarted = true
+		return serve(ctx)
+	}
+	stop := startAdapters(t, runners, []string{"cli"})
+
+	client := &http.Client{Timeout: 100 * time.Millisecond}
+	_, err := client.Get(url)
+	assert.Error(t, err)
+
+	assert.NoError(t, stop())
+	assert.False(t, httpStarted)
+}
+
+func TestRunAdapters_FirstStopShutsDownOthers(t *testing.T) {
+	runners, _ := httpTestRunners(t)
+	runners["cli"] = func(ctx context.Context) error { return nil }
+
+	done := make(chan error, 1)
+	go func() { done <- runAdapters(context.Background(), runners, []string{"http", "cli"}) }()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("HTTP adapter kept running after the CLI exited")
+	}
+}
+
+func TestRunAdapters_UnavailableAdapter(t *testing.T) {
+	runners, _ := httpTestRunners(t)
+
+	err := runAdapters(context.Background(), runners, []string{"http", "grpc"})
+	assert.ErrorContains(t, err, `adapter "grpc" is not available`)
+}
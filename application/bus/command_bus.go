@@ -0,0 +1,83 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// handlerFunc is a type-erased handler stored by a bus
+type handlerFunc func(ctx context.Context, msg any) (any, *model.DomainError)
+
+// registry maps message types to handlers; it backs both the command and the query bus
+type registry struct {
+	handlers map[reflect.Type]handlerFunc
+	// unknown is returned for unregistered message types and mismatched results
+	unknown *model.DomainError
+	kind    string
+}
+
+func newRegistry(unknown *model.DomainError, kind string) registry {
+	return registry{handlers: make(map[reflect.Type]handlerFunc), unknown: unknown, kind: kind}
+}
+
+// register stores the handler for messages of type M, replacing any previous one
+func register[M any, R any](r *registry, handler func(ctx context.Context, msg M) (R, *model.DomainError)) {
+	r.handlers[reflect.TypeFor[M]()] = func(ctx context.Context, msg any) (any, *model.DomainError) {
+		return handler(ctx, msg.(M))
+	}
+}
+
+// dispatch runs the handler registered for the message's type
+func (r *registry) dispatch(ctx context.Context, msg any) (any, *model.DomainError) {
+	handler, ok := r.handlers[reflect.TypeOf(msg)]
+	if !ok {
+		return nil, r.unknown.WithDetails(map[string]string{r.kind: fmt.Sprintf("%T", msg)})
+	}
+	return handler(ctx, msg)
+}
+
+// dispatchAs dispatches msg and returns its result as R
+func dispatchAs[R any](ctx context.Context, r *registry, msg any) (R, *model.DomainError) {
+	var zero R
+	result, err := r.dispatch(ctx, msg)
+	if err != nil {
+		return zero, err
+	}
+	typed, ok := result.(R)
+	if !ok {
+		return zero, r.unknown.WithDetails(map[string]string{
+			r.kind:   fmt.Sprintf("%T", msg),
+			"result": fmt.Sprintf("%T", result),
+		})
+	}
+	return typed, nil
+}
+
+// CommandBus routes commands to the handler registered for their concrete type,
+// so adapters depend on command types rather than use case method signatures
+type CommandBus struct {
+	registry
+}
+
+// NewCommandBus creates an empty CommandBus
+func NewCommandBus() *CommandBus {
+	return &CommandBus{registry: newRegistry(model.ErrUnknownCommand, "command")}
+}
+
+// RegisterCommand registers the handler for commands of type C, replacing any previous one
+func RegisterCommand[C any, R any](b *CommandBus, handler func(ctx context.Context, cmd C) (R, *model.DomainError)) {
+	register(&b.registry, handler)
+}
+
+// Dispatch runs the handler registered for the command's type
+func (b *CommandBus) Dispatch(ctx context.Context, cmd any) (any, *model.DomainError) {
+	return b.dispatch(ctx, cmd)
+}
+
+// DispatchCommand dispatches cmd and returns its result as R
+func DispatchCommand[R any](ctx context.Context, b *CommandBus, cmd any) (R, *model.DomainError) {
+	return dispatchAs[R](ctx, &b.registry, cmd)
+}
@@ -0,0 +1,65 @@
+package bus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// stubTodoUseCase records the commands it receives; unimplemented methods panic
+type stubTodoUseCase struct {
+	port.TodoUseCasePort
+	created  []command.CreateTodoCommand
+	archived []model.TodoID
+}
+
+func (s *stubTodoUseCase) CreateTodoUseCase(ctx context.Context, cmd command.CreateTodoCommand) (model.TodoID, *model.DomainError) {
+	s.created = append(s.created, cmd)
+	return "new-id", nil
+}
+
+func (s *stubTodoUseCase) ArchiveTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	s.archived = append(s.archived, id)
+	return model.ErrCannotArchiveTodo
+}
+
+func TestTodoCommandBus_DispatchCreateTodoCommand(t *testing.T) {
+	uc := &stubTodoUseCase{}
+	b := NewTodoCommandBus(uc)
+	cmd := command.CreateTodoCommand{Title: "Buy milk", Priority: "low"}
+
+	id, err := DispatchCommand[model.TodoID](context.Background(), b, cmd)
+	assert.Nil(t, err)
+	assert.Equal(t, model.TodoID("new-id"), id)
+	assert.Equal(t, []command.CreateTodoCommand{cmd}, uc.created)
+}
+
+func TestTodoCommandBus_PropagatesDomainError(t *testing.T) {
+	uc := &stubTodoUseCase{}
+	b := NewTodoCommandBus(uc)
+
+	_, err := DispatchCommand[NoResult](context.Background(), b, command.ArchiveTodoCommand{ID: "todo-1"})
+	assert.Equal(t, model.ErrCannotArchiveTodo, err)
+	assert.Equal(t, []model.TodoID{"todo-1"}, uc.archived)
+}
+
+func TestCommandBus_UnknownCommand(t *testing.T) {
+	b := NewCommandBus()
+
+	result, err := b.Dispatch(context.Background(), command.CreateTodoCommand{Title: "Buy milk"})
+	assert.Nil(t, result)
+	assert.Equal(t, model.ErrUnknownCommand.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, "command.CreateTodoCommand", err.GetDetails()["command"])
+}
+
+func TestDispatchCommand_ResultTypeMismatch(t *testing.T) {
+	b := NewTodoCommandBus(&stubTodoUseCase{})
+
+	_, err := DispatchCommand[string](context.Background(), b, command.CreateTodoCommand{Title: "Buy milk"})
+	assert.Equal(t, model.ErrUnknownCommand.GetErrorCode(), err.GetErrorCode())
+}
@@ -0,0 +1,32 @@
+package bus
+
+import (
+	"context"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// QueryBus routes read-side queries to the handler registered for their concrete type
+type QueryBus struct {
+	registry
+}
+
+// NewQueryBus creates an empty QueryBus
+func NewQueryBus() *QueryBus {
+	return &QueryBus{registry: newRegistry(model.ErrUnknownQuery, "query")}
+}
+
+// RegisterQuery registers the handler for queries of type Q, replacing any previous one
+func RegisterQuery[Q any, R any](b *QueryBus, handler func(ctx context.Context, q Q) (R, *model.DomainError)) {
+	register(&b.registry, handler)
+}
+
+// Dispatch runs the handler registered for the query's type
+func (b *QueryBus) Dispatch(ctx context.Context, q any) (any, *model.DomainError) {
+	return b.dispatch(ctx, q)
+}
+
+// DispatchQuery dispatches q and returns its result as R
+func DispatchQuery[R any](ctx context.Context, b *QueryBus, q any) (R, *model.DomainError) {
+	return dispatchAs[R](ctx, &b.registry, q)
+}
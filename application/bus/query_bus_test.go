@@ -0,0 +1,55 @@
+package bus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/application/query"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// stubTodoQueries records the queries it receives; unimplemented methods panic
+type stubTodoQueries struct {
+	port.TodoUseCasePort
+	listed []query.ListTodosQuery
+}
+
+func (s *stubTodoQueries) ListTodosUseCase(ctx context.Context, q query.ListTodosQuery) (*appmodel.TodoListResponse, *model.DomainError) {
+	s.listed = append(s.listed, q)
+	return &appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{{ID: "todo-1"}}, Count: 1, Total: 4}, nil
+}
+
+func (s *stubTodoQueries) GetTodoUseCase(ctx context.Context, id model.TodoID) (*appmodel.TodoResponse, *model.DomainError) {
+	return nil, model.ErrTodoNotFound
+}
+
+func TestTodoQueryBus_DispatchListTodosQuery(t *testing.T) {
+	uc := &stubTodoQueries{}
+	b := NewTodoQueryBus(uc)
+	q := query.ListTodosQuery{StatusFilter: "pending", PriorityFilter: "high", SortBy: "title", Limit: 1}
+
+	resp, err := DispatchQuery[*appmodel.TodoListResponse](context.Background(), b, q)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, resp.Total)
+	assert.Equal(t, []query.ListTodosQuery{q}, uc.listed)
+}
+
+func TestTodoQueryBus_DispatchGetTodoQuery(t *testing.T) {
+	b := NewTodoQueryBus(&stubTodoQueries{})
+
+	resp, err := DispatchQuery[*appmodel.TodoResponse](context.Background(), b, query.GetTodoQuery{ID: "missing"})
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrTodoNotFound, err)
+}
+
+func TestQueryBus_UnknownQuery(t *testing.T) {
+	b := NewQueryBus()
+
+	_, err := b.Dispatch(context.Background(), query.GetTodoQuery{ID: "todo-1"})
+	assert.Equal(t, model.ErrUnknownQuery.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, "query.GetTodoQuery", err.GetDetails()["query"])
+}
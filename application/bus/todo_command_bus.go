@@ -0,0 +1,64 @@
+package bus
+
+import (
+	"context"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// NoResult is returned by commands that only report success or failure
+type NoResult struct{}
+
+// NewTodoCommandBus registers every todo command against the given use case port
+func NewTodoCommandBus(uc port.TodoUseCasePort) *CommandBus {
+	b := NewCommandBus()
+	RegisterCommand(b, func(ctx context.Context, cmd command.CreateTodoCommand) (model.TodoID, *model.DomainError) {
+		return uc.CreateTodoUseCase(ctx, cmd)
+	})
+	RegisterCommand(b, func(ctx context.Context, cmd command.UpdateTodoCommand) (NoResult, *model.DomainError) {
+		return NoResult{}, uc.UpdateTodoUseCase(ctx, cmd)
+	})
+	RegisterCommand(b, func(ctx context.Context, cmd command.CompleteTodoCommand) (NoResult, *model.DomainError) {
+		return NoResult{}, uc.CompleteTodoUseCase(ctx, model.TodoID(cmd.ID))
+	})
+	RegisterCommand(b, func(ctx context.Context, cmd command.UncompleteTodoCommand) (NoResult, *model.DomainError) {
+		return NoResult{}, uc.UncompleteTodoUseCase(ctx, model.TodoID(cmd.ID))
+	})
+	RegisterCommand(b, func(ctx context.Context, cmd command.ReopenTodoCommand) (NoResult, *model.DomainError) {
+		return NoResult{}, uc.ReopenTodoUseCase(ctx, model.TodoID(cmd.ID))
+	})
+	RegisterCommand(b, func(ctx context.Context, cmd command.ArchiveTodoCommand) (NoResult, *model.DomainError) {
+		return NoResult{}, uc.ArchiveTodoUseCase(ctx, model.TodoID(cmd.ID))
+	})
+	RegisterCommand(b, func(ctx context.Context, cmd command.UnarchiveTodoCommand) (NoResult, *model.DomainError) {
+		return NoResult{}, uc.UnarchiveTodoUseCase(ctx, model.TodoID(cmd.ID))
+	})
+	RegisterCommand(b, func(ctx context.Context, cmd command.DeleteTodoCommand) (NoResult, *model.DomainError) {
+		return NoResult{}, uc.DeleteTodoUseCase(ctx, model.TodoID(cmd.ID))
+	})
+	RegisterCommand(b, func(ctx context.Context, cmd command.DeleteTodosCommand) ([]model.TodoID, *model.DomainError) {
+		return uc.DeleteTodosUseCase(ctx, toTodoIDs(cmd.IDs))
+	})
+	RegisterCommand(b, func(ctx context.Context, cmd command.UncompleteTodosCommand) ([]model.TodoID, *model.DomainError) {
+		return uc.UncompleteBatchUseCase(ctx, toTodoIDs(cmd.IDs))
+	})
+	RegisterCommand(b, func(ctx context.Context, cmd command.BulkCompleteTodosCommand) (*appmodel.BulkResult, *model.DomainError) {
+		return uc.BulkCompleteTodosUseCase(ctx, toTodoIDs(cmd.IDs))
+	})
+	RegisterCommand(b, func(ctx context.Context, cmd command.ValidateFieldCommand) (*appmodel.FieldValidationResponse, *model.DomainError) {
+		return uc.ValidateFieldUseCase(ctx, cmd)
+	})
+	return b
+}
+
+// toTodoIDs converts raw IDs from a command to TodoIDs
+func toTodoIDs(ids []string) []model.TodoID {
+	todoIDs := make([]model.TodoID, len(ids))
+	for i, id := range ids {
+		todoIDs[i] = model.TodoID(id)
+	}
+	return todoIDs
+}
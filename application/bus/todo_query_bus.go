@@ -0,0 +1,22 @@
+package bus
+
+import (
+	"context"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/application/query"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// NewTodoQueryBus registers every todo query against the given use case port
+func NewTodoQueryBus(uc port.TodoUseCasePort) *QueryBus {
+	b := NewQueryBus()
+	RegisterQuery(b, func(ctx context.Context, q query.ListTodosQuery) (*appmodel.TodoListResponse, *model.DomainError) {
+		return uc.ListTodosUseCase(ctx, q)
+	})
+	RegisterQuery(b, func(ctx context.Context, q query.GetTodoQuery) (*appmodel.TodoResponse, *model.DomainError) {
+		return uc.GetTodoUseCase(ctx, model.TodoID(q.ID))
+	})
+	return b
+}
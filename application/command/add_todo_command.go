@@ -1,5 +1,7 @@
 package command
 
+import "time"
+
 // CreateTodoCommand represents a command to create a new Todo following CQRS pattern
 type CreateTodoCommand struct {
 	Title       string `json:"title"`
@@ -7,6 +9,12 @@ type CreateTodoCommand struct {
 	Priority    string `json:"priority,omitempty"`
 	CategoryID  string `json:"category-id,omitempty"`
 	CreatedBy   string `json:"created-by,omitempty"`
+	// DueDate is optional and cannot be in the past
+	DueDate *time.Time `json:"due-date,omitempty"`
+	// Tags are unique, at most 20, each up to 30 characters
+	Tags []string `json:"tags,omitempty"`
+	// Source is set by the adapter handling the request, never by the client
+	Source string `json:"-"`
 }
 
 // UpdateTodoCommand represents a command to update an existing Todo
@@ -16,6 +24,10 @@ type UpdateTodoCommand struct {
 	Description string `json:"description,omitempty"`
 	Priority    string `json:"priority,omitempty"`
 	CategoryID  string `json:"category-id,omitempty"`
+	// DueDate is optional and cannot be in the past
+	DueDate *time.Time `json:"due-date,omitempty"`
+	// Tags, when present, replace the todo's tags
+	Tags []string `json:"tags,omitempty"`
 }
 
 // CompleteTodoCommand represents a command to mark a Todo as completed
@@ -23,11 +35,57 @@ type CompleteTodoCommand struct {
 	ID string `json:"id"`
 }
 
+// UncompleteTodoCommand represents a command to return a completed Todo to pending
+type UncompleteTodoCommand struct {
+	ID string `json:"id"`
+}
+
+// ReopenTodoCommand represents a command to reset a completed Todo to pending
+type ReopenTodoCommand struct {
+	ID string `json:"id"`
+}
+
 // ArchiveTodoCommand represents a command to archive a Todo
 type ArchiveTodoCommand struct {
 	ID string `json:"id"`
 }
 
+// UnarchiveTodoCommand represents a command to return an archived Todo to pending
+type UnarchiveTodoCommand struct {
+	ID string `json:"id"`
+}
+
+// DeleteTodoCommand represents a command to delete a single Todo
+type DeleteTodoCommand struct {
+	ID string `json:"id"`
+}
+
+// DeleteTodosCommand represents a command to delete several Todos at once
+type DeleteTodosCommand struct {
+	IDs []string `json:"ids"`
+}
+
+// UncompleteTodosCommand represents a command to reopen several completed Todos at once
+type UncompleteTodosCommand struct {
+	IDs []string `json:"ids"`
+}
+
+// BulkCompleteTodosCommand represents a command to complete several Todos at once
+type BulkCompleteTodosCommand struct {
+	IDs []string `json:"ids"`
+}
+
+// AddDependencyCommand represents a command to block a Todo until another is completed
+type AddDependencyCommand struct {
+	DependsOn string `json:"depends-on"`
+}
+
+// ValidateFieldCommand represents a request to validate a single todo field value
+type ValidateFieldCommand struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
 // CreateUserCommand represents a command to create a new User
 type CreateUserCommand struct {
 	Email     string `json:"email"`
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"encoding/xml"
+	"sort"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// BatchResponse represents the outcome of an operation applied to several todos
+type BatchResponse struct {
+	XMLName xml.Name `json:"-" xml:"batch-result"`
+	Failed  []string `json:"failed" xml:"failed>id"`
+}
+
+// BatchResponseMapper maps the failed domain IDs of a batch operation to a BatchResponse
+func BatchResponseMapper(failed []model.TodoID) BatchResponse {
+	ids := make([]string, len(failed))
+	for i, id := range failed {
+		ids[i] = string(id)
+	}
+	return BatchResponse{Failed: ids}
+}
+
+// BulkResult reports the outcome of an operation applied to several todos, per todo
+type BulkResult struct {
+	XMLName   xml.Name `json:"-" xml:"bulk-result"`
+	Succeeded []string `json:"succeeded" xml:"succeeded>id"`
+	// Failed maps the ID of every todo the operation could not be applied to to the reason
+	Failed BulkFailures `json:"failed" xml:"failed"`
+}
+
+// NewBulkResult creates a BulkResult with no outcomes recorded yet
+func NewBulkResult() *BulkResult {
+	return &BulkResult{Succeeded: []string{}, Failed: BulkFailures{}}
+}
+
+// BulkFailures maps todo IDs to the reason an operation failed for them
+type BulkFailures map[string]string
+
+// MarshalXML encodes the failures as <todo id="...">reason</todo> elements ordered
+// by ID, since encoding/xml cannot marshal maps
+func (f BulkFailures) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	ids := make([]string, 0, len(f))
+	for id := range f {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		element := xml.StartElement{Name: xml.Name{Local: "todo"}, Attr: []xml.Attr{{Name: xml.Name{Local: "id"}, Value: id}}}
+		if err := e.EncodeElement(f[id], element); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
@@ -0,0 +1,20 @@
+package model
+
+import "encoding/xml"
+
+// StatusCountsResponse reports how many todos are in each status
+type StatusCountsResponse struct {
+	Total     int `json:"total" xml:"total"`
+	Pending   int `json:"pending" xml:"pending"`
+	Completed int `json:"completed" xml:"completed"`
+	Archived  int `json:"archived" xml:"archived"`
+}
+
+// BootstrapResponse bundles what a client needs to render its first screen:
+// the first page of todos, every category and the todo counts per status
+type BootstrapResponse struct {
+	XMLName    xml.Name             `json:"-" xml:"bootstrap"`
+	Todos      TodoListResponse     `json:"todos" xml:"todos"`
+	Categories CategoryListResponse `json:"categories" xml:"categories"`
+	Counts     StatusCountsResponse `json:"counts" xml:"counts"`
+}
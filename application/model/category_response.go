@@ -0,0 +1,49 @@
+package model
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// CategoryResponse represents a category in the application layer
+type CategoryResponse struct {
+	XMLName     xml.Name  `json:"-" xml:"category"`
+	ID          string    `json:"id" xml:"id"`
+	Name        string    `json:"name" xml:"name"`
+	Description string    `json:"description" xml:"description"`
+	Color       string    `json:"color" xml:"color"`
+	CreatedBy   string    `json:"created-by,omitempty" xml:"created-by,omitempty"`
+	CreatedAt   time.Time `json:"created-at" xml:"created-at"`
+	IsDefault   bool      `json:"is-default" xml:"is-default"`
+}
+
+// CategoryListResponse represents a list of categories
+type CategoryListResponse struct {
+	XMLName    xml.Name           `json:"-" xml:"categories"`
+	Categories []CategoryResponse `json:"categories" xml:"category"`
+	Count      int                `json:"count" xml:"count"`
+}
+
+// CategoryResponseMapper maps a domain Category to a CategoryResponse
+func CategoryResponseMapper(category *model.Category) CategoryResponse {
+	return CategoryResponse{
+		ID:          string(category.GetID()),
+		Name:        category.GetName(),
+		Description: category.GetDescription(),
+		Color:       string(category.GetColor()),
+		CreatedBy:   string(category.GetCreatedBy()),
+		CreatedAt:   category.GetCreatedAt(),
+		IsDefault:   category.IsDefault(),
+	}
+}
+
+// CategoryListResponseMapper maps a slice of domain Categories to a CategoryListResponse
+func CategoryListResponseMapper(categories []*model.Category) CategoryListResponse {
+	responses := make([]CategoryResponse, len(categories))
+	for i, category := range categories {
+		responses[i] = CategoryResponseMapper(category)
+	}
+	return CategoryListResponse{Categories: responses, Count: len(responses)}
+}
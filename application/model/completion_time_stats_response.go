@@ -0,0 +1,37 @@
+package model
+
+import (
+	"encoding/xml"
+	"sort"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// CompletionTimeStatsResponse reports the average time to complete todos per priority
+type CompletionTimeStatsResponse struct {
+	XMLName    xml.Name                 `json:"-" xml:"completion-time-stats"`
+	Priorities []PriorityCompletionTime `json:"priorities" xml:"priorities>priority"`
+}
+
+// PriorityCompletionTime is the completion time average for a single priority
+type PriorityCompletionTime struct {
+	Priority       string  `json:"priority" xml:"name"`
+	Completed      int     `json:"completed" xml:"completed"`
+	AverageSeconds float64 `json:"average-seconds" xml:"average-seconds"`
+}
+
+// CompletionTimeStatsResponseMapper maps per-priority stats to a response ordered from high to low priority
+func CompletionTimeStatsResponseMapper(stats []model.CompletionTimeStat) CompletionTimeStatsResponse {
+	priorities := make([]PriorityCompletionTime, len(stats))
+	for i, stat := range stats {
+		priorities[i] = PriorityCompletionTime{
+			Priority:       string(stat.Priority),
+			Completed:      stat.Completed,
+			AverageSeconds: stat.AverageDuration.Seconds(),
+		}
+	}
+	sort.Slice(priorities, func(i, j int) bool {
+		return model.TodoPriority(priorities[i].Priority).Rank() > model.TodoPriority(priorities[j].Priority).Rank()
+	})
+	return CompletionTimeStatsResponse{Priorities: priorities}
+}
@@ -0,0 +1,9 @@
+package model
+
+import "encoding/xml"
+
+// CountResponse reports how many todos match a filter
+type CountResponse struct {
+	XMLName xml.Name `json:"-" xml:"count-result"`
+	Count   int      `json:"count" xml:"count"`
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// dashboardWeek is the rolling window used for the weekly completion figures
+const dashboardWeek = 7 * 24 * time.Hour
+
+// DashboardResponse summarizes a user's todos for a dashboard view
+type DashboardResponse struct {
+	XMLName   xml.Name `json:"-" xml:"dashboard"`
+	UserID    string   `json:"user-id" xml:"user-id"`
+	Total     int      `json:"total" xml:"total"`
+	Pending   int      `json:"pending" xml:"pending"`
+	Completed int      `json:"completed" xml:"completed"`
+	Archived  int      `json:"archived" xml:"archived"`
+	// Overdue counts pending todos whose due date has passed
+	Overdue int `json:"overdue" xml:"overdue"`
+	// DueToday counts pending todos due later on the current calendar day;
+	// todos due earlier today count as overdue instead
+	DueToday int `json:"due-today" xml:"due-today"`
+	// CompletedThisWeek counts todos completed within the last 7 days
+	CompletedThisWeek int `json:"completed-this-week" xml:"completed-this-week"`
+	// CompletionRateThisWeek is CompletedThisWeek divided by the todos that were
+	// either completed within the last 7 days or are still pending
+	CompletionRateThisWeek float64 `json:"completion-rate-this-week" xml:"completion-rate-this-week"`
+}
+
+// DashboardResponseMapper computes the dashboard figures for a user's todos
+func DashboardResponseMapper(userID model.UserID, todos []*model.Todo, now time.Time) DashboardResponse {
+	response := DashboardResponse{
+		UserID: string(userID),
+		Total:  len(todos),
+	}
+
+	weekStart := now.Add(-dashboardWeek)
+	year, month, day := now.Date()
+	tomorrow := time.Date(year, month, day+1, 0, 0, 0, 0, now.Location())
+	for _, todo := range todos {
+		switch todo.GetStatus() {
+		case model.TodoStatusPending:
+			response.Pending++
+			if todo.IsOverdueAt(now) {
+				response.Overdue++
+			} else if dueDate := todo.GetDueDate(); dueDate != nil && dueDate.Before(tomorrow) {
+				response.DueToday++
+			}
+		case model.TodoStatusCompleted:
+			response.Completed++
+			if completedAt := todo.GetCompletedAt(); completedAt != nil && completedAt.After(weekStart) {
+				response.CompletedThisWeek++
+			}
+		case model.TodoStatusArchived:
+			response.Archived++
+		}
+	}
+
+	if open := response.CompletedThisWeek + response.Pending; open > 0 {
+		response.CompletionRateThisWeek = float64(response.CompletedThisWeek) / float64(open)
+	}
+
+	return response
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// ExamplePayloadsResponse holds sample request and response bodies for client developers
+type ExamplePayloadsResponse struct {
+	XMLName    xml.Name                  `json:"-" xml:"examples"`
+	CreateTodo command.CreateTodoCommand `json:"create-todo" xml:"create-todo"`
+	Todo       TodoResponse              `json:"todo" xml:"todo"`
+}
+
+// ExamplePayloads builds the sample payloads from the domain model, so the todo
+// shape always matches what GET /todos/{id} returns
+func ExamplePayloads() ExamplePayloadsResponse {
+	cmd := command.CreateTodoCommand{
+		Title:       "Write release notes",
+		Description: "Summarize the changes shipped this sprint",
+		Priority:    string(model.TodoPriorityMedium),
+	}
+
+	createdAt := time.Date(2025, time.January, 1, 9, 0, 0, 0, time.UTC)
+	todo := model.NewTodoFromData("00000000-0000-0000-0000-000000000000", cmd.Title, cmd.Description,
+		model.TodoStatusPending, model.TodoPriority(cmd.Priority), createdAt, createdAt, nil, "", "", nil, nil, model.TodoSourceHTTP)
+
+	return ExamplePayloadsResponse{CreateTodo: cmd, Todo: TodoResponseMapper(todo)}
+}
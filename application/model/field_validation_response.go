@@ -0,0 +1,23 @@
+package model
+
+import (
+	"encoding/xml"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// FieldValidationResponse reports whether a single field value is valid
+type FieldValidationResponse struct {
+	XMLName xml.Name                   `json:"-" xml:"field-validation"`
+	Valid   bool                       `json:"valid" xml:"valid"`
+	Error   *model.DomainErrorResponse `json:"error" xml:"error,omitempty"`
+}
+
+// FieldValidationResponseMapper maps a validation outcome to a FieldValidationResponse
+func FieldValidationResponseMapper(err *model.DomainError) FieldValidationResponse {
+	if err == nil {
+		return FieldValidationResponse{Valid: true}
+	}
+	response := err.ToResponse()
+	return FieldValidationResponse{Valid: false, Error: &response}
+}
@@ -0,0 +1,7 @@
+package model
+
+// ReplayResponse reports how many stored events were re-applied to the registered projections
+type ReplayResponse struct {
+	Replayed    int      `json:"replayed" xml:"replayed"`
+	Projections []string `json:"projections" xml:"projections>projection"`
+}
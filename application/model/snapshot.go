@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// SnapshotVersion identifies the snapshot format produced by SnapshotMapper
+const SnapshotVersion = 1
+
+// Snapshot is the serialized form of the entire todo set
+type Snapshot struct {
+	Version int            `json:"version"`
+	TakenAt time.Time      `json:"taken-at"`
+	Todos   []TodoSnapshot `json:"todos"`
+}
+
+// TodoSnapshot holds every persisted field of a todo
+type TodoSnapshot struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	Priority    string     `json:"priority"`
+	CreatedAt   time.Time  `json:"created-at"`
+	UpdatedAt   time.Time  `json:"updated-at"`
+	CompletedAt *time.Time `json:"completed-at,omitempty"`
+	CreatedBy   string     `json:"created-by,omitempty"`
+	CategoryID  string     `json:"category-id,omitempty"`
+	DueDate     *time.Time `json:"due-date,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	DependsOn   []string   `json:"depends-on,omitempty"`
+	Source      string     `json:"source,omitempty"`
+}
+
+// SnapshotMapper maps domain Todos to a Snapshot
+func SnapshotMapper(todos []*model.Todo, takenAt time.Time) Snapshot {
+	snapshot := Snapshot{Version: SnapshotVersion, TakenAt: takenAt, Todos: make([]TodoSnapshot, len(todos))}
+	for i, todo := range todos {
+		snapshot.Todos[i] = TodoSnapshot{
+			ID:          string(todo.GetID()),
+			Title:       todo.GetTitle(),
+			Description: todo.GetDescription(),
+			Status:      string(todo.GetStatus()),
+			Priority:    string(todo.GetPriority()),
+			CreatedAt:   todo.GetCreatedAt(),
+			UpdatedAt:   todo.GetUpdatedAt(),
+			CompletedAt: todo.GetCompletedAt(),
+			CreatedBy:   string(todo.GetCreatedBy()),
+			CategoryID:  string(todo.GetCategoryID()),
+			DueDate:     todo.GetDueDate(),
+			Tags:        todo.GetTags(),
+			DependsOn:   todoIDStrings(todo.GetDependsOn()),
+			Source:      string(todo.GetSource()),
+		}
+	}
+	return snapshot
+}
+
+// ToModel rebuilds the domain Todo captured by the snapshot
+func (s TodoSnapshot) ToModel() *model.Todo {
+	// Snapshots taken before sources were tracked restore as imported todos
+	source := model.TodoSource(s.Source)
+	if source == "" {
+		source = model.TodoSourceImport
+	}
+	todo := model.NewTodoFromData(
+		model.TodoID(s.ID),
+		s.Title,
+		s.Description,
+		model.TodoStatus(s.Status),
+		model.TodoPriority(s.Priority),
+		s.CreatedAt,
+		s.UpdatedAt,
+		s.CompletedAt,
+		model.UserID(s.CreatedBy),
+		model.CategoryID(s.CategoryID),
+		s.DueDate,
+		s.Tags,
+		source,
+	)
+	dependsOn := make([]model.TodoID, len(s.DependsOn))
+	for i, id := range s.DependsOn {
+		dependsOn[i] = model.TodoID(id)
+	}
+	todo.RestoreDependencies(dependsOn)
+	return todo
+}
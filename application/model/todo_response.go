@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/xml"
 	"time"
 
 	"github.com/mr3iscuit/ddd-golang/domain/model"
@@ -8,19 +9,36 @@ import (
 
 // TodoResponse represents a todo item in the application layer
 type TodoResponse struct {
-	ID          string     `json:"id"`
-	Title       string     `json:"title"`
-	Description string     `json:"description"`
-	Status      string     `json:"status"`
-	Priority    string     `json:"priority"`
-	CreatedAt   time.Time  `json:"created-at"`
-	CompletedAt *time.Time `json:"completed-at,omitempty"`
+	XMLName     xml.Name   `json:"-" xml:"todo"`
+	ID          string     `json:"id" xml:"id"`
+	Title       string     `json:"title" xml:"title"`
+	Description string     `json:"description" xml:"description"`
+	Status      string     `json:"status" xml:"status"`
+	Priority    string     `json:"priority" xml:"priority"`
+	CreatedAt   time.Time  `json:"created-at" xml:"created-at"`
+	CompletedAt *time.Time `json:"completed-at,omitempty" xml:"completed-at,omitempty"`
+	CreatedBy   string     `json:"created-by,omitempty" xml:"created-by,omitempty"`
+	CategoryID  string     `json:"category-id,omitempty" xml:"category-id,omitempty"`
+	DueDate     *time.Time `json:"due-date,omitempty" xml:"due-date,omitempty"`
+	Tags        []string   `json:"tags" xml:"tags>tag"`
+	Source      string     `json:"source,omitempty" xml:"source,omitempty"`
+	// DependsOn lists the IDs of the todos that must be completed first
+	DependsOn []string `json:"depends-on" xml:"depends-on>id"`
+	// Deleted marks a tombstone that carries only the ID of a deleted todo
+	Deleted bool `json:"deleted,omitempty" xml:"deleted,omitempty"`
+	// Stale marks a last-known-good copy served because the repository read failed
+	Stale bool `json:"-" xml:"-"`
 }
 
 // TodoListResponse represents a list of todos
 type TodoListResponse struct {
-	Todos []TodoResponse `json:"todos"`
-	Count int            `json:"count"`
+	XMLName xml.Name       `json:"-" xml:"todos"`
+	Todos   []TodoResponse `json:"todos" xml:"todo"`
+	Count   int            `json:"count" xml:"count"`
+	// Total is the number of todos across all pages
+	Total int `json:"total" xml:"total"`
+	// Stale marks a last-known-good copy served because the repository read failed
+	Stale bool `json:"-" xml:"-"`
 }
 
 // TodoResponseMapper maps a domain Todo to a TodoResponse
@@ -32,6 +50,12 @@ func TodoResponseMapper(todo *model.Todo) TodoResponse {
 		Status:      string(todo.GetStatus()),
 		Priority:    string(todo.GetPriority()),
 		CreatedAt:   todo.GetCreatedAt(),
+		CreatedBy:   string(todo.GetCreatedBy()),
+		CategoryID:  string(todo.GetCategoryID()),
+		DueDate:     todo.GetDueDate(),
+		Tags:        todo.GetTags(),
+		DependsOn:   todoIDStrings(todo.GetDependsOn()),
+		Source:      string(todo.GetSource()),
 	}
 
 	if todo.GetCompletedAt() != nil {
@@ -51,5 +75,15 @@ func TodoListResponseMapper(todos []*model.Todo) TodoListResponse {
 	return TodoListResponse{
 		Todos: responses,
 		Count: len(responses),
+		Total: len(responses),
 	}
 }
+
+// todoIDStrings converts todo IDs to their string form, never returning nil
+func todoIDStrings(ids []model.TodoID) []string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = string(id)
+	}
+	return strs
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"encoding/xml"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// TodosByPriorityResponse buckets todos by priority for a priority board view
+type TodosByPriorityResponse struct {
+	XMLName xml.Name       `json:"-" xml:"todos-by-priority"`
+	High    []TodoResponse `json:"high" xml:"high>todo"`
+	Medium  []TodoResponse `json:"medium" xml:"medium>todo"`
+	Low     []TodoResponse `json:"low" xml:"low>todo"`
+}
+
+// TodosByPriorityResponseMapper buckets todos by priority, keeping their order within each bucket
+func TodosByPriorityResponseMapper(todos []*model.Todo) TodosByPriorityResponse {
+	response := TodosByPriorityResponse{
+		High:   []TodoResponse{},
+		Medium: []TodoResponse{},
+		Low:    []TodoResponse{},
+	}
+	for _, todo := range todos {
+		switch todo.GetPriority() {
+		case model.TodoPriorityHigh:
+			response.High = append(response.High, TodoResponseMapper(todo))
+		case model.TodoPriorityMedium:
+			response.Medium = append(response.Medium, TodoResponseMapper(todo))
+		case model.TodoPriorityLow:
+			response.Low = append(response.Low, TodoResponseMapper(todo))
+		}
+	}
+	return response
+}
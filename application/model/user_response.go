@@ -0,0 +1,37 @@
+package model
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// UserResponse represents a user in the application layer
+type UserResponse struct {
+	XMLName     xml.Name   `json:"-" xml:"user"`
+	ID          string     `json:"id" xml:"id"`
+	Email       string     `json:"email" xml:"email"`
+	Username    string     `json:"username" xml:"username"`
+	FirstName   string     `json:"first-name" xml:"first-name"`
+	LastName    string     `json:"last-name" xml:"last-name"`
+	Role        string     `json:"role" xml:"role"`
+	Status      string     `json:"status" xml:"status"`
+	CreatedAt   time.Time  `json:"created-at" xml:"created-at"`
+	LastLoginAt *time.Time `json:"last-login-at,omitempty" xml:"last-login-at,omitempty"`
+}
+
+// UserResponseMapper maps a domain User to a UserResponse
+func UserResponseMapper(user *model.User) UserResponse {
+	return UserResponse{
+		ID:          string(user.GetID()),
+		Email:       user.GetEmail(),
+		Username:    user.GetUsername(),
+		FirstName:   user.GetFirstName(),
+		LastName:    user.GetLastName(),
+		Role:        string(user.GetRole()),
+		Status:      string(user.GetStatus()),
+		CreatedAt:   user.GetCreatedAt(),
+		LastLoginAt: user.GetLastLoginAt(),
+	}
+}
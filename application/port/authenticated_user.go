@@ -0,0 +1,21 @@
+package port
+
+import (
+	"context"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+type authenticatedUserContextKey struct{}
+
+// WithAuthenticatedUser returns a context carrying the user a request was authenticated as
+func WithAuthenticatedUser(ctx context.Context, user model.UserID) context.Context {
+	return context.WithValue(ctx, authenticatedUserContextKey{}, user)
+}
+
+// AuthenticatedUserFromContext returns the user ctx was authenticated as; ok is
+// false when authentication is off or the credentials identify no single user
+func AuthenticatedUserFromContext(ctx context.Context) (model.UserID, bool) {
+	user, ok := ctx.Value(authenticatedUserContextKey{}).(model.UserID)
+	return user, ok && user != ""
+}
@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// BootstrapUseCasePort defines the inbound port for loading a client's initial state in one call
+type BootstrapUseCasePort interface {
+	BootstrapUseCase(ctx context.Context, limit int) (*appmodel.BootstrapResponse, *model.DomainError)
+}
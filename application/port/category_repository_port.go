@@ -0,0 +1,11 @@
+package port
+
+import "github.com/mr3iscuit/ddd-golang/domain/model"
+
+// CategoryRepositoryPort is the outbound port for Category persistence
+type CategoryRepositoryPort interface {
+	Save(category *model.Category) error
+	FindByID(id model.CategoryID) (*model.Category, error)
+	FindAll() ([]*model.Category, error)
+	Delete(id model.CategoryID) error
+}
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// CategoryUseCasePort defines the inbound port for Category use cases
+type CategoryUseCasePort interface {
+	CreateCategoryUseCase(cmd command.CreateCategoryCommand) (model.CategoryID, *model.DomainError)
+	UpdateCategoryUseCase(cmd command.UpdateCategoryCommand) *model.DomainError
+	GetCategoryUseCase(id model.CategoryID) (*appmodel.CategoryResponse, *model.DomainError)
+	ListCategoriesUseCase() (*appmodel.CategoryListResponse, *model.DomainError)
+	DeleteCategoryUseCase(id model.CategoryID) *model.DomainError
+}
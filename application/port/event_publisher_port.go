@@ -0,0 +1,8 @@
+package port
+
+import "context"
+
+// EventPublisherPort is the outbound port for publishing domain events
+type EventPublisherPort interface {
+	Publish(ctx context.Context, event interface{}) error
+}
@@ -0,0 +1,10 @@
+package port
+
+import "context"
+
+// EventStorePort is the outbound port for the append-only log of published domain events
+type EventStorePort interface {
+	Append(ctx context.Context, event interface{}) error
+	// LoadAll returns every stored event in the order it was appended
+	LoadAll(ctx context.Context) ([]interface{}, error)
+}
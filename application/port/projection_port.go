@@ -0,0 +1,25 @@
+package port
+
+import (
+	"context"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// ProjectionPort is a read model built from domain events that can be rebuilt by replaying them
+type ProjectionPort interface {
+	Name() string
+	// Reset discards the projection's state before a replay
+	Reset(ctx context.Context) error
+	Apply(ctx context.Context, event interface{}) error
+}
+
+// TodoSearchProjectionPort is a projection indexing the titles of unarchived todos
+type TodoSearchProjectionPort interface {
+	ProjectionPort
+	// Query returns the IDs of indexed todos whose titles contain every term, sorted by ID
+	Query(ctx context.Context, terms []string) ([]model.TodoID, error)
+	// Ready reports whether the index also covers the todos stored before the
+	// process started; until then Query may miss them
+	Ready() bool
+}
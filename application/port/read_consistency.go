@@ -0,0 +1,33 @@
+package port
+
+import "context"
+
+// ReadConsistency tells repositories with read replicas where a request's reads may be served
+type ReadConsistency string
+
+const (
+	// ReadConsistencyEventual allows reads from a replica that may lag behind recent writes
+	ReadConsistencyEventual ReadConsistency = "eventual"
+	// ReadConsistencyStrong requires reads from the primary so they observe every committed write
+	ReadConsistencyStrong ReadConsistency = "strong"
+)
+
+// IsValid reports whether the consistency is one of the supported levels
+func (c ReadConsistency) IsValid() bool {
+	return c == ReadConsistencyEventual || c == ReadConsistencyStrong
+}
+
+type readConsistencyContextKey struct{}
+
+// WithReadConsistency returns a context whose repository reads use the given consistency
+func WithReadConsistency(ctx context.Context, consistency ReadConsistency) context.Context {
+	return context.WithValue(ctx, readConsistencyContextKey{}, consistency)
+}
+
+// ReadConsistencyFromContext returns the consistency requested for ctx, eventual by default
+func ReadConsistencyFromContext(ctx context.Context) ReadConsistency {
+	if consistency, ok := ctx.Value(readConsistencyContextKey{}).(ReadConsistency); ok {
+		return consistency
+	}
+	return ReadConsistencyEventual
+}
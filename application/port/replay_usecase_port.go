@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// ReplayUseCasePort defines the inbound port for rebuilding projections from the event store
+type ReplayUseCasePort interface {
+	ReplayEventsUseCase(ctx context.Context) (*appmodel.ReplayResponse, *model.DomainError)
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// TodoDependencyUseCasePort defines the inbound port for blocking todos on other todos
+type TodoDependencyUseCasePort interface {
+	AddDependencyUseCase(ctx context.Context, id, dependsOn model.TodoID) *model.DomainError
+	RemoveDependencyUseCase(ctx context.Context, id, dependsOn model.TodoID) *model.DomainError
+	ListActionableTodosUseCase(ctx context.Context) (*appmodel.TodoListResponse, *model.DomainError)
+}
@@ -7,6 +7,10 @@ type TodoDomainServicePort interface {
 	ValidateTitle(title string) *model.DomainError
 	ValidateDescription(description string) *model.DomainError
 	ValidatePriority(priority string) *model.DomainError
+	ValidateStatus(status string) *model.DomainError
 	ValidateCreateTodoCommand(title string, description string, priority string) *model.DomainError
 	ValidateUpdateTodoCommand(title string, description string, priority string) *model.DomainError
+	SuggestPriority(title string) model.TodoPriority
+	// Limits returns the length limits titles and descriptions are validated against
+	Limits() model.TodoLimits
 }
@@ -1,12 +1,53 @@
 package port
 
-import "github.com/mr3iscuit/ddd-golang/domain/model"
+import (
+	"context"
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
 
 // TodoRepositoryPort is the outbound port for Todo persistence
-// (previously domain/repository.TodoRepository)
+// (previously domain/repository.TodoRepository). Every method takes the
+// request context so cancellation and deadlines reach the store.
 type TodoRepositoryPort interface {
-	Save(todo *model.Todo) error
-	FindByID(id model.TodoID) (*model.Todo, error)
-	FindAll() ([]*model.Todo, error)
-	Delete(id model.TodoID) error
+	// Save inserts or updates a Todo; prefer Create or Update when the intent is known
+	Save(ctx context.Context, todo *model.Todo) error
+	// Create inserts a new Todo and fails if one with the same ID exists
+	Create(ctx context.Context, todo *model.Todo) error
+	// Update overwrites an existing Todo and fails if none has its ID
+	Update(ctx context.Context, todo *model.Todo) error
+	// UpdateAll overwrites several existing Todos as one unit: if any is
+	// missing or invalid, none is written
+	UpdateAll(ctx context.Context, todos []*model.Todo) error
+	FindByID(ctx context.Context, id model.TodoID) (*model.Todo, error)
+	// FindByIDs retrieves the Todos with the given IDs in a single query,
+	// ordered by creation time; IDs that are not stored are skipped
+	FindByIDs(ctx context.Context, ids []model.TodoID) ([]*model.Todo, error)
+	FindAll(ctx context.Context) ([]*model.Todo, error)
+	FindPaginated(ctx context.Context, limit, offset int) ([]*model.Todo, int, error)
+	FindFiltered(ctx context.Context, filter model.TodoFilter, sort model.TodoSort, limit, offset int) ([]*model.Todo, int, error)
+	FindByStatus(ctx context.Context, status model.TodoStatus) ([]*model.Todo, error)
+	FindByCreatedBy(ctx context.Context, userID model.UserID) ([]*model.Todo, error)
+	FindRandom(ctx context.Context) (*model.Todo, error)
+	FindStale(ctx context.Context, olderThan time.Duration) ([]*model.Todo, error)
+	// Search retrieves the Todos whose title or description contains term,
+	// ignoring case, ordered by creation time
+	Search(ctx context.Context, term string) ([]*model.Todo, error)
+	// FindOrderedByPriority retrieves Todos from highest to lowest priority,
+	// skipping archived ones unless includeArchived is set
+	FindOrderedByPriority(ctx context.Context, includeArchived bool) ([]*model.Todo, error)
+	Count(ctx context.Context, filter model.TodoFilter) (int, error)
+	// FindDeletedIDs lists the IDs of deleted Todos, oldest deletion first, so
+	// clients caching todos locally can drop them
+	FindDeletedIDs(ctx context.Context) ([]model.TodoID, error)
+	Delete(ctx context.Context, id model.TodoID) error
+	DeleteByIDs(ctx context.Context, ids []model.TodoID) ([]model.TodoID, error)
+	CompletionTimeStats(ctx context.Context) ([]model.CompletionTimeStat, error)
+	// Ping checks that the underlying store is reachable
+	Ping(ctx context.Context) error
+	// LockDependencies locks the given Todos and serializes dependency changes
+	// until the surrounding transaction ends, so concurrent changes cannot
+	// together close a cycle. Outside a transaction it has no lasting effect.
+	LockDependencies(ctx context.Context, ids ...model.TodoID) error
 }
@@ -1,18 +1,41 @@
 package port
 
 import (
+	"context"
+	"time"
+
 	"github.com/mr3iscuit/ddd-golang/application/command"
 	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/query"
 	"github.com/mr3iscuit/ddd-golang/domain/model"
 )
 
-// TodoUseCasePort defines the inbound port for Todo use cases
+// TodoUseCasePort defines the inbound port for Todo use cases; ctx is the
+// request context and is passed through to the repository
 type TodoUseCasePort interface {
-	CreateTodoUseCase(cmd command.CreateTodoCommand) (model.TodoID, *model.DomainError)
-	UpdateTodoUseCase(cmd command.UpdateTodoCommand) *model.DomainError
-	CompleteTodoUseCase(id model.TodoID) *model.DomainError
-	ArchiveTodoUseCase(id model.TodoID) *model.DomainError
-	GetTodoUseCase(id model.TodoID) (*appmodel.TodoResponse, *model.DomainError)
-	ListTodosUseCase() (*appmodel.TodoListResponse, *model.DomainError)
-	TestErrorUseCase() *model.DomainError
+	CreateTodoUseCase(ctx context.Context, cmd command.CreateTodoCommand) (model.TodoID, *model.DomainError)
+	UpdateTodoUseCase(ctx context.Context, cmd command.UpdateTodoCommand) *model.DomainError
+	CompleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError
+	UncompleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError
+	UncompleteBatchUseCase(ctx context.Context, ids []model.TodoID) ([]model.TodoID, *model.DomainError)
+	BulkCompleteTodosUseCase(ctx context.Context, ids []model.TodoID) (*appmodel.BulkResult, *model.DomainError)
+	ReopenTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError
+	ArchiveTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError
+	UnarchiveTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError
+	GetTodoUseCase(ctx context.Context, id model.TodoID) (*appmodel.TodoResponse, *model.DomainError)
+	GetRandomTodoUseCase(ctx context.Context) (*appmodel.TodoResponse, *model.DomainError)
+	ListTodosUseCase(ctx context.Context, q query.ListTodosQuery) (*appmodel.TodoListResponse, *model.DomainError)
+	CountTodosUseCase(ctx context.Context, filter model.TodoFilter) (*appmodel.CountResponse, *model.DomainError)
+	ListStaleTodosUseCase(ctx context.Context, olderThan time.Duration) (*appmodel.TodoListResponse, *model.DomainError)
+	SearchTodosUseCase(ctx context.Context, text string) (*appmodel.TodoListResponse, *model.DomainError)
+	ListTodosByPriorityUseCase(ctx context.Context, includeArchived bool) (*appmodel.TodosByPriorityResponse, *model.DomainError)
+	DeleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError
+	DeleteTodosUseCase(ctx context.Context, ids []model.TodoID) ([]model.TodoID, *model.DomainError)
+	GetDashboardUseCase(ctx context.Context, owner model.UserID) (*appmodel.DashboardResponse, *model.DomainError)
+	CompletionTimeStatsUseCase(ctx context.Context) (*appmodel.CompletionTimeStatsResponse, *model.DomainError)
+	SnapshotUseCase(ctx context.Context) ([]byte, *model.DomainError)
+	RestoreSnapshotUseCase(ctx context.Context, data []byte, replace bool) *model.DomainError
+	ValidateFieldUseCase(ctx context.Context, cmd command.ValidateFieldCommand) (*appmodel.FieldValidationResponse, *model.DomainError)
+	TestErrorUseCase(ctx context.Context) *model.DomainError
+	ReadinessUseCase(ctx context.Context) *model.DomainError
 }
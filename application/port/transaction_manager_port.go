@@ -0,0 +1,11 @@
+package port
+
+import "context"
+
+// TransactionManager is the outbound port for running several todo repository
+// calls as one unit of work
+type TransactionManager interface {
+	// WithinTransaction calls fn with a repository whose writes are committed
+	// together when fn returns nil and discarded when it returns an error
+	WithinTransaction(ctx context.Context, fn func(repo TodoRepositoryPort) error) error
+}
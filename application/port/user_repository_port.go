@@ -0,0 +1,13 @@
+package port
+
+import "github.com/mr3iscuit/ddd-golang/domain/model"
+
+// UserRepositoryPort is the outbound port for User persistence
+type UserRepositoryPort interface {
+	Save(user *model.User) error
+	FindByID(id model.UserID) (*model.User, error)
+	// FindByEmail returns nil without an error when no user has the email
+	FindByEmail(email string) (*model.User, error)
+	FindAll() ([]*model.User, error)
+	Delete(id model.UserID) error
+}
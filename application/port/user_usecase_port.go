@@ -0,0 +1,16 @@
+package port
+
+import (
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// UserUseCasePort defines the inbound port for User use cases
+type UserUseCasePort interface {
+	CreateUserUseCase(cmd command.CreateUserCommand) (model.UserID, *model.DomainError)
+	GetUserUseCase(id model.UserID) (*appmodel.UserResponse, *model.DomainError)
+	UpdateUserProfileUseCase(cmd command.UpdateUserProfileCommand) *model.DomainError
+	PromoteUserUseCase(cmd command.PromoteUserCommand) *model.DomainError
+	SuspendUserUseCase(cmd command.SuspendUserCommand) *model.DomainError
+}
@@ -0,0 +1,6 @@
+package query
+
+// GetTodoQuery represents a query to retrieve a single todo by ID
+type GetTodoQuery struct {
+	ID string `json:"id"`
+}
@@ -1,8 +1,72 @@
 package query
 
+import (
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
 // ListTodosQuery represents a query to retrieve all todos following CQRS pattern
 type ListTodosQuery struct {
-	// Future: Add filtering, pagination, sorting options
+	// Limit caps the page size; zero returns every todo from Offset onwards
 	Limit  int `json:"limit,omitempty"`
 	Offset int `json:"offset,omitempty"`
+	// StatusFilter restricts the list to todos in the given status when set
+	StatusFilter string `json:"status,omitempty"`
+	// PriorityFilter restricts the list to todos with the given priority when set
+	PriorityFilter string `json:"priority,omitempty"`
+	// SortBy is one of created_at, updated_at, priority or title; SortOrder is asc or desc
+	SortBy    string `json:"sort-by,omitempty"`
+	SortOrder string `json:"sort-order,omitempty"`
+	// Overdue restricts the list to pending todos past their due date
+	Overdue bool `json:"overdue,omitempty"`
+	// TagFilter restricts the list to todos carrying the given tag when set
+	TagFilter string `json:"tag,omitempty"`
+	// SourceFilter restricts the list to todos created through the given source when set
+	SourceFilter string `json:"source,omitempty"`
+	// SearchTerm restricts the list to todos whose title or description contains it, ignoring case
+	SearchTerm string `json:"q,omitempty"`
+	// CreatedByFilter restricts the list to todos created by the given user when set
+	CreatedByFilter string `json:"created-by,omitempty"`
+	// CategoryFilter restricts the list to todos assigned to the given category when set
+	CategoryFilter string `json:"category,omitempty"`
+	// IncludeDeleted appends a tombstone for every deleted todo to the list
+	IncludeDeleted bool `json:"include-deleted,omitempty"`
+}
+
+// Filter converts the query's filters into a TodoFilter, treating now as the
+// current time for Overdue. Paging, sorting and IncludeDeleted are ignored.
+func (q ListTodosQuery) Filter(now time.Time) (model.TodoFilter, *model.DomainError) {
+	filter := model.TodoFilter{
+		Search:    q.SearchTerm,
+		Tag:       q.TagFilter,
+		CreatedBy: model.UserID(q.CreatedByFilter),
+		Category:  model.CategoryID(q.CategoryFilter),
+	}
+	if q.StatusFilter != "" {
+		switch status := model.TodoStatus(q.StatusFilter); status {
+		case model.TodoStatusPending, model.TodoStatusCompleted, model.TodoStatusArchived:
+			filter.Status = status
+		default:
+			return filter, model.ErrInvalidStatus
+		}
+	}
+	if q.PriorityFilter != "" {
+		switch priority := model.TodoPriority(q.PriorityFilter); priority {
+		case model.TodoPriorityLow, model.TodoPriorityMedium, model.TodoPriorityHigh:
+			filter.Priority = priority
+		default:
+			return filter, model.ErrInvalidPriority
+		}
+	}
+	if q.Overdue {
+		filter.OverdueAt = &now
+	}
+	if q.SourceFilter != "" {
+		filter.Source = model.TodoSource(q.SourceFilter)
+		if !filter.Source.IsValid() {
+			return filter, model.ErrInvalidQueryParam.WithDetails(map[string]string{"param": "source", "value": q.SourceFilter})
+		}
+	}
+	return filter, nil
 }
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/application/query"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// BootstrapUseCase implements the BootstrapUseCasePort by composing the todo and category use cases
+type BootstrapUseCase struct {
+	todos      port.TodoUseCasePort
+	categories port.CategoryUseCasePort
+}
+
+var _ port.BootstrapUseCasePort = (*BootstrapUseCase)(nil)
+
+// NewBootstrapUseCase creates a new BootstrapUseCase
+func NewBootstrapUseCase(todos port.TodoUseCasePort, categories port.CategoryUseCasePort) *BootstrapUseCase {
+	return &BootstrapUseCase{todos: todos, categories: categories}
+}
+
+// BootstrapUseCase returns the first limit todos, every category and the todo
+// counts per status. When ctx carries an authenticated user the todos and
+// counts cover only that user's todos; categories are shared by all users.
+// The number of repository queries is fixed, whatever the number of todos.
+func (uc *BootstrapUseCase) BootstrapUseCase(ctx context.Context, limit int) (*appmodel.BootstrapResponse, *model.DomainError) {
+	owner, _ := port.AuthenticatedUserFromContext(ctx)
+
+	todos, err := uc.todos.ListTodosUseCase(ctx, query.ListTodosQuery{Limit: limit, CreatedByFilter: string(owner)})
+	if err != nil {
+		return nil, err
+	}
+	categories, err := uc.categories.ListCategoriesUseCase()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := appmodel.StatusCountsResponse{}
+	for status, count := range map[model.TodoStatus]*int{
+		model.TodoStatusPending:   &counts.Pending,
+		model.TodoStatusCompleted: &counts.Completed,
+		model.TodoStatusArchived:  &counts.Archived,
+	} {
+		response, err := uc.todos.CountTodosUseCase(ctx, model.TodoFilter{Status: status, CreatedBy: owner})
+		if err != nil {
+			return nil, err
+		}
+		*count = response.Count
+		counts.Total += response.Count
+	}
+
+	return &appmodel.BootstrapResponse{Todos: *todos, Categories: *categories, Counts: counts}, nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/domain/service"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository"
+)
+
+// newSeededBootstrapUseCase composes the real use cases over in-memory repositories holding
+// three todos of alice (pending, completed, archived), one pending todo of bob and two categories
+func newSeededBootstrapUseCase(t *testing.T) *BootstrapUseCase {
+	ctx := context.Background()
+	todoRepo := repository.NewInMemoryTodoRepository()
+	now := time.Now()
+	for i, seed := range []struct {
+		owner  model.UserID
+		status model.TodoStatus
+	}{
+		{"alice", model.TodoStatusPending},
+		{"alice", model.TodoStatusCompleted},
+		{"alice", model.TodoStatusArchived},
+		{"bob", model.TodoStatusPending},
+	} {
+		createdAt := now.Add(time.Duration(i) * time.Minute)
+		var completedAt *time.Time
+		if seed.status == model.TodoStatusCompleted {
+			completedAt = &createdAt
+		}
+		todo := model.NewTodoFromData(model.TodoID(string(seed.owner)+"-"+string(seed.status)), "Seeded todo", "",
+			seed.status, model.TodoPriorityMedium, createdAt, createdAt, completedAt, seed.owner, "", nil, nil, model.TodoSourceHTTP)
+		require.NoError(t, todoRepo.Save(ctx, todo))
+	}
+
+	categoryRepo := repository.NewInMemoryCategoryRepository()
+	require.NoError(t, categoryRepo.Save(model.NewCategory("Work", "", model.CategoryColorBlue, "alice")))
+	require.NoError(t, categoryRepo.Save(model.NewCategory("Home", "", model.CategoryColorGreen, "bob")))
+
+	return NewBootstrapUseCase(NewTodoUseCase(todoRepo, service.NewTodoDomainService()), NewCategoryUseCase(categoryRepo))
+}
+
+func TestBootstrapUseCase_ReturnsAllSections(t *testing.T) {
+	uc := newSeededBootstrapUseCase(t)
+
+	response, err := uc.BootstrapUseCase(context.Background(), 2)
+
+	require.Nil(t, err)
+	assert.Len(t, response.Todos.Todos, 2)
+	assert.Equal(t, 4, response.Todos.Total)
+	assert.Equal(t, 2, response.Categories.Count)
+	assert.Equal(t, 4, response.Counts.Total)
+	assert.Equal(t, 2, response.Counts.Pending)
+	assert.Equal(t, 1, response.Counts.Completed)
+	assert.Equal(t, 1, response.Counts.Archived)
+}
+
+func TestBootstrapUseCase_ScopesToAuthenticatedUser(t *testing.T) {
+	uc := newSeededBootstrapUseCase(t)
+	ctx := port.WithAuthenticatedUser(context.Background(), "alice")
+
+	response, err := uc.BootstrapUseCase(ctx, 10)
+
+	require.Nil(t, err)
+	assert.Equal(t, 3, response.Todos.Total)
+	for _, todo := range response.Todos.Todos {
+		assert.Equal(t, "alice", todo.CreatedBy)
+	}
+	assert.Equal(t, 2, response.Categories.Count)
+	assert.Equal(t, 3, response.Counts.Total)
+	assert.Equal(t, 1, response.Counts.Pending)
+	assert.Equal(t, 1, response.Counts.Completed)
+	assert.Equal(t, 1, response.Counts.Archived)
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// CategoryUseCase implements the CategoryUseCasePort using the CategoryRepositoryPort
+type CategoryUseCase struct {
+	categoryRepo port.CategoryRepositoryPort
+}
+
+var _ port.CategoryUseCasePort = (*CategoryUseCase)(nil)
+
+// NewCategoryUseCase creates a new CategoryUseCase
+func NewCategoryUseCase(categoryRepo port.CategoryRepositoryPort) *CategoryUseCase {
+	return &CategoryUseCase{categoryRepo: categoryRepo}
+}
+
+// CreateCategoryUseCase creates a new category
+func (uc *CategoryUseCase) CreateCategoryUseCase(cmd command.CreateCategoryCommand) (model.CategoryID, *model.DomainError) {
+	color := model.CategoryColor(cmd.Color)
+	if !color.IsValid() {
+		return "", invalidCategoryColor()
+	}
+
+	category := model.NewCategory(cmd.Name, cmd.Description, color, model.UserID(cmd.CreatedBy))
+	if err := category.IsValid(); err != nil {
+		return "", model.ErrInvalidCategory.WithDetails(map[string]string{"reason": err.Error()})
+	}
+
+	if err := uc.categoryRepo.Save(category); err != nil {
+		return "", model.ErrFailedToSaveCategory
+	}
+	return category.GetID(), nil
+}
+
+// UpdateCategoryUseCase updates a category; empty fields are left unchanged
+func (uc *CategoryUseCase) UpdateCategoryUseCase(cmd command.UpdateCategoryCommand) *model.DomainError {
+	category, err := uc.categoryRepo.FindByID(model.CategoryID(cmd.ID))
+	if err != nil {
+		return model.ErrCategoryNotFound
+	}
+
+	if cmd.Color != "" {
+		if err := category.UpdateColor(model.CategoryColor(cmd.Color)); err != nil {
+			return invalidCategoryColor()
+		}
+	}
+	if cmd.Name != "" {
+		if err := category.UpdateName(cmd.Name); err != nil {
+			return model.ErrInvalidCategory.WithDetails(map[string]string{"reason": err.Error()})
+		}
+	}
+	if cmd.Description != "" {
+		if err := category.UpdateDescription(cmd.Description); err != nil {
+			return model.ErrInvalidCategory.WithDetails(map[string]string{"reason": err.Error()})
+		}
+	}
+
+	if err := uc.categoryRepo.Save(category); err != nil {
+		return model.ErrFailedToSaveCategory
+	}
+	return nil
+}
+
+// GetCategoryUseCase retrieves a single category
+func (uc *CategoryUseCase) GetCategoryUseCase(id model.CategoryID) (*appmodel.CategoryResponse, *model.DomainError) {
+	category, err := uc.categoryRepo.FindByID(id)
+	if err != nil {
+		return nil, model.ErrCategoryNotFound
+	}
+
+	response := appmodel.CategoryResponseMapper(category)
+	return &response, nil
+}
+
+// ListCategoriesUseCase retrieves all categories
+func (uc *CategoryUseCase) ListCategoriesUseCase() (*appmodel.CategoryListResponse, *model.DomainError) {
+	categories, err := uc.categoryRepo.FindAll()
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveCategories
+	}
+
+	response := appmodel.CategoryListResponseMapper(categories)
+	return &response, nil
+}
+
+// DeleteCategoryUseCase deletes a category; default categories are protected
+func (uc *CategoryUseCase) DeleteCategoryUseCase(id model.CategoryID) *model.DomainError {
+	category, err := uc.categoryRepo.FindByID(id)
+	if err != nil {
+		return model.ErrCategoryNotFound
+	}
+	if category.IsDefault() {
+		return model.ErrCannotDeleteDefaultCategory
+	}
+
+	if err := uc.categoryRepo.Delete(id); err != nil {
+		return model.ErrFailedToDeleteCategory
+	}
+	return nil
+}
+
+// invalidCategoryColor returns ErrInvalidCategoryColor listing the supported colors
+func invalidCategoryColor() *model.DomainError {
+	allowed := make([]string, len(model.CategoryColors))
+	for i, color := range model.CategoryColors {
+		allowed[i] = string(color)
+	}
+	return model.ErrInvalidCategoryColor.WithDetails(map[string]string{"allowed": strings.Join(allowed, ", ")})
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+type MockCategoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockCategoryRepository) Save(category *model.Category) error {
+	args := m.Called(category)
+	return args.Error(0)
+}
+
+func (m *MockCategoryRepository) FindByID(id model.CategoryID) (*model.Category, error) {
+	args := m.Called(id)
+	if category, ok := args.Get(0).(*model.Category); ok {
+		return category, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCategoryRepository) FindAll() ([]*model.Category, error) {
+	args := m.Called()
+	if categories, ok := args.Get(0).([]*model.Category); ok {
+		return categories, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCategoryRepository) Delete(id model.CategoryID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func TestCreateCategoryUseCase_Success(t *testing.T) {
+	repo := new(MockCategoryRepository)
+	uc := NewCategoryUseCase(repo)
+
+	repo.On("Save", mock.AnythingOfType("*model.Category")).Return(nil)
+
+	id, err := uc.CreateCategoryUseCase(command.CreateCategoryCommand{Name: "Work", Color: "blue"})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, id)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateCategoryUseCase_InvalidColor(t *testing.T) {
+	repo := new(MockCategoryRepository)
+	uc := NewCategoryUseCase(repo)
+
+	_, err := uc.CreateCategoryUseCase(command.CreateCategoryCommand{Name: "Work", Color: "magenta"})
+	assert.Equal(t, model.ErrInvalidCategoryColor.GetErrorCode(), err.GetErrorCode())
+	assert.Contains(t, err.GetDetails()["allowed"], "blue")
+	assert.Equal(t, len(model.CategoryColors), len(strings.Split(err.GetDetails()["allowed"], ", ")))
+	repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCreateCategoryUseCase_InvalidName(t *testing.T) {
+	repo := new(MockCategoryRepository)
+	uc := NewCategoryUseCase(repo)
+
+	_, err := uc.CreateCategoryUseCase(command.CreateCategoryCommand{Name: strings.Repeat("a", 51), Color: "red"})
+	assert.Equal(t, model.ErrInvalidCategory.GetErrorCode(), err.GetErrorCode())
+	repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCreateCategoryUseCase_SaveError(t *testing.T) {
+	repo := new(MockCategoryRepository)
+	uc := NewCategoryUseCase(repo)
+
+	repo.On("Save", mock.AnythingOfType("*model.Category")).Return(errors.New("db down"))
+
+	_, err := uc.CreateCategoryUseCase(command.CreateCategoryCommand{Name: "Work", Color: "red"})
+	assert.Equal(t, model.ErrFailedToSaveCategory, err)
+}
+
+func TestUpdateCategoryUseCase_KeepsUnsetFields(t *testing.T) {
+	repo := new(MockCategoryRepository)
+	uc := NewCategoryUseCase(repo)
+
+	category := model.NewCategory("Work", "Office tasks", model.CategoryColorBlue, "")
+	repo.On("FindByID", category.GetID()).Return(category, nil)
+	repo.On("Save", category).Return(nil)
+
+	err := uc.UpdateCategoryUseCase(command.UpdateCategoryCommand{ID: string(category.GetID()), Color: "green"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Work", category.GetName())
+	assert.Equal(t, "Office tasks", category.GetDescription())
+	assert.Equal(t, model.CategoryColorGreen, category.GetColor())
+}
+
+func TestUpdateCategoryUseCase_InvalidColor(t *testing.T) {
+	repo := new(MockCategoryRepository)
+	uc := NewCategoryUseCase(repo)
+
+	category := model.NewCategory("Work", "", model.CategoryColorBlue, "")
+	repo.On("FindByID", category.GetID()).Return(category, nil)
+
+	err := uc.UpdateCategoryUseCase(command.UpdateCategoryCommand{ID: string(category.GetID()), Color: "magenta"})
+	assert.Equal(t, model.ErrInvalidCategoryColor.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, model.CategoryColorBlue, category.GetColor())
+	repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestUpdateCategoryUseCase_NotFound(t *testing.T) {
+	repo := new(MockCategoryRepository)
+	uc := NewCategoryUseCase(repo)
+
+	repo.On("FindByID", model.CategoryID("missing")).Return(nil, errors.New("not found"))
+
+	err := uc.UpdateCategoryUseCase(command.UpdateCategoryCommand{ID: "missing", Name: "Home"})
+	assert.Equal(t, model.ErrCategoryNotFound, err)
+}
+
+func TestGetAndListCategoriesUseCase(t *testing.T) {
+	repo := new(MockCategoryRepository)
+	uc := NewCategoryUseCase(repo)
+
+	category := model.NewCategory("Work", "", model.CategoryColorBlue, "user-1")
+	repo.On("FindByID", category.GetID()).Return(category, nil)
+	repo.On("FindAll").Return([]*model.Category{category}, nil)
+
+	response, err := uc.GetCategoryUseCase(category.GetID())
+	assert.Nil(t, err)
+	assert.Equal(t, "blue", response.Color)
+	assert.Equal(t, "user-1", response.CreatedBy)
+
+	list, err := uc.ListCategoriesUseCase()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, list.Count)
+}
+
+func TestListCategoriesUseCase_RepositoryError(t *testing.T) {
+	repo := new(MockCategoryRepository)
+	uc := NewCategoryUseCase(repo)
+
+	repo.On("FindAll").Return(nil, errors.New("db down"))
+
+	_, err := uc.ListCategoriesUseCase()
+	assert.Equal(t, model.ErrFailedToRetrieveCategories, err)
+}
+
+func TestDeleteCategoryUseCase(t *testing.T) {
+	repo := new(MockCategoryRepository)
+	uc := NewCategoryUseCase(repo)
+
+	category := model.NewCategory("Work", "", model.CategoryColorBlue, "")
+	repo.On("FindByID", category.GetID()).Return(category, nil)
+	repo.On("Delete", category.GetID()).Return(nil)
+
+	assert.Nil(t, uc.DeleteCategoryUseCase(category.GetID()))
+	repo.AssertExpectations(t)
+}
+
+func TestDeleteCategoryUseCase_ProtectsDefault(t *testing.T) {
+	repo := new(MockCategoryRepository)
+	uc := NewCategoryUseCase(repo)
+
+	category := model.NewDefaultCategory("General", model.CategoryColorGray)
+	repo.On("FindByID", category.GetID()).Return(category, nil)
+
+	err := uc.DeleteCategoryUseCase(category.GetID())
+	assert.Equal(t, model.ErrCannotDeleteDefaultCategory, err)
+	repo.AssertNotCalled(t, "Delete", mock.Anything)
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/event"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+// PriorityEscalator is a background job raising the priority of pending todos
+// left untouched for too long: low to medium, then medium to high. Each cycle
+// escalates a todo by at most one level, and escalating resets its age.
+type PriorityEscalator struct {
+	todoRepo       port.TodoRepositoryPort
+	eventPublisher port.EventPublisherPort
+	logger         *slog.Logger
+	// thresholds maps a priority to how long a todo may go without updates before escalating
+	thresholds map[model.TodoPriority]time.Duration
+	interval   time.Duration
+	now        func() time.Time
+}
+
+// escalations maps each escalatable priority to the next level up
+var escalations = map[model.TodoPriority]model.TodoPriority{
+	model.TodoPriorityLow:    model.TodoPriorityMedium,
+	model.TodoPriorityMedium: model.TodoPriorityHigh,
+}
+
+// NewPriorityEscalator creates a PriorityEscalator using the thresholds and interval in cfg
+func NewPriorityEscalator(todoRepo port.TodoRepositoryPort, publisher port.EventPublisherPort, cfg *config.Config, logger *slog.Logger) *PriorityEscalator {
+	return &PriorityEscalator{
+		todoRepo:       todoRepo,
+		eventPublisher: publisher,
+		logger:         logger,
+		thresholds: map[model.TodoPriority]time.Duration{
+			model.TodoPriorityLow:    time.Duration(cfg.EscalateLowAfterHours) * time.Hour,
+			model.TodoPriorityMedium: time.Duration(cfg.EscalateMediumAfterHours) * time.Hour,
+		},
+		interval: time.Duration(cfg.PriorityEscalationIntervalMinutes) * time.Minute,
+		now:      time.Now,
+	}
+}
+
+// Run escalates on every tick of the configured interval until ctx is cancelled
+func (e *PriorityEscalator) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			escalated, err := e.RunOnce(ctx)
+			if err != nil {
+				e.logger.Error("priority escalation failed", slog.String("error", err.Error()))
+				continue
+			}
+			if escalated > 0 {
+				e.logger.Info("escalated todo priorities", slog.Int("count", escalated))
+			}
+		}
+	}
+}
+
+// RunOnce escalates every pending todo past its level's threshold, publishing a
+// TodoPriorityChangedEvent for each, and returns how many were escalated
+func (e *PriorityEscalator) RunOnce(ctx context.Context) (int, error) {
+	ctx = forWrite(ctx)
+	todos, err := e.todoRepo.FindByStatus(ctx, model.TodoStatusPending)
+	if err != nil {
+		return 0, err
+	}
+
+	now := e.now()
+	escalated := 0
+	for _, todo := range todos {
+		oldPriority := todo.GetPriority()
+		newPriority, ok := escalations[oldPriority]
+		if !ok || now.Sub(todo.GetUpdatedAt()) < e.thresholds[oldPriority] {
+			continue
+		}
+		if _, err := todo.UpdatePriority(newPriority); err != nil {
+			return escalated, err
+		}
+		if err := e.todoRepo.Update(ctx, todo); err != nil {
+			return escalated, err
+		}
+		escalated++
+		if err := e.eventPublisher.Publish(ctx, event.NewTodoPriorityChangedEvent(todo.GetID(), oldPriority, newPriority)); err != nil {
+			e.logger.Warn("failed to publish priority escalation",
+				slog.String("todo_id", string(todo.GetID())),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+	return escalated, nil
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mr3iscuit/ddd-golang/domain/event"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+func TestPriorityEscalator_RunOnce(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	cfg := config.Default()
+	cfg.EscalateLowAfterHours = 24
+	cfg.EscalateMediumAfterHours = 48
+	escalator := NewPriorityEscalator(repo, publisher, cfg, slog.Default())
+	now := time.Now()
+
+	backdated := func(id model.TodoID, priority model.TodoPriority, age time.Duration) *model.Todo {
+		updatedAt := now.Add(-age)
+		return model.NewTodoFromData(id, string(id), "", model.TodoStatusPending, priority, updatedAt, updatedAt, nil, "", "", nil, nil, "")
+	}
+	oldLow := backdated("old-low", model.TodoPriorityLow, 25*time.Hour)
+	freshLow := backdated("fresh-low", model.TodoPriorityLow, time.Hour)
+	oldMedium := backdated("old-medium", model.TodoPriorityMedium, 49*time.Hour)
+	youngMedium := backdated("young-medium", model.TodoPriorityMedium, 25*time.Hour)
+	oldHigh := backdated("old-high", model.TodoPriorityHigh, 1000*time.Hour)
+	repo.On("FindByStatus", model.TodoStatusPending).Return([]*model.Todo{oldLow, freshLow, oldMedium, youngMedium, oldHigh}, nil)
+	repo.On("Update", mock.AnythingOfType("*model.Todo")).Return(nil)
+
+	escalated, err := escalator.RunOnce(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, escalated)
+	assert.Equal(t, model.TodoPriorityMedium, oldLow.GetPriority(), "low escalates one level only")
+	assert.Equal(t, model.TodoPriorityHigh, oldMedium.GetPriority())
+	assert.Equal(t, model.TodoPriorityLow, freshLow.GetPriority())
+	assert.Equal(t, model.TodoPriorityMedium, youngMedium.GetPriority())
+	repo.AssertNumberOfCalls(t, "Update", 2)
+
+	if assert.Len(t, publisher.events, 2) {
+		first := publisher.events[0].(*event.TodoPriorityChangedEvent)
+		assert.Equal(t, model.TodoID("old-low"), first.TodoID)
+		assert.Equal(t, model.TodoPriorityLow, first.OldPriority)
+		assert.Equal(t, model.TodoPriorityMedium, first.NewPriority)
+		second := publisher.events[1].(*event.TodoPriorityChangedEvent)
+		assert.Equal(t, model.TodoID("old-medium"), second.TodoID)
+		assert.Equal(t, model.TodoPriorityHigh, second.NewPriority)
+	}
+}
+
+func TestPriorityEscalator_RunOnce_RepoError(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	escalator := NewPriorityEscalator(repo, publisher, config.Default(), slog.Default())
+	repo.On("FindByStatus", model.TodoStatusPending).Return(nil, errors.New("db error"))
+
+	escalated, err := escalator.RunOnce(context.Background())
+
+	assert.Error(t, err)
+	assert.Zero(t, escalated)
+	assert.Empty(t, publisher.events)
+}
+
+func TestPriorityEscalator_RunStopsOnCancel(t *testing.T) {
+	escalator := NewPriorityEscalator(new(MockTodoRepository), &capturingEventPublisher{}, config.Default(), slog.Default())
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		escalator.Run(ctx)
+		close(done)
+	}()
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after cancellation")
+	}
+}
+
+func TestPriorityEscalator_RunOnceLoadsFromPrimaryDespiteStaleReplica(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.Default()
+	cfg.EscalateLowAfterHours = 24
+	old := time.Now().Add(-25 * time.Hour)
+	backdated := func(description string) *model.Todo {
+		return model.NewTodoFromData("old-low", "Original", description, model.TodoStatusPending, model.TodoPriorityLow, old, old, nil, "", "", nil, nil, "")
+	}
+	primary, replica := repository.NewInMemoryTodoRepository(), repository.NewInMemoryTodoRepository()
+	// The primary already holds a description the replica has not caught up with
+	assert.NoError(t, primary.Save(ctx, backdated("Committed on the primary")))
+	assert.NoError(t, replica.Save(ctx, backdated("")))
+	escalator := NewPriorityEscalator(repository.NewRoutingTodoRepository(primary, replica), &capturingEventPublisher{}, cfg, slog.Default())
+
+	escalated, err := escalator.RunOnce(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, escalated)
+	saved, err := primary.FindByID(ctx, "old-low")
+	assert.NoError(t, err)
+	assert.Equal(t, "Committed on the primary", saved.GetDescription())
+	assert.Equal(t, model.TodoPriorityMedium, saved.GetPriority())
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// ReplayUseCase implements the ReplayUseCasePort by re-applying stored events to projections
+type ReplayUseCase struct {
+	store       port.EventStorePort
+	projections []port.ProjectionPort
+}
+
+var _ port.ReplayUseCasePort = (*ReplayUseCase)(nil)
+
+// NewReplayUseCase creates a new ReplayUseCase that rebuilds the given projections
+func NewReplayUseCase(store port.EventStorePort, projections ...port.ProjectionPort) *ReplayUseCase {
+	return &ReplayUseCase{store: store, projections: projections}
+}
+
+// ReplayEventsUseCase resets every projection and re-applies all stored events to it in order
+func (uc *ReplayUseCase) ReplayEventsUseCase(ctx context.Context) (*appmodel.ReplayResponse, *model.DomainError) {
+	events, err := uc.store.LoadAll(ctx)
+	if err != nil {
+		return nil, model.ErrFailedToReplayEvents.WithDetails(map[string]string{"reason": err.Error()})
+	}
+
+	names := make([]string, 0, len(uc.projections))
+	for _, projection := range uc.projections {
+		if err := projection.Reset(ctx); err != nil {
+			return nil, replayFailed(projection, err)
+		}
+		for _, e := range events {
+			if err := projection.Apply(ctx, e); err != nil {
+				return nil, replayFailed(projection, err)
+			}
+		}
+		names = append(names, projection.Name())
+	}
+
+	return &appmodel.ReplayResponse{Replayed: len(events), Projections: names}, nil
+}
+
+// replayFailed reports which projection could not be rebuilt
+func replayFailed(projection port.ProjectionPort, err error) *model.DomainError {
+	return model.ErrFailedToReplayEvents.WithDetails(map[string]string{
+		"projection": projection.Name(),
+		"reason":     err.Error(),
+	})
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mr3iscuit/ddd-golang/domain/event"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// sliceEventStore keeps appended events in a slice
+type sliceEventStore struct {
+	events  []interface{}
+	loadErr error
+}
+
+func (s *sliceEventStore) Append(ctx context.Context, e interface{}) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *sliceEventStore) LoadAll(ctx context.Context) ([]interface{}, error) {
+	return s.events, s.loadErr
+}
+
+// createdIDsProjection records the IDs of created todos in event order
+type createdIDsProjection struct {
+	ids      []model.TodoID
+	applyErr error
+}
+
+func (p *createdIDsProjection) Name() string { return "created_ids" }
+
+func (p *createdIDsProjection) Reset(ctx context.Context) error {
+	p.ids = nil
+	return nil
+}
+
+func (p *createdIDsProjection) Apply(ctx context.Context, e interface{}) error {
+	if p.applyErr != nil {
+		return p.applyErr
+	}
+	if created, ok := e.(*event.TodoCreatedEvent); ok {
+		p.ids = append(p.ids, created.TodoID)
+	}
+	return nil
+}
+
+func TestReplayEventsUseCase_RebuildsProjection(t *testing.T) {
+	ctx := context.Background()
+	store := &sliceEventStore{}
+	projection := &createdIDsProjection{}
+	for _, e := range []interface{}{
+		event.NewTodoCreatedEvent("a", "Buy milk", model.TodoSourceHTTP),
+		event.NewTodoCompletedEvent("a"),
+		event.NewTodoCreatedEvent("b", "Call mum", model.TodoSourceCLI),
+	} {
+		require.NoError(t, store.Append(ctx, e))
+		require.NoError(t, projection.Apply(ctx, e))
+	}
+
+	// Simulate the projection drifting out of sync
+	projection.ids = []model.TodoID{"stale"}
+
+	response, err := NewReplayUseCase(store, projection).ReplayEventsUseCase(ctx)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 3, response.Replayed)
+	assert.Equal(t, []string{"created_ids"}, response.Projections)
+	assert.Equal(t, []model.TodoID{"a", "b"}, projection.ids)
+}
+
+func TestReplayEventsUseCase_StoreError(t *testing.T) {
+	store := &sliceEventStore{loadErr: errors.New("store unavailable")}
+
+	response, err := NewReplayUseCase(store, &createdIDsProjection{}).ReplayEventsUseCase(context.Background())
+
+	assert.Nil(t, response)
+	require.NotNil(t, err)
+	assert.Equal(t, model.ErrFailedToReplayEvents.GetErrorCode(), err.GetErrorCode())
+}
+
+func TestReplayEventsUseCase_ProjectionError(t *testing.T) {
+	store := &sliceEventStore{events: []interface{}{event.NewTodoCompletedEvent("a")}}
+	projection := &createdIDsProjection{applyErr: errors.New("boom")}
+
+	_, err := NewReplayUseCase(store, projection).ReplayEventsUseCase(context.Background())
+
+	require.NotNil(t, err)
+	assert.Equal(t, "created_ids", err.GetDetails()["projection"])
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"slices"
+	"sync"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// staleCacheMaxTodos caps how many todos the stale read cache keeps; the
+// todos stored longest ago are dropped first, and longer lists are not kept
+const staleCacheMaxTodos = 1000
+
+// staleReadCache keeps the last successfully read responses so reads can
+// degrade to stale data while the repository is unavailable
+type staleReadCache struct {
+	mu       sync.RWMutex
+	maxTodos int
+	lastList *appmodel.TodoListResponse
+	lastTodo map[model.TodoID]appmodel.TodoResponse
+	// order lists the IDs in lastTodo from the least to the most recently stored
+	order []model.TodoID
+}
+
+func newStaleReadCache(maxTodos int) *staleReadCache {
+	return &staleReadCache{maxTodos: maxTodos, lastTodo: make(map[model.TodoID]appmodel.TodoResponse)}
+}
+
+// storeList records a fresh list, which also refreshes every listed todo.
+// A list longer than the cap replaces no previous list.
+func (c *staleReadCache) storeList(response appmodel.TodoListResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(response.Todos) > c.maxTodos {
+		c.lastList = nil
+		return
+	}
+	response.Todos = slices.Clone(response.Todos)
+	c.lastList = &response
+	for _, todo := range response.Todos {
+		c.put(todo)
+	}
+}
+
+// storeTodo records a freshly read todo
+func (c *staleReadCache) storeTodo(response appmodel.TodoResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.put(response)
+}
+
+// put records a todo as the most recently stored, dropping the least recently
+// stored todos beyond the cap; callers hold c.mu
+func (c *staleReadCache) put(response appmodel.TodoResponse) {
+	id := model.TodoID(response.ID)
+	if _, ok := c.lastTodo[id]; ok {
+		c.order = slices.DeleteFunc(c.order, func(existing model.TodoID) bool { return existing == id })
+	}
+	c.lastTodo[id] = response
+	c.order = append(c.order, id)
+	for len(c.order) > c.maxTodos {
+		delete(c.lastTodo, c.order[0])
+		c.order = c.order[1:]
+	}
+}
+
+// evict forgets deleted todos, including from the last list, so they are never served stale
+func (c *staleReadCache) evict(ids ...model.TodoID) {
+	if len(ids) == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	gone := func(id model.TodoID) bool { return slices.Contains(ids, id) }
+	for _, id := range ids {
+		delete(c.lastTodo, id)
+	}
+	c.order = slices.DeleteFunc(c.order, gone)
+	if c.lastList != nil {
+		kept := slices.DeleteFunc(slices.Clone(c.lastList.Todos), func(todo appmodel.TodoResponse) bool {
+			return gone(model.TodoID(todo.ID))
+		})
+		removed := len(c.lastList.Todos) - len(kept)
+		c.lastList.Todos = kept
+		c.lastList.Count -= removed
+		c.lastList.Total -= removed
+	}
+}
+
+// list returns a stale copy of the last list, if any
+func (c *staleReadCache) list() (*appmodel.TodoListResponse, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.lastList == nil {
+		return nil, false
+	}
+	stale := *c.lastList
+	stale.Stale = true
+	return &stale, true
+}
+
+// todo returns a stale copy of the last read of the given todo, if any
+func (c *staleReadCache) todo(id model.TodoID) (*appmodel.TodoResponse, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	cached, ok := c.lastTodo[id]
+	if !ok {
+		return nil, false
+	}
+	cached.Stale = true
+	return &cached, true
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+func TestStaleReadCache_DropsLeastRecentlyStoredBeyondCap(t *testing.T) {
+	cache := newStaleReadCache(2)
+	cache.storeTodo(appmodel.TodoResponse{ID: "a"})
+	cache.storeTodo(appmodel.TodoResponse{ID: "b"})
+	cache.storeTodo(appmodel.TodoResponse{ID: "a", Title: "Refreshed"})
+	cache.storeTodo(appmodel.TodoResponse{ID: "c"})
+
+	_, ok := cache.todo("b")
+	assert.False(t, ok)
+	refreshed, ok := cache.todo("a")
+	assert.True(t, ok)
+	assert.Equal(t, "Refreshed", refreshed.Title)
+	_, ok = cache.todo("c")
+	assert.True(t, ok)
+
+	cache.storeList(appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{{ID: "x"}, {ID: "y"}, {ID: "z"}}, Count: 3})
+	_, ok = cache.list()
+	assert.False(t, ok, "lists longer than the cap are not kept")
+}
+
+func TestStaleReadCache_EvictFiltersLastList(t *testing.T) {
+	cache := newStaleReadCache(staleCacheMaxTodos)
+	cache.storeList(appmodel.TodoListResponse{Todos: []appmodel.TodoResponse{{ID: "a"}, {ID: "b"}}, Count: 2, Total: 2})
+
+	cache.evict(model.TodoID("a"))
+
+	stale, ok := cache.list()
+	assert.True(t, ok)
+	assert.Equal(t, []appmodel.TodoResponse{{ID: "b"}}, stale.Todos)
+	assert.Equal(t, 1, stale.Count)
+	assert.Equal(t, 1, stale.Total)
+	_, ok = cache.todo("a")
+	assert.False(t, ok)
+}
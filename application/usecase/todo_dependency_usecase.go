@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// TodoDependencyUseCase implements the TodoDependencyUseCasePort
+type TodoDependencyUseCase struct {
+	todoRepo     port.TodoRepositoryPort
+	transactions port.TransactionManager
+}
+
+var _ port.TodoDependencyUseCasePort = (*TodoDependencyUseCase)(nil)
+
+// TodoDependencyUseCaseOption configures optional behaviour of a TodoDependencyUseCase
+type TodoDependencyUseCaseOption func(*TodoDependencyUseCase)
+
+// WithDependencyTransactionManager runs each dependency change in a
+// transaction of the given manager, which must share the use case's store.
+// Without one, changes run straight on the repository.
+func WithDependencyTransactionManager(transactions port.TransactionManager) TodoDependencyUseCaseOption {
+	return func(uc *TodoDependencyUseCase) {
+		uc.transactions = transactions
+	}
+}
+
+// NewTodoDependencyUseCase creates a new TodoDependencyUseCase
+func NewTodoDependencyUseCase(todoRepo port.TodoRepositoryPort, opts ...TodoDependencyUseCaseOption) *TodoDependencyUseCase {
+	uc := &TodoDependencyUseCase{
+		todoRepo:     todoRepo,
+		transactions: directTransactionManager{repo: todoRepo},
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+// AddDependencyUseCase blocks the todo id until the todo dependsOn is completed.
+// The cycle check and the write run in one transaction that locks the
+// dependency graph, so two concurrent additions cannot together close a cycle.
+func (uc *TodoDependencyUseCase) AddDependencyUseCase(ctx context.Context, id, dependsOn model.TodoID) *model.DomainError {
+	ctx = forWrite(ctx)
+	var failure *model.DomainError
+	err := uc.transactions.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		if err := repo.LockDependencies(ctx, id, dependsOn); err != nil {
+			failure = model.ErrFailedToSaveTodo
+			return err
+		}
+		todo, err := repo.FindByID(ctx, id)
+		if err != nil {
+			failure = model.ErrTodoNotFound
+			return err
+		}
+		dependency, err := repo.FindByID(ctx, dependsOn)
+		if err != nil {
+			failure = model.ErrTodoNotFound.WithDetails(map[string]string{"depends-on": string(dependsOn)})
+			return err
+		}
+		cycle, err := wouldCreateCycle(ctx, repo, id, dependsOn)
+		if err != nil {
+			failure = model.ErrFailedToRetrieveTodos
+			return err
+		}
+		if cycle {
+			failure = model.ErrDependencyCycle.WithDetails(map[string]string{"depends-on": string(dependsOn)})
+			return failure
+		}
+		if err := todo.AddDependency(dependency); err != nil {
+			failure = model.ErrInvalidDependency.WithDetails(map[string]string{"depends-on": string(dependsOn)})
+			return err
+		}
+		if err := repo.Update(ctx, todo); err != nil {
+			failure = writeFailed(err, model.ErrFailedToSaveTodo)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		if failure == nil {
+			// The work succeeded but the transaction could not be committed
+			failure = model.ErrFailedToSaveTodo
+		}
+		return failure
+	}
+	return nil
+}
+
+// WouldCreateCycle reports whether making from depend on to would close a
+// cycle, that is whether to already depends on from directly or through a
+// chain of other todos. Self-dependencies are left to the aggregate. The
+// whole graph is loaded in a single query and walked breadth-first.
+func (uc *TodoDependencyUseCase) WouldCreateCycle(ctx context.Context, from, to model.TodoID) (bool, error) {
+	return wouldCreateCycle(ctx, uc.todoRepo, from, to)
+}
+
+// wouldCreateCycle implements WouldCreateCycle on the graph held by repo
+func wouldCreateCycle(ctx context.Context, repo port.TodoRepositoryPort, from, to model.TodoID) (bool, error) {
+	todos, err := repo.FindAll(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	dependsOn := make(map[model.TodoID][]model.TodoID, len(todos))
+	for _, todo := range todos {
+		dependsOn[todo.GetID()] = todo.GetDependsOn()
+	}
+	visited := map[model.TodoID]bool{to: true}
+	queue := slices.Clone(dependsOn[to])
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == from {
+			return true, nil
+		}
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		queue = append(queue, dependsOn[id]...)
+	}
+	return false, nil
+}
+
+// RemoveDependencyUseCase unblocks the todo id from the todo dependsOn
+func (uc *TodoDependencyUseCase) RemoveDependencyUseCase(ctx context.Context, id, dependsOn model.TodoID) *model.DomainError {
+	ctx = forWrite(ctx)
+	todo, err := uc.todoRepo.FindByID(ctx, id)
+	if err != nil {
+		return model.ErrTodoNotFound
+	}
+	if err := todo.RemoveDependency(dependsOn); err != nil {
+		return model.ErrInvalidDependency.WithDetails(map[string]string{"depends-on": string(dependsOn)})
+	}
+	if err := uc.todoRepo.Update(ctx, todo); err != nil {
+		return writeFailed(err, model.ErrFailedToSaveTodo)
+	}
+	return nil
+}
+
+// ListActionableTodosUseCase lists the pending todos whose dependencies are all
+// completed, in creation order. Every todo is loaded in a single query so the
+// dependencies can be resolved without one lookup per todo.
+func (uc *TodoDependencyUseCase) ListActionableTodosUseCase(ctx context.Context) (*appmodel.TodoListResponse, *model.DomainError) {
+	todos, err := uc.todoRepo.FindAll(ctx)
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+
+	byID := make(map[model.TodoID]*model.Todo, len(todos))
+	for _, todo := range todos {
+		byID[todo.GetID()] = todo
+	}
+	actionable := []*model.Todo{}
+	for _, todo := range todos {
+		var dependencies []*model.Todo
+		for _, id := range todo.GetDependsOn() {
+			if dependency, ok := byID[id]; ok {
+				dependencies = append(dependencies, dependency)
+			}
+		}
+		if todo.IsActionable(dependencies) {
+			actionable = append(actionable, todo)
+		}
+	}
+
+	response := appmodel.TodoListResponseMapper(actionable)
+	return &response, nil
+}
@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository"
+)
+
+// saveTodos stores todos in a new in-memory repository
+func saveTodos(t *testing.T, todos ...*model.Todo) *repository.InMemoryTodoRepository {
+	repo := repository.NewInMemoryTodoRepository()
+	for _, todo := range todos {
+		require.NoError(t, repo.Save(context.Background(), todo))
+	}
+	return repo
+}
+
+func TestTodoDependencyUseCase_AddAndRemoveDependency(t *testing.T) {
+	ctx := context.Background()
+	design, build := model.NewSimpleTodo("Design"), model.NewSimpleTodo("Build")
+	repo := saveTodos(t, design, build)
+	uc := NewTodoDependencyUseCase(repo)
+
+	require.Nil(t, uc.AddDependencyUseCase(ctx, build.GetID(), design.GetID()))
+	stored, err := repo.FindByID(ctx, build.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, []model.TodoID{design.GetID()}, stored.GetDependsOn())
+
+	require.Nil(t, uc.RemoveDependencyUseCase(ctx, build.GetID(), design.GetID()))
+	stored, err = repo.FindByID(ctx, build.GetID())
+	require.NoError(t, err)
+	assert.Empty(t, stored.GetDependsOn())
+}
+
+func TestTodoDependencyUseCase_AddDependencyErrors(t *testing.T) {
+	ctx := context.Background()
+	design, build := model.NewSimpleTodo("Design"), model.NewSimpleTodo("Build")
+	repo := saveTodos(t, design, build)
+	uc := NewTodoDependencyUseCase(repo)
+	require.Nil(t, uc.AddDependencyUseCase(ctx, build.GetID(), design.GetID()))
+
+	tests := []struct {
+		name      string
+		id        model.TodoID
+		dependsOn model.TodoID
+		wantCode  int
+	}{
+		{"self", design.GetID(), design.GetID(), model.ErrInvalidDependency.GetErrorCode()},
+		{"duplicate", build.GetID(), design.GetID(), model.ErrInvalidDependency.GetErrorCode()},
+		{"direct cycle", design.GetID(), build.GetID(), model.ErrDependencyCycle.GetErrorCode()},
+		{"unknown todo", "missing", design.GetID(), model.ErrTodoNotFound.GetErrorCode()},
+		{"unknown dependency", build.GetID(), "missing", model.ErrTodoNotFound.GetErrorCode()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := uc.AddDependencyUseCase(ctx, tt.id, tt.dependsOn)
+
+			require.NotNil(t, err)
+			assert.Equal(t, tt.wantCode, err.GetErrorCode())
+		})
+	}
+}
+
+func TestTodoDependencyUseCase_RejectsCycleAcrossChain(t *testing.T) {
+	ctx := context.Background()
+	design, build, test, release := model.NewSimpleTodo("Design"), model.NewSimpleTodo("Build"), model.NewSimpleTodo("Test"), model.NewSimpleTodo("Release")
+	repo := saveTodos(t, design, build, test, release)
+	uc := NewTodoDependencyUseCase(repo)
+	// release -> test -> build -> design
+	require.Nil(t, uc.AddDependencyUseCase(ctx, build.GetID(), design.GetID()))
+	require.Nil(t, uc.AddDependencyUseCase(ctx, test.GetID(), build.GetID()))
+	require.Nil(t, uc.AddDependencyUseCase(ctx, release.GetID(), test.GetID()))
+
+	cycle, err := uc.WouldCreateCycle(ctx, design.GetID(), release.GetID())
+	require.NoError(t, err)
+	assert.True(t, cycle)
+
+	derr := uc.AddDependencyUseCase(ctx, design.GetID(), release.GetID())
+	require.NotNil(t, derr)
+	assert.Equal(t, model.ErrDependencyCycle.GetErrorCode(), derr.GetErrorCode())
+	stored, err := repo.FindByID(ctx, design.GetID())
+	require.NoError(t, err)
+	assert.Empty(t, stored.GetDependsOn())
+
+	// A shortcut along the chain's direction adds no cycle
+	cycle, err = uc.WouldCreateCycle(ctx, release.GetID(), design.GetID())
+	require.NoError(t, err)
+	assert.False(t, cycle)
+	require.Nil(t, uc.AddDependencyUseCase(ctx, release.GetID(), design.GetID()))
+	stored, err = repo.FindByID(ctx, release.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, []model.TodoID{test.GetID(), design.GetID()}, stored.GetDependsOn())
+}
+
+func TestTodoDependencyUseCase_AddDependencyRunsInOneTransaction(t *testing.T) {
+	ctx := context.Background()
+	design, build := model.NewSimpleTodo("Design"), model.NewSimpleTodo("Build")
+	repo := saveTodos(t, design, build)
+	// The use case's own repository has no expectations, so touching it outside the transaction fails the test
+	outside := new(MockTodoRepository)
+
+	err := NewTodoDependencyUseCase(outside, WithDependencyTransactionManager(failingCommitTransactions{inner: repo})).
+		AddDependencyUseCase(ctx, build.GetID(), design.GetID())
+	require.NotNil(t, err)
+	assert.Equal(t, model.ErrFailedToSaveTodo.GetErrorCode(), err.GetErrorCode())
+	stored, findErr := repo.FindByID(ctx, build.GetID())
+	require.NoError(t, findErr)
+	assert.Empty(t, stored.GetDependsOn())
+
+	require.Nil(t, NewTodoDependencyUseCase(outside, WithDependencyTransactionManager(repo)).
+		AddDependencyUseCase(ctx, build.GetID(), design.GetID()))
+	stored, findErr = repo.FindByID(ctx, build.GetID())
+	require.NoError(t, findErr)
+	assert.Equal(t, []model.TodoID{design.GetID()}, stored.GetDependsOn())
+	outside.AssertExpectations(t)
+}
+
+// rendezvousRepository holds the first two graph loads until both have read,
+// or until a short timeout when transactions keep them apart, so two racing
+// cycle checks see the same graph unless something serializes them
+type rendezvousRepository struct {
+	port.TodoRepositoryPort
+	transactions port.TransactionManager
+	loads        *sync.WaitGroup
+	bothLoading  <-chan struct{}
+	arrivals     *atomic.Int32
+}
+
+func newRendezvousRepository(repo *repository.InMemoryTodoRepository) *rendezvousRepository {
+	loads := new(sync.WaitGroup)
+	loads.Add(2)
+	bothLoading := make(chan struct{})
+	go func() {
+		loads.Wait()
+		close(bothLoading)
+	}()
+	return &rendezvousRepository{TodoRepositoryPort: repo, transactions: repo, loads: loads, bothLoading: bothLoading, arrivals: new(atomic.Int32)}
+}
+
+func (r *rendezvousRepository) FindAll(ctx context.Context) ([]*model.Todo, error) {
+	todos, err := r.TodoRepositoryPort.FindAll(ctx)
+	if r.arrivals.Add(1) <= 2 {
+		r.loads.Done()
+		select {
+		case <-r.bothLoading:
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	return todos, err
+}
+
+func (r *rendezvousRepository) WithinTransaction(ctx context.Context, fn func(repo port.TodoRepositoryPort) error) error {
+	return r.transactions.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		scoped := *r
+		scoped.TodoRepositoryPort = repo
+		return fn(&scoped)
+	})
+}
+
+func TestTodoDependencyUseCase_ConcurrentAdditionsCannotCloseCycle(t *testing.T) {
+	ctx := context.Background()
+	a, b, c, d := model.NewSimpleTodo("A"), model.NewSimpleTodo("B"), model.NewSimpleTodo("C"), model.NewSimpleTodo("D")
+	require.NoError(t, a.AddDependency(b))
+	require.NoError(t, c.AddDependency(d))
+	repo := newRendezvousRepository(saveTodos(t, a, b, c, d))
+	uc := NewTodoDependencyUseCase(repo, WithDependencyTransactionManager(repo))
+
+	// b -> c and d -> a touch disjoint todos but together would close a -> b -> c -> d -> a
+	errs := make(chan *model.DomainError, 2)
+	go func() { errs <- uc.AddDependencyUseCase(ctx, b.GetID(), c.GetID()) }()
+	go func() { errs <- uc.AddDependencyUseCase(ctx, d.GetID(), a.GetID()) }()
+	first, second := <-errs, <-errs
+
+	if first == nil {
+		first, second = second, first
+	}
+	require.Nil(t, second)
+	require.NotNil(t, first)
+	assert.Equal(t, model.ErrDependencyCycle.GetErrorCode(), first.GetErrorCode())
+}
+
+func TestTodoDependencyUseCase_RemoveMissingDependency(t *testing.T) {
+	design := model.NewSimpleTodo("Design")
+	uc := NewTodoDependencyUseCase(saveTodos(t, design))
+
+	err := uc.RemoveDependencyUseCase(context.Background(), design.GetID(), "missing")
+
+	require.NotNil(t, err)
+	assert.Equal(t, model.ErrInvalidDependency.GetErrorCode(), err.GetErrorCode())
+}
+
+func TestTodoDependencyUseCase_ListActionableTodos(t *testing.T) {
+	ctx := context.Background()
+	design, review, build := model.NewSimpleTodo("Design"), model.NewSimpleTodo("Review"), model.NewSimpleTodo("Build")
+	require.NoError(t, review.AddDependency(design))
+	require.NoError(t, build.AddDependency(review))
+	repo := saveTodos(t, design, review, build)
+	uc := NewTodoDependencyUseCase(repo)
+
+	response, err := uc.ListActionableTodosUseCase(ctx)
+	require.Nil(t, err)
+	require.Len(t, response.Todos, 1)
+	assert.Equal(t, string(design.GetID()), response.Todos[0].ID)
+
+	// Completing a dependency unblocks the todos waiting on it only
+	require.NoError(t, design.MarkAsCompleted())
+	require.NoError(t, repo.Save(ctx, design))
+
+	response, err = uc.ListActionableTodosUseCase(ctx)
+	require.Nil(t, err)
+	require.Len(t, response.Todos, 1)
+	assert.Equal(t, string(review.GetID()), response.Todos[0].ID)
+	assert.Equal(t, []string{string(design.GetID())}, response.Todos[0].DependsOn)
+}
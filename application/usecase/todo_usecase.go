@@ -1,33 +1,216 @@
 package usecase
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/mr3iscuit/ddd-golang/application/command"
 	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
 	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/application/query"
+	"github.com/mr3iscuit/ddd-golang/domain/event"
 	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
 )
 
 // TodoUseCase implements the TodoUseCasePort
 // and uses the TodoRepositoryPort and TodoDomainServicePort
 // (was TodoApplicationService)
 type TodoUseCase struct {
-	todoRepo      port.TodoRepositoryPort
-	domainService port.TodoDomainServicePort
+	todoRepo       port.TodoRepositoryPort
+	categoryRepo   port.CategoryRepositoryPort
+	domainService  port.TodoDomainServicePort
+	eventPublisher port.EventPublisherPort
+	config         *config.Config
+	logger         *slog.Logger
+	staleCache     *staleReadCache
+	searchIndex    port.TodoSearchProjectionPort
+	transactions   port.TransactionManager
+}
+
+// TodoUseCaseOption configures optional dependencies of a TodoUseCase
+type TodoUseCaseOption func(*TodoUseCase)
+
+// WithConfig sets the configuration controlling optional business rules
+func WithConfig(cfg *config.Config) TodoUseCaseOption {
+	return func(uc *TodoUseCase) {
+		uc.config = cfg
+	}
+}
+
+// WithLogger sets the logger used for audit and diagnostic output
+func WithLogger(logger *slog.Logger) TodoUseCaseOption {
+	return func(uc *TodoUseCase) {
+		uc.logger = logger
+	}
+}
+
+// WithEventPublisher sets the publisher used to emit domain events
+func WithEventPublisher(publisher port.EventPublisherPort) TodoUseCaseOption {
+	return func(uc *TodoUseCase) {
+		uc.eventPublisher = publisher
+	}
+}
+
+// WithCategoryRepository sets the repository used to verify categories assigned to todos.
+// Without one no category can be verified, so todos cannot be assigned a category.
+func WithCategoryRepository(repo port.CategoryRepositoryPort) TodoUseCaseOption {
+	return func(uc *TodoUseCase) {
+		uc.categoryRepo = repo
+	}
+}
+
+// WithSearchProjection sets the title index consulted by SearchTodosUseCase.
+// Without one, or until it reports ready, searches scan every todo.
+func WithSearchProjection(index port.TodoSearchProjectionPort) TodoUseCaseOption {
+	return func(uc *TodoUseCase) {
+		uc.searchIndex = index
+	}
+}
+
+// WithTransactionManager sets the unit of work used by bulk operations so their
+// reads and writes commit together. Without one they run directly on the todo
+// repository, relying on UpdateAll alone to write all or none of the todos.
+func WithTransactionManager(transactions port.TransactionManager) TodoUseCaseOption {
+	return func(uc *TodoUseCase) {
+		uc.transactions = transactions
+	}
 }
 
-func NewTodoUseCase(todoRepo port.TodoRepositoryPort, domainService port.TodoDomainServicePort) *TodoUseCase {
-	return &TodoUseCase{
-		todoRepo:      todoRepo,
-		domainService: domainService,
+func NewTodoUseCase(todoRepo port.TodoRepositoryPort, domainService port.TodoDomainServicePort, opts ...TodoUseCaseOption) *TodoUseCase {
+	uc := &TodoUseCase{
+		todoRepo:       todoRepo,
+		domainService:  domainService,
+		eventPublisher: noopEventPublisher{},
+		config:         config.Default(),
+		logger:         slog.Default(),
+		staleCache:     newStaleReadCache(staleCacheMaxTodos),
+		transactions:   directTransactionManager{repo: todoRepo},
+	}
+	for _, opt := range opts {
+		opt(uc)
 	}
+	return uc
+}
+
+// noopEventPublisher discards events when no publisher is configured
+type noopEventPublisher struct{}
+
+func (noopEventPublisher) Publish(ctx context.Context, event interface{}) error {
+	return nil
+}
+
+// directTransactionManager runs work straight on the repository when no
+// transaction manager is configured
+type directTransactionManager struct {
+	repo port.TodoRepositoryPort
+}
+
+func (m directTransactionManager) WithinTransaction(ctx context.Context, fn func(repo port.TodoRepositoryPort) error) error {
+	return fn(m.repo)
 }
 
-func (uc *TodoUseCase) CreateTodoUseCase(cmd command.CreateTodoCommand) (model.TodoID, *model.DomainError) {
+// anonymousActor is logged as the actor of changes made without an authenticated user
+const anonymousActor = "anonymous"
+
+// audit logs a status transition, made by the authenticated user of ctx, when audit logging is enabled
+func (uc *TodoUseCase) audit(ctx context.Context, id model.TodoID, from model.TodoStatus, to model.TodoStatus) {
+	if !uc.config.AuditLog {
+		return
+	}
+	actor := anonymousActor
+	if user, ok := port.AuthenticatedUserFromContext(ctx); ok {
+		actor = string(user)
+	}
+	uc.logger.Info("todo status changed",
+		slog.String("todo_id", string(id)),
+		slog.String("from_status", string(from)),
+		slog.String("to_status", string(to)),
+		slog.String("actor", actor),
+		slog.Time("changed_at", time.Now()),
+	)
+}
+
+// repair backfills the completion timestamp of completed todos read without one,
+// logging each repair; it does nothing unless RepairInconsistentState is enabled
+func (uc *TodoUseCase) repair(todos ...*model.Todo) {
+	if !uc.config.RepairInconsistentState {
+		return
+	}
+	for _, todo := range todos {
+		if todo.RepairCompletedAt() {
+			uc.logger.Warn("repaired completed todo without a completion time",
+				slog.String("todo_id", string(todo.GetID())),
+				slog.Time("completed_at", *todo.GetCompletedAt()),
+			)
+		}
+	}
+}
+
+// publish emits a domain event under the request context; the state change is
+// already persisted, so failures are only logged.
+func (uc *TodoUseCase) publish(ctx context.Context, e interface{}) {
+	if err := uc.eventPublisher.Publish(ctx, e); err != nil {
+		uc.logger.Warn("failed to publish event",
+			slog.String("event", fmt.Sprintf("%T", e)),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// forWrite returns ctx with its repository reads sent to the primary. Use cases load
+// a todo through it before modifying and saving it, so a lagging replica cannot
+// hand them a stale copy whose write would revert a recent change.
+func forWrite(ctx context.Context) context.Context {
+	return port.WithReadConsistency(ctx, port.ReadConsistencyStrong)
+}
+
+// writeFailed maps a failed repository write to ErrTodoNotFound when it matched
+// no todo, so a write racing a delete is not reported as a storage failure
+func writeFailed(err error, fallback *model.DomainError) *model.DomainError {
+	if errors.Is(err, model.ErrTodoNotFound) {
+		return model.ErrTodoNotFound
+	}
+	return fallback
+}
+
+// checkBulkSize rejects bulk operations carrying more IDs than configured
+func (uc *TodoUseCase) checkBulkSize(ids []model.TodoID) *model.DomainError {
+	if limit := uc.config.MaxBulkOperationSize; len(ids) > limit {
+		return model.ErrBulkTooLarge.WithDetails(map[string]string{"max_size": strconv.Itoa(limit)})
+	}
+	return nil
+}
+
+func (uc *TodoUseCase) CreateTodoUseCase(ctx context.Context, cmd command.CreateTodoCommand) (model.TodoID, *model.DomainError) {
+	if uc.config.NormalizeTitles {
+		cmd.Title = model.NormalizeTitle(cmd.Title)
+	}
+	if cmd.Description == "" {
+		cmd.Description = uc.config.DefaultDescription
+	}
+	// An explicit priority is authoritative; only a missing one is inferred
+	if cmd.Priority == "" && uc.config.InferPriority {
+		cmd.Priority = string(uc.domainService.SuggestPriority(cmd.Title))
+	}
+
 	// Validate using domain service
 	if err := uc.domainService.ValidateCreateTodoCommand(cmd.Title, cmd.Description, cmd.Priority); err != nil {
 		return "", err
 	}
 
+	// The domain service only accepts an empty title when drafts are allowed
+	if strings.TrimSpace(cmd.Title) == "" {
+		cmd.Title = model.UntitledTitle
+	}
+
 	// Map priority string to domain type
 	var priority model.TodoPriority
 	switch cmd.Priority {
@@ -39,34 +222,75 @@ func (uc *TodoUseCase) CreateTodoUseCase(cmd command.CreateTodoCommand) (model.T
 		priority = model.TodoPriorityMedium
 	}
 
+	if cmd.CategoryID != "" {
+		if err := uc.checkCategoryExists(cmd.CategoryID); err != nil {
+			return "", err
+		}
+	}
+
 	todo := model.NewTodo(cmd.Title, cmd.Description, priority)
-	if err := uc.todoRepo.Save(todo); err != nil {
+	if cmd.CreatedBy != "" {
+		todo.AssignCreator(model.UserID(cmd.CreatedBy))
+	}
+	if cmd.CategoryID != "" {
+		todo.AssignCategory(model.CategoryID(cmd.CategoryID))
+	}
+	if cmd.DueDate != nil {
+		if err := todo.SetDueDate(*cmd.DueDate); err != nil {
+			return "", model.ErrInvalidDueDate
+		}
+	}
+	for _, tag := range cmd.Tags {
+		if err := todo.AddTag(tag); err != nil {
+			return "", model.ErrInvalidTag.WithDetails(map[string]string{"tag": tag})
+		}
+	}
+	if cmd.Source != "" {
+		if err := todo.SetSource(model.TodoSource(cmd.Source)); err != nil {
+			return "", model.ErrInvalidSource
+		}
+	}
+	if err := uc.todoRepo.Create(ctx, todo); err != nil {
 		return "", model.ErrFailedToSaveTodo
 	}
+	uc.publish(ctx, event.NewTodoCreatedEvent(todo.GetID(), todo.GetTitle(), todo.GetSource()))
 	return todo.GetID(), nil
 }
 
-func (uc *TodoUseCase) UpdateTodoUseCase(cmd command.UpdateTodoCommand) *model.DomainError {
+func (uc *TodoUseCase) UpdateTodoUseCase(ctx context.Context, cmd command.UpdateTodoCommand) *model.DomainError {
+	ctx = forWrite(ctx)
+	if uc.config.NormalizeTitles && cmd.Title != "" {
+		if cmd.Title = model.NormalizeTitle(cmd.Title); cmd.Title == "" {
+			return model.ErrEmptyTitle
+		}
+	}
+
 	// Validate using domain service
 	if err := uc.domainService.ValidateUpdateTodoCommand(cmd.Title, cmd.Description, cmd.Priority); err != nil {
 		return err
 	}
 
-	todo, err := uc.todoRepo.FindByID(model.TodoID(cmd.ID))
+	todo, err := uc.todoRepo.FindByID(ctx, model.TodoID(cmd.ID))
 	if err != nil {
 		return model.ErrTodoNotFound
 	}
+	oldPriority := todo.GetPriority()
+	changed := false
 
 	if cmd.Title != "" {
-		if err := todo.UpdateTitle(cmd.Title); err != nil {
+		updated, err := todo.UpdateTitle(cmd.Title, uc.domainService.Limits())
+		if err != nil {
 			return model.ErrInvalidTitle
 		}
+		changed = changed || updated
 	}
 
 	if cmd.Description != "" {
-		if err := todo.UpdateDescription(cmd.Description); err != nil {
+		updated, err := todo.UpdateDescription(cmd.Description, uc.domainService.Limits())
+		if err != nil {
 			return model.ErrInvalidDescription
 		}
+		changed = changed || updated
 	}
 
 	if cmd.Priority != "" {
@@ -81,66 +305,651 @@ func (uc *TodoUseCase) UpdateTodoUseCase(cmd command.UpdateTodoCommand) *model.D
 		default:
 			return model.ErrInvalidPriority
 		}
-		if err := todo.UpdatePriority(priority); err != nil {
+		updated, err := todo.UpdatePriority(priority)
+		if err != nil {
 			return model.ErrInvalidPriority
 		}
+		changed = changed || updated
+	}
+
+	if categoryID := model.CategoryID(cmd.CategoryID); categoryID != "" && categoryID != todo.GetCategoryID() {
+		if err := uc.checkCategoryExists(cmd.CategoryID); err != nil {
+			return err
+		}
+		todo.AssignCategory(categoryID)
+		changed = true
 	}
 
-	if err := uc.todoRepo.Save(todo); err != nil {
-		return model.ErrFailedToSaveTodo
+	if cmd.DueDate != nil {
+		if due := todo.GetDueDate(); due == nil || !due.Equal(*cmd.DueDate) {
+			if err := todo.SetDueDate(*cmd.DueDate); err != nil {
+				return model.ErrInvalidDueDate
+			}
+			changed = true
+		}
+	}
+
+	if cmd.Tags != nil {
+		updated, err := replaceTags(todo, cmd.Tags)
+		if err != nil {
+			return err
+		}
+		changed = changed || updated
+	}
+
+	// Nothing to write or announce when the command repeats the todo's current state
+	if !changed {
+		return nil
+	}
+
+	if err := uc.todoRepo.Update(ctx, todo); err != nil {
+		return writeFailed(err, model.ErrFailedToSaveTodo)
+	}
+	uc.publish(ctx, event.NewTodoUpdatedEvent(todo.GetID(), todo.GetTitle()))
+	if newPriority := todo.GetPriority(); newPriority != oldPriority {
+		uc.publish(ctx, event.NewTodoPriorityChangedEvent(todo.GetID(), oldPriority, newPriority))
+	}
+	return nil
+}
+
+// replaceTags swaps the todo's tags for the given set, keeping the ones it already carries,
+// and reports whether the set changed
+func replaceTags(todo *model.Todo, tags []string) (bool, *model.DomainError) {
+	changed := false
+	for _, tag := range todo.GetTags() {
+		if !slices.Contains(tags, tag) {
+			todo.RemoveTag(tag)
+			changed = true
+		}
+	}
+	for _, tag := range tags {
+		if todo.HasTag(tag) {
+			continue
+		}
+		if err := todo.AddTag(tag); err != nil {
+			return changed, model.ErrInvalidTag.WithDetails(map[string]string{"tag": tag})
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// checkCategoryExists returns ErrCategoryNotFound unless the category repository holds the category
+func (uc *TodoUseCase) checkCategoryExists(id string) *model.DomainError {
+	if uc.categoryRepo == nil {
+		return model.ErrCategoryNotFound.WithDetails(map[string]string{"category-id": id})
+	}
+	if _, err := uc.categoryRepo.FindByID(model.CategoryID(id)); err != nil {
+		return model.ErrCategoryNotFound.WithDetails(map[string]string{"category-id": id})
+	}
+	return nil
+}
+
+func (uc *TodoUseCase) CompleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	ctx = forWrite(ctx)
+	todo, from, derr := uc.completeTodo(ctx, uc.todoRepo, id)
+	if derr != nil {
+		return derr
+	}
+	if err := uc.todoRepo.Update(ctx, todo); err != nil {
+		return writeFailed(err, model.ErrFailedToSaveCompletedTodo)
 	}
+	if !uc.isCompletedStatePersisted(ctx, id) {
+		return model.ErrFailedToSaveCompletedTodo
+	}
+	uc.audit(ctx, id, from, todo.GetStatus())
+	uc.publish(ctx, event.NewTodoCompletedEvent(id))
 	return nil
 }
 
-func (uc *TodoUseCase) CompleteTodoUseCase(id model.TodoID) *model.DomainError {
-	todo, err := uc.todoRepo.FindByID(id)
+// completeTodo loads the todo from repo and marks it completed without saving
+// it, returning the status it had before
+func (uc *TodoUseCase) completeTodo(ctx context.Context, repo port.TodoRepositoryPort, id model.TodoID) (*model.Todo, model.TodoStatus, *model.DomainError) {
+	todo, err := repo.FindByID(ctx, id)
 	if err != nil {
-		return model.ErrTodoNotFound
+		return nil, "", model.ErrTodoNotFound
 	}
+	if uc.config.RequireCategoryForCompletion && todo.GetCategoryID() == "" {
+		return nil, "", model.ErrCategoryRequired
+	}
+	from := todo.GetStatus()
 	if err := todo.MarkAsCompleted(); err != nil {
-		return model.ErrCannotCompleteTodo
+		return nil, "", model.ErrCannotCompleteTodo
 	}
-	if err := uc.todoRepo.Save(todo); err != nil {
-		return model.ErrFailedToSaveCompletedTodo
+	return todo, from, nil
+}
+
+// BulkCompleteTodosUseCase completes every given todo and reports the outcome
+// per ID; a todo that cannot be completed does not stop the others. The todos
+// are loaded and written in one transaction, so either every completable todo
+// is saved or, when the write fails, none is and each is reported as failed.
+func (uc *TodoUseCase) BulkCompleteTodosUseCase(ctx context.Context, ids []model.TodoID) (*appmodel.BulkResult, *model.DomainError) {
+	if err := uc.checkBulkSize(ids); err != nil {
+		return nil, err
 	}
+
+	result := appmodel.NewBulkResult()
+	var completed []*model.Todo
+	var previous []model.TodoStatus
+	err := uc.transactions.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		seen := make(map[model.TodoID]bool, len(ids))
+		for _, id := range ids {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			todo, from, err := uc.completeTodo(ctx, repo, id)
+			if err != nil {
+				result.Failed[string(id)] = err.GetErrorMessage()
+				continue
+			}
+			completed = append(completed, todo)
+			previous = append(previous, from)
+		}
+		if len(completed) == 0 {
+			return nil
+		}
+		return repo.UpdateAll(ctx, completed)
+	})
+	if err != nil {
+		reason := writeFailed(err, model.ErrFailedToSaveCompletedTodo).GetErrorMessage()
+		for _, todo := range completed {
+			result.Failed[string(todo.GetID())] = reason
+		}
+		return result, nil
+	}
+	for i, todo := range completed {
+		result.Succeeded = append(result.Succeeded, string(todo.GetID()))
+		uc.audit(ctx, todo.GetID(), previous[i], todo.GetStatus())
+		uc.publish(ctx, event.NewTodoCompletedEvent(todo.GetID()))
+	}
+	return result, nil
+}
+
+// isCompletedStatePersisted reads the todo back and checks that the stored row
+// reflects the completed status and a non-null completion timestamp. The read
+// must observe the write just made, so it is never served by a lagging replica.
+func (uc *TodoUseCase) isCompletedStatePersisted(ctx context.Context, id model.TodoID) bool {
+	saved, err := uc.todoRepo.FindByID(forWrite(ctx), id)
+	if err != nil {
+		return false
+	}
+	return saved.IsCompleted() && saved.GetCompletedAt() != nil
+}
+
+// UncompleteTodoUseCase returns a completed todo to pending
+func (uc *TodoUseCase) UncompleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	ctx = forWrite(ctx)
+	todo, err := uc.todoRepo.FindByID(ctx, id)
+	if err != nil {
+		return model.ErrTodoNotFound
+	}
+	from := todo.GetStatus()
+	if err := todo.Uncomplete(); err != nil {
+		return model.ErrCannotUncompleteTodo
+	}
+	if err := uc.todoRepo.Update(ctx, todo); err != nil {
+		return writeFailed(err, model.ErrFailedToSaveTodo)
+	}
+	uc.audit(ctx, id, from, todo.GetStatus())
 	return nil
 }
 
-func (uc *TodoUseCase) ArchiveTodoUseCase(id model.TodoID) *model.DomainError {
-	todo, err := uc.todoRepo.FindByID(id)
+// ReopenTodoUseCase resets a completed todo to pending; archived todos cannot be reopened
+func (uc *TodoUseCase) ReopenTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	ctx = forWrite(ctx)
+	todo, err := uc.todoRepo.FindByID(ctx, id)
 	if err != nil {
 		return model.ErrTodoNotFound
 	}
+	from := todo.GetStatus()
+	if err := todo.MarkAsPending(); err != nil {
+		return model.ErrCannotReopenTodo
+	}
+	if err := uc.todoRepo.Update(ctx, todo); err != nil {
+		return writeFailed(err, model.ErrFailedToSaveTodo)
+	}
+	uc.audit(ctx, id, from, todo.GetStatus())
+	return nil
+}
+
+// UncompleteBatchUseCase reopens every given completed todo and returns the IDs
+// that were missing, not completed or could not be saved
+func (uc *TodoUseCase) UncompleteBatchUseCase(ctx context.Context, ids []model.TodoID) ([]model.TodoID, *model.DomainError) {
+	if err := uc.checkBulkSize(ids); err != nil {
+		return nil, err
+	}
+
+	var failed []model.TodoID
+	for _, id := range ids {
+		if err := uc.UncompleteTodoUseCase(ctx, id); err != nil {
+			failed = append(failed, id)
+		}
+	}
+	return failed, nil
+}
+
+func (uc *TodoUseCase) ArchiveTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	ctx = forWrite(ctx)
+	todo, err := uc.todoRepo.FindByID(ctx, id)
+	if err != nil {
+		return model.ErrTodoNotFound
+	}
+	if todo.IsCompleted() && !uc.config.AllowArchiveCompleted {
+		return model.ErrCannotArchiveTodo.WithDetails(map[string]string{"reason": "archiving completed todos is disabled"})
+	}
+	from := todo.GetStatus()
 	if err := todo.ArchiveTodo(); err != nil {
 		return model.ErrCannotArchiveTodo
 	}
-	if err := uc.todoRepo.Save(todo); err != nil {
-		return model.ErrFailedToSaveArchivedTodo
+	if err := uc.todoRepo.Update(ctx, todo); err != nil {
+		return writeFailed(err, model.ErrFailedToSaveArchivedTodo)
+	}
+	uc.audit(ctx, id, from, todo.GetStatus())
+	uc.publish(ctx, event.NewTodoArchivedEvent(id))
+	return nil
+}
+
+// UnarchiveTodoUseCase returns an archived todo to pending
+func (uc *TodoUseCase) UnarchiveTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	ctx = forWrite(ctx)
+	todo, err := uc.todoRepo.FindByID(ctx, id)
+	if err != nil {
+		return model.ErrTodoNotFound
+	}
+	from := todo.GetStatus()
+	if err := todo.UnarchiveTodo(); err != nil {
+		return model.ErrCannotUnarchiveTodo
+	}
+	if err := uc.todoRepo.Update(ctx, todo); err != nil {
+		return writeFailed(err, model.ErrFailedToSaveTodo)
 	}
+	uc.audit(ctx, id, from, todo.GetStatus())
+	uc.publish(ctx, event.NewTodoUnarchivedEvent(id, todo.GetTitle()))
 	return nil
 }
 
-func (uc *TodoUseCase) GetTodoUseCase(id model.TodoID) (*appmodel.TodoResponse, *model.DomainError) {
-	todo, err := uc.todoRepo.FindByID(id)
+func (uc *TodoUseCase) GetTodoUseCase(ctx context.Context, id model.TodoID) (*appmodel.TodoResponse, *model.DomainError) {
+	todo, err := uc.todoRepo.FindByID(ctx, id)
 	if err != nil {
+		// Only a failed read is served stale; a todo that is gone stays gone
+		if errors.Is(err, model.ErrTodoNotFound) {
+			uc.staleCache.evict(id)
+		} else if stale, ok := uc.staleCache.todo(id); ok && uc.config.StaleOnError {
+			return stale, nil
+		}
 		return nil, model.ErrTodoNotFound
 	}
+	uc.repair(todo)
+	response := appmodel.TodoResponseMapper(todo)
+	if uc.config.StaleOnError {
+		uc.staleCache.storeTodo(response)
+	}
+	return &response, nil
+}
+
+// GetRandomTodoUseCase picks a random pending todo to work on
+func (uc *TodoUseCase) GetRandomTodoUseCase(ctx context.Context) (*appmodel.TodoResponse, *model.DomainError) {
+	todo, err := uc.todoRepo.FindRandom(ctx)
+	if err != nil {
+		if errors.Is(err, model.ErrTodoNotFound) {
+			return nil, model.ErrTodoNotFound
+		}
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	uc.repair(todo)
 	response := appmodel.TodoResponseMapper(todo)
 	return &response, nil
 }
 
-func (uc *TodoUseCase) ListTodosUseCase() (*appmodel.TodoListResponse, *model.DomainError) {
+func (uc *TodoUseCase) ListTodosUseCase(ctx context.Context, q query.ListTodosQuery) (*appmodel.TodoListResponse, *model.DomainError) {
 	if uc.todoRepo == nil {
 		return nil, model.ErrRepositoryNotInitialized
 	}
-	todos, err := uc.todoRepo.FindAll()
+	if q.IncludeDeleted {
+		return uc.listTodosWithTombstones(ctx, q)
+	}
+	if q.StatusFilter != "" || q.PriorityFilter != "" || q.SortBy != "" || q.SortOrder != "" || q.Overdue || q.TagFilter != "" || q.SourceFilter != "" || q.CreatedByFilter != "" || q.CategoryFilter != "" {
+		return uc.listTodosFiltered(ctx, q)
+	}
+	if q.SearchTerm != "" {
+		return uc.searchTodos(ctx, q)
+	}
+
+	// Only the full, unpaginated list is kept for stale reads
+	unpaginated := q.Limit == 0 && q.Offset == 0
+	todos, total, err := uc.todoRepo.FindPaginated(ctx, q.Limit, q.Offset)
 	if err != nil {
+		if stale, ok := uc.staleCache.list(); ok && uc.config.StaleOnError && unpaginated {
+			return stale, nil
+		}
 		return nil, model.ErrFailedToRetrieveTodos
 	}
+	uc.repair(todos...)
 	response := appmodel.TodoListResponseMapper(todos)
+	response.Total = total
+	if unpaginated && uc.config.StaleOnError {
+		uc.staleCache.storeList(response)
+	}
+	return &response, nil
+}
+
+// searchTodos lists one page of the todos whose title or description contains the query's search term
+func (uc *TodoUseCase) searchTodos(ctx context.Context, q query.ListTodosQuery) (*appmodel.TodoListResponse, *model.DomainError) {
+	todos, err := uc.todoRepo.Search(ctx, q.SearchTerm)
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	uc.repair(todos...)
+	page := todos[min(q.Offset, len(todos)):]
+	if q.Limit > 0 {
+		page = page[:min(q.Limit, len(page))]
+	}
+	response := appmodel.TodoListResponseMapper(page)
+	response.Total = len(todos)
 	return &response, nil
 }
 
-func (uc *TodoUseCase) TestErrorUseCase() *model.DomainError {
+// listTodosWithTombstones lists todos as usual and appends a tombstone for every deleted todo
+func (uc *TodoUseCase) listTodosWithTombstones(ctx context.Context, q query.ListTodosQuery) (*appmodel.TodoListResponse, *model.DomainError) {
+	q.IncludeDeleted = false
+	response, err := uc.ListTodosUseCase(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	deleted, repoErr := uc.todoRepo.FindDeletedIDs(ctx)
+	if repoErr != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+
+	todos := make([]appmodel.TodoResponse, 0, len(response.Todos)+len(deleted))
+	todos = append(todos, response.Todos...)
+	for _, id := range deleted {
+		todos = append(todos, appmodel.TodoResponse{ID: string(id), Deleted: true})
+	}
+	response.Todos = todos
+	response.Count = len(todos)
+	return response, nil
+}
+
+// listTodosFiltered lists one page of the todos matching the query's filters in its sort order
+func (uc *TodoUseCase) listTodosFiltered(ctx context.Context, q query.ListTodosQuery) (*appmodel.TodoListResponse, *model.DomainError) {
+	filter, err := q.Filter(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	sort, err := model.ParseTodoSort(q.SortBy, q.SortOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	todos, total, repoErr := uc.todoRepo.FindFiltered(ctx, filter, sort, q.Limit, q.Offset)
+	if repoErr != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	uc.repair(todos...)
+	response := appmodel.TodoListResponseMapper(todos)
+	response.Total = total
+	return &response, nil
+}
+
+// CountTodosUseCase counts the todos matching the filter
+func (uc *TodoUseCase) CountTodosUseCase(ctx context.Context, filter model.TodoFilter) (*appmodel.CountResponse, *model.DomainError) {
+	count, err := uc.todoRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	return &appmodel.CountResponse{Count: count}, nil
+}
+
+// ListStaleTodosUseCase lists pending todos that have not been updated within olderThan
+func (uc *TodoUseCase) ListStaleTodosUseCase(ctx context.Context, olderThan time.Duration) (*appmodel.TodoListResponse, *model.DomainError) {
+	todos, err := uc.todoRepo.FindStale(ctx, olderThan)
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	response := appmodel.TodoListResponseMapper(todos)
+	return &response, nil
+}
+
+// SearchTodosUseCase lists the unarchived todos whose titles contain every word of text
+func (uc *TodoUseCase) SearchTodosUseCase(ctx context.Context, text string) (*appmodel.TodoListResponse, *model.DomainError) {
+	terms := model.TitleTerms(text)
+	if len(terms) == 0 {
+		return nil, model.ErrInvalidQueryParam.WithDetails(map[string]string{"param": "q", "value": text})
+	}
+	if uc.searchIndex == nil || !uc.searchIndex.Ready() {
+		return uc.scanTodos(ctx, terms)
+	}
+
+	ids, err := uc.searchIndex.Query(ctx, terms)
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	found, err := uc.todoRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	byID := make(map[model.TodoID]*model.Todo, len(found))
+	for _, todo := range found {
+		byID[todo.GetID()] = todo
+	}
+	// Keep the index's order, skipping hits deleted or archived since they were indexed
+	todos := make([]*model.Todo, 0, len(ids))
+	for _, id := range ids {
+		if todo, ok := byID[id]; ok && !todo.IsArchived() {
+			todos = append(todos, todo)
+		}
+	}
+	uc.repair(todos...)
+	response := appmodel.TodoListResponseMapper(todos)
+	return &response, nil
+}
+
+// scanTodos answers a title search without an index by matching every todo
+func (uc *TodoUseCase) scanTodos(ctx context.Context, terms []string) (*appmodel.TodoListResponse, *model.DomainError) {
+	all, err := uc.todoRepo.FindAll(ctx)
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	todos := make([]*model.Todo, 0, len(all))
+	for _, todo := range all {
+		if !todo.IsArchived() && todo.MatchesTitleTerms(terms) {
+			todos = append(todos, todo)
+		}
+	}
+	uc.repair(todos...)
+	response := appmodel.TodoListResponseMapper(todos)
+	return &response, nil
+}
+
+// ListTodosByPriorityUseCase groups todos into high, medium and low priority buckets
+func (uc *TodoUseCase) ListTodosByPriorityUseCase(ctx context.Context, includeArchived bool) (*appmodel.TodosByPriorityResponse, *model.DomainError) {
+	todos, err := uc.todoRepo.FindOrderedByPriority(ctx, includeArchived)
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	uc.repair(todos...)
+	response := appmodel.TodosByPriorityResponseMapper(todos)
+	return &response, nil
+}
+
+// DeleteTodosUseCase deletes all given todos and returns the IDs that could not be deleted
+func (uc *TodoUseCase) DeleteTodosUseCase(ctx context.Context, ids []model.TodoID) ([]model.TodoID, *model.DomainError) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	if err := uc.checkBulkSize(ids); err != nil {
+		return nil, err
+	}
+	failed, err := uc.todoRepo.DeleteByIDs(ctx, ids)
+	if err != nil {
+		return nil, model.ErrFailedToDeleteTodo
+	}
+	uc.staleCache.evict(ids...)
+	published := make(map[model.TodoID]bool, len(ids))
+	for _, id := range failed {
+		published[id] = true
+	}
+	for _, id := range ids {
+		if !published[id] {
+			published[id] = true
+			uc.publish(ctx, event.NewTodoDeletedEvent(id))
+		}
+	}
+	return failed, nil
+}
+
+// DeleteTodoUseCase deletes a single todo
+func (uc *TodoUseCase) DeleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	ctx = forWrite(ctx)
+	if _, err := uc.todoRepo.FindByID(ctx, id); err != nil {
+		return model.ErrTodoNotFound
+	}
+	if err := uc.todoRepo.Delete(ctx, id); err != nil {
+		return writeFailed(err, model.ErrFailedToDeleteTodo)
+	}
+	uc.staleCache.evict(id)
+	uc.publish(ctx, event.NewTodoDeletedEvent(id))
+	return nil
+}
+
+// GetDashboardUseCase summarizes the todos owned by the given user
+func (uc *TodoUseCase) GetDashboardUseCase(ctx context.Context, owner model.UserID) (*appmodel.DashboardResponse, *model.DomainError) {
+	todos, err := uc.todoRepo.FindByCreatedBy(ctx, owner)
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	response := appmodel.DashboardResponseMapper(owner, todos, time.Now())
+	return &response, nil
+}
+
+// CompletionTimeStatsUseCase reports the average time from creation to completion per priority
+func (uc *TodoUseCase) CompletionTimeStatsUseCase(ctx context.Context) (*appmodel.CompletionTimeStatsResponse, *model.DomainError) {
+	stats, err := uc.todoRepo.CompletionTimeStats(ctx)
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	response := appmodel.CompletionTimeStatsResponseMapper(stats)
+	return &response, nil
+}
+
+// SnapshotUseCase serializes every todo to JSON
+func (uc *TodoUseCase) SnapshotUseCase(ctx context.Context) ([]byte, *model.DomainError) {
+	todos, err := uc.todoRepo.FindAll(ctx)
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	data, err := json.Marshal(appmodel.SnapshotMapper(todos, time.Now()))
+	if err != nil {
+		return nil, model.ErrFailedToRetrieveTodos
+	}
+	return data, nil
+}
+
+// RestoreSnapshotUseCase saves every todo in a snapshot, first deleting all
+// existing todos when replace is set. The snapshot is fully validated before
+// anything is changed, and the deletes and saves run in one transaction so a
+// failed restore leaves the existing todos in place.
+func (uc *TodoUseCase) RestoreSnapshotUseCase(ctx context.Context, data []byte, replace bool) *model.DomainError {
+	var snapshot appmodel.Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return model.ErrInvalidSnapshot.WithDetails(map[string]string{"reason": err.Error()})
+	}
+	if snapshot.Version != appmodel.SnapshotVersion {
+		return model.ErrInvalidSnapshot.WithDetails(map[string]string{"reason": fmt.Sprintf("unsupported version %d", snapshot.Version)})
+	}
+
+	todos := make([]*model.Todo, len(snapshot.Todos))
+	for i, record := range snapshot.Todos {
+		todo := record.ToModel()
+		if record.ID == "" {
+			return model.ErrInvalidSnapshot.WithDetails(map[string]string{"reason": fmt.Sprintf("todo %d has no id", i)})
+		}
+		if err := todo.Validate(uc.domainService.Limits()); err != nil {
+			return model.ErrInvalidSnapshot.WithDetails(map[string]string{"id": record.ID, "reason": err.Error()})
+		}
+		todos[i] = todo
+	}
+
+	var deleted []model.TodoID
+	var failure *model.DomainError
+	err := uc.transactions.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		if replace {
+			existing, err := repo.FindAll(ctx)
+			if err != nil {
+				failure = model.ErrFailedToRetrieveTodos
+				return err
+			}
+			ids := make([]model.TodoID, len(existing))
+			for i, todo := range existing {
+				ids[i] = todo.GetID()
+			}
+			if len(ids) > 0 {
+				if _, err := repo.DeleteByIDs(ctx, ids); err != nil {
+					failure = model.ErrFailedToDeleteTodo
+					return err
+				}
+				deleted = ids
+			}
+		}
+
+		for _, todo := range todos {
+			if err := repo.Save(ctx, todo); err != nil {
+				failure = model.ErrFailedToSaveTodo
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if failure == nil {
+			// The work succeeded but the transaction could not be committed
+			failure = model.ErrFailedToSaveTodo
+		}
+		return failure
+	}
+	uc.staleCache.evict(deleted...)
+	for _, id := range deleted {
+		uc.publish(ctx, event.NewTodoDeletedEvent(id))
+	}
+	for _, todo := range todos {
+		uc.publish(ctx, event.NewTodoRestoredEvent(todo.GetID(), todo.GetTitle(), todo.IsArchived()))
+	}
+	return nil
+}
+
+// ValidateFieldUseCase validates a single field value with the domain service's per-field validator
+func (uc *TodoUseCase) ValidateFieldUseCase(ctx context.Context, cmd command.ValidateFieldCommand) (*appmodel.FieldValidationResponse, *model.DomainError) {
+	var validationErr *model.DomainError
+	switch cmd.Field {
+	case "title":
+		validationErr = uc.domainService.ValidateTitle(cmd.Value)
+	case "description":
+		validationErr = uc.domainService.ValidateDescription(cmd.Value)
+	case "priority":
+		validationErr = uc.domainService.ValidatePriority(cmd.Value)
+	default:
+		return nil, model.ErrUnknownField
+	}
+	response := appmodel.FieldValidationResponseMapper(validationErr)
+	return &response, nil
+}
+
+func (uc *TodoUseCase) TestErrorUseCase(ctx context.Context) *model.DomainError {
 	return model.ErrTestError
 }
+
+// ReadinessUseCase reports whether the todo repository can serve requests
+func (uc *TodoUseCase) ReadinessUseCase(ctx context.Context) *model.DomainError {
+	if uc.todoRepo == nil {
+		return model.ErrRepositoryNotInitialized
+	}
+	if err := uc.todoRepo.Ping(ctx); err != nil {
+		// The reason names hosts and credentials, so it is logged rather than returned
+		uc.logger.Warn("database readiness check failed", slog.String("error", err.Error()))
+		return model.ErrDatabaseUnavailable
+	}
+	return nil
+}
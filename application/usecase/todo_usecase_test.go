@@ -1,27 +1,57 @@
 package usecase
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/mr3iscuit/ddd-golang/application/command"
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/application/query"
+	"github.com/mr3iscuit/ddd-golang/domain/event"
 	"github.com/mr3iscuit/ddd-golang/domain/model"
 	"github.com/mr3iscuit/ddd-golang/domain/service"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/messaging"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/projection"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
 )
 
 type MockTodoRepository struct {
 	mock.Mock
 }
 
-func (m *MockTodoRepository) Save(todo *model.Todo) error {
+func (m *MockTodoRepository) Save(ctx context.Context, todo *model.Todo) error {
 	args := m.Called(todo)
 	return args.Error(0)
 }
 
-func (m *MockTodoRepository) FindByID(id model.TodoID) (*model.Todo, error) {
+func (m *MockTodoRepository) Create(ctx context.Context, todo *model.Todo) error {
+	args := m.Called(todo)
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) Update(ctx context.Context, todo *model.Todo) error {
+	args := m.Called(todo)
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) UpdateAll(ctx context.Context, todos []*model.Todo) error {
+	args := m.Called(todos)
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) FindByID(ctx context.Context, id model.TodoID) (*model.Todo, error) {
 	args := m.Called(id)
 	if todo, ok := args.Get(0).(*model.Todo); ok {
 		return todo, args.Error(1)
@@ -29,7 +59,15 @@ func (m *MockTodoRepository) FindByID(id model.TodoID) (*model.Todo, error) {
 	return nil, args.Error(1)
 }
 
-func (m *MockTodoRepository) FindAll() ([]*model.Todo, error) {
+func (m *MockTodoRepository) FindByIDs(ctx context.Context, ids []model.TodoID) ([]*model.Todo, error) {
+	args := m.Called(ids)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindAll(ctx context.Context) ([]*model.Todo, error) {
 	args := m.Called()
 	if todos, ok := args.Get(0).([]*model.Todo); ok {
 		return todos, args.Error(1)
@@ -37,34 +75,220 @@ func (m *MockTodoRepository) FindAll() ([]*model.Todo, error) {
 	return nil, args.Error(1)
 }
 
-func (m *MockTodoRepository) Delete(id model.TodoID) error {
+func (m *MockTodoRepository) FindPaginated(ctx context.Context, limit, offset int) ([]*model.Todo, int, error) {
+	args := m.Called(limit, offset)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Int(1), args.Error(2)
+	}
+	return nil, args.Int(1), args.Error(2)
+}
+
+func (m *MockTodoRepository) FindFiltered(ctx context.Context, filter model.TodoFilter, sort model.TodoSort, limit, offset int) ([]*model.Todo, int, error) {
+	args := m.Called(filter, sort, limit, offset)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Int(1), args.Error(2)
+	}
+	return nil, args.Int(1), args.Error(2)
+}
+
+func (m *MockTodoRepository) FindByStatus(ctx context.Context, status model.TodoStatus) ([]*model.Todo, error) {
+	args := m.Called(status)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindByCreatedBy(ctx context.Context, userID model.UserID) ([]*model.Todo, error) {
+	args := m.Called(userID)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) Delete(ctx context.Context, id model.TodoID) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
 
+func (m *MockTodoRepository) DeleteByIDs(ctx context.Context, ids []model.TodoID) ([]model.TodoID, error) {
+	args := m.Called(ids)
+	if missing, ok := args.Get(0).([]model.TodoID); ok {
+		return missing, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) Ping(ctx context.Context) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) LockDependencies(ctx context.Context, ids ...model.TodoID) error {
+	args := m.Called(ids)
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) CompletionTimeStats(ctx context.Context) ([]model.CompletionTimeStat, error) {
+	args := m.Called()
+	if stats, ok := args.Get(0).([]model.CompletionTimeStat); ok {
+		return stats, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindRandom(ctx context.Context) (*model.Todo, error) {
+	args := m.Called()
+	if todo, ok := args.Get(0).(*model.Todo); ok {
+		return todo, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindStale(ctx context.Context, olderThan time.Duration) ([]*model.Todo, error) {
+	args := m.Called(olderThan)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) Search(ctx context.Context, term string) ([]*model.Todo, error) {
+	args := m.Called(term)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindOrderedByPriority(ctx context.Context, includeArchived bool) ([]*model.Todo, error) {
+	args := m.Called(includeArchived)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindDeletedIDs(ctx context.Context) ([]model.TodoID, error) {
+	args := m.Called()
+	if ids, ok := args.Get(0).([]model.TodoID); ok {
+		return ids, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) Count(ctx context.Context, filter model.TodoFilter) (int, error) {
+	args := m.Called(filter)
+	return args.Int(0), args.Error(1)
+}
+
 func TestCreateTodoUseCase_Success(t *testing.T) {
 	repo := new(MockTodoRepository)
 	domainService := service.NewTodoDomainService()
 	uc := NewTodoUseCase(repo, domainService)
 	cmd := command.CreateTodoCommand{Title: "Test", Description: "Desc", Priority: "high"}
 
-	repo.On("Save", mock.AnythingOfType("*model.Todo")).Return(nil)
+	repo.On("Create", mock.AnythingOfType("*model.Todo")).Return(nil)
+
+	id, err := uc.CreateTodoUseCase(context.Background(), cmd)
+	assert.NotEmpty(t, id)
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+// recordingDomainService wraps the real domain service and counts create validations
+type recordingDomainService struct {
+	*service.TodoDomainService
+	createValidations int
+}
+
+func (s *recordingDomainService) ValidateCreateTodoCommand(title, description, priority string) *model.DomainError {
+	s.createValidations++
+	return s.TodoDomainService.ValidateCreateTodoCommand(title, description, priority)
+}
+
+func TestNewTodoUseCase_UsesInjectedDomainService(t *testing.T) {
+	repo := new(MockTodoRepository)
+	domainService := &recordingDomainService{TodoDomainService: service.NewTodoDomainService()}
+	uc := NewTodoUseCase(repo, domainService)
+	assert.Same(t, domainService, uc.domainService)
+	repo.On("Create", mock.AnythingOfType("*model.Todo")).Return(nil)
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Injected", Priority: "low"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, domainService.createValidations)
+}
+
+func TestCreateTodoUseCase_NormalizesTitle(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	cmd := command.CreateTodoCommand{Title: "  Buy   milk  ", Priority: "low"}
+
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetTitle() == "Buy milk"
+	})).Return(nil)
 
-	id, err := uc.CreateTodoUseCase(cmd)
+	id, err := uc.CreateTodoUseCase(context.Background(), cmd)
 	assert.NotEmpty(t, id)
 	assert.Nil(t, err)
 	repo.AssertExpectations(t)
 }
 
+func TestCreateTodoUseCase_ValidatesNormalizedTitle(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	// 40 words separated by long whitespace runs: over 100 characters raw, under 100 once normalized
+	title := strings.Repeat("a     ", 40)
+	cmd := command.CreateTodoCommand{Title: title, Priority: "low"}
+
+	repo.On("Create", mock.AnythingOfType("*model.Todo")).Return(nil)
+
+	_, err := uc.CreateTodoUseCase(context.Background(), cmd)
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateTodoUseCase_NormalizationDisabled(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.NormalizeTitles = false
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	cmd := command.CreateTodoCommand{Title: "  Buy   milk  ", Priority: "low"}
+
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetTitle() == "  Buy   milk  "
+	})).Return(nil)
+
+	_, err := uc.CreateTodoUseCase(context.Background(), cmd)
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateTodoUseCase_NormalizesTitle(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Original", "Desc", model.TodoPriorityMedium)
+	cmd := command.UpdateTodoCommand{ID: "test-id", Title: " New \t title "}
+
+	repo.On("FindByID", model.TodoID("test-id")).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.UpdateTodoUseCase(context.Background(), cmd)
+	assert.Nil(t, err)
+	assert.Equal(t, "New title", todo.GetTitle())
+	repo.AssertExpectations(t)
+}
+
 func TestCreateTodoUseCase_SaveError(t *testing.T) {
 	repo := new(MockTodoRepository)
 	domainService := service.NewTodoDomainService()
 	uc := NewTodoUseCase(repo, domainService)
 	cmd := command.CreateTodoCommand{Title: "Test", Description: "Desc", Priority: "high"}
 
-	repo.On("Save", mock.AnythingOfType("*model.Todo")).Return(errors.New("db error"))
+	repo.On("Create", mock.AnythingOfType("*model.Todo")).Return(errors.New("db error"))
 
-	id, err := uc.CreateTodoUseCase(cmd)
+	id, err := uc.CreateTodoUseCase(context.Background(), cmd)
 	assert.Empty(t, id)
 	assert.NotNil(t, err)
 	assert.Equal(t, "Failed to save todo", err.GetErrorMessage())
@@ -79,13 +303,218 @@ func TestUpdateTodoUseCase_Success(t *testing.T) {
 	cmd := command.UpdateTodoCommand{ID: "test-id", Title: "Updated"}
 
 	repo.On("FindByID", model.TodoID("test-id")).Return(todo, nil)
-	repo.On("Save", todo).Return(nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.UpdateTodoUseCase(context.Background(), cmd)
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+// capturingEventPublisher records published events and fails with err when it is set
+type capturingEventPublisher struct {
+	events   []interface{}
+	contexts []context.Context
+	err      error
+}
+
+func (p *capturingEventPublisher) Publish(ctx context.Context, e interface{}) error {
+	p.events = append(p.events, e)
+	p.contexts = append(p.contexts, ctx)
+	return p.err
+}
+
+func TestCreateTodoUseCase_PublishesCreated(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	repo.On("Create", mock.AnythingOfType("*model.Todo")).Return(nil)
+
+	id, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low"})
+	assert.Nil(t, err)
+	assert.Len(t, publisher.events, 1)
+	created, ok := publisher.events[0].(*event.TodoCreatedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, id, created.TodoID)
+	repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCreateTodoUseCase_LogsPublishFailure(t *testing.T) {
+	repo := new(MockTodoRepository)
+	var logs bytes.Buffer
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(),
+		WithEventPublisher(&capturingEventPublisher{err: errors.New("queue full")}),
+		WithLogger(slog.New(slog.NewJSONHandler(&logs, nil))),
+	)
+	repo.On("Create", mock.AnythingOfType("*model.Todo")).Return(nil)
+
+	// The todo is already stored, so a failed publish does not fail the request
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low"})
+	assert.Nil(t, err)
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Equal(t, "WARN", entry["level"])
+	assert.Equal(t, "failed to publish event", entry["msg"])
+	assert.Equal(t, "*event.TodoCreatedEvent", entry["event"])
+	assert.Equal(t, "queue full", entry["error"])
+}
+
+type requestKey struct{}
+
+func TestCreateTodoUseCase_PublishesUnderRequestContext(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	repo.On("Create", mock.AnythingOfType("*model.Todo")).Return(nil)
+
+	ctx := context.WithValue(context.Background(), requestKey{}, "req-1")
+	_, err := uc.CreateTodoUseCase(ctx, command.CreateTodoCommand{Title: "Test", Priority: "low"})
+	assert.Nil(t, err)
+	assert.Len(t, publisher.contexts, 1)
+	assert.Equal(t, "req-1", publisher.contexts[0].Value(requestKey{}))
+}
+
+func TestCreateTodoUseCase_RecordsSource(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetSource() == model.TodoSourceCLI
+	})).Return(nil)
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low", Source: "cli"})
+	assert.Nil(t, err)
+	created := publisher.events[0].(*event.TodoCreatedEvent)
+	assert.Equal(t, model.TodoSourceCLI, created.Source)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateTodoUseCase_InvalidSource(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low", Source: "fax"})
+	assert.Equal(t, model.ErrInvalidSource.GetErrorCode(), err.GetErrorCode())
+	repo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestListTodosUseCase_SourceFilter(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("FindFiltered", model.TodoFilter{Source: model.TodoSourceImport}, model.TodoSort{}, 0, 0).Return([]*model.Todo{}, 0, nil)
+
+	_, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{SourceFilter: "import"})
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+
+	_, err = uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{SourceFilter: "fax"})
+	assert.Equal(t, model.ErrInvalidQueryParam.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, "source", err.GetDetails()["param"])
+}
+
+func TestCreateTodoUseCase_CreateFailurePublishesNothing(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	repo.On("Create", mock.AnythingOfType("*model.Todo")).Return(errors.New("todo already exists"))
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low"})
+	assert.Equal(t, model.ErrFailedToSaveTodo, err)
+	assert.Empty(t, publisher.events)
+}
+
+func TestUpdateTodoUseCase_UpdateFailure(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	todo := model.NewTodo("Original", "Desc", model.TodoPriorityMedium)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(errors.New("todo not found"))
+
+	err := uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), Title: "Renamed"})
+	assert.Equal(t, model.ErrFailedToSaveTodo, err)
+	assert.Empty(t, publisher.events)
+	repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestUpdateTodoUseCase_UpdateMatchesNoRow(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	todo := model.NewTodo("Original", "Desc", model.TodoPriorityMedium)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	// The todo was deleted between the read and the write
+	repo.On("Update", todo).Return(fmt.Errorf("todo with id %s not found: %w", todo.GetID(), model.ErrTodoNotFound))
+
+	err := uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), Title: "Renamed"})
+	assert.Equal(t, model.ErrTodoNotFound, err)
+	assert.Empty(t, publisher.events)
+}
+
+func TestUpdateTodoUseCase_PublishesPriorityChanged(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	todo := model.NewTodo("Original", "Desc", model.TodoPriorityMedium)
+	cmd := command.UpdateTodoCommand{ID: "test-id", Priority: "high"}
+
+	repo.On("FindByID", model.TodoID("test-id")).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.UpdateTodoUseCase(context.Background(), cmd)
+	assert.Nil(t, err)
+	assert.Len(t, publisher.events, 2)
+	_, ok := publisher.events[0].(*event.TodoUpdatedEvent)
+	assert.True(t, ok)
+	changed, ok := publisher.events[1].(*event.TodoPriorityChangedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, todo.GetID(), changed.TodoID)
+	assert.Equal(t, model.TodoPriorityMedium, changed.OldPriority)
+	assert.Equal(t, model.TodoPriorityHigh, changed.NewPriority)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateTodoUseCase_SamePriorityPublishesNothing(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	todo := model.NewTodo("Original", "Desc", model.TodoPriorityMedium)
+	cmd := command.UpdateTodoCommand{ID: "test-id", Title: "Renamed", Priority: "medium"}
+
+	repo.On("FindByID", model.TodoID("test-id")).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
 
-	err := uc.UpdateTodoUseCase(cmd)
+	err := uc.UpdateTodoUseCase(context.Background(), cmd)
 	assert.Nil(t, err)
+	// Only the update itself is announced
+	assert.Len(t, publisher.events, 1)
+	_, ok := publisher.events[0].(*event.TodoUpdatedEvent)
+	assert.True(t, ok)
 	repo.AssertExpectations(t)
 }
 
+func TestUpdateTodoUseCase_UnchangedTodoWritesAndPublishesNothing(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	todo := model.NewTodo("Original", "Desc", model.TodoPriorityMedium)
+	assert.NoError(t, todo.AddTag("home"))
+	updatedAt := todo.GetUpdatedAt()
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+
+	err := uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{
+		ID:          string(todo.GetID()),
+		Title:       "Original",
+		Description: "Desc",
+		Priority:    "medium",
+		Tags:        []string{"home"},
+	})
+	assert.Nil(t, err)
+	assert.Empty(t, publisher.events)
+	assert.Equal(t, updatedAt, todo.GetUpdatedAt())
+	repo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
 func TestUpdateTodoUseCase_NotFound(t *testing.T) {
 	repo := new(MockTodoRepository)
 	domainService := service.NewTodoDomainService()
@@ -94,7 +523,7 @@ func TestUpdateTodoUseCase_NotFound(t *testing.T) {
 
 	repo.On("FindByID", model.TodoID("notfound")).Return(nil, errors.New("not found"))
 
-	err := uc.UpdateTodoUseCase(cmd)
+	err := uc.UpdateTodoUseCase(context.Background(), cmd)
 	assert.NotNil(t, err)
 	assert.Equal(t, "Todo not found", err.GetErrorMessage())
 	repo.AssertExpectations(t)
@@ -111,7 +540,7 @@ func TestUpdateTodoUseCase_InvalidTitle(t *testing.T) {
 
 	// Note: FindByID is not called because domain validation fails first
 
-	err := uc.UpdateTodoUseCase(cmd)
+	err := uc.UpdateTodoUseCase(context.Background(), cmd)
 	assert.NotNil(t, err)
 	assert.Equal(t, "Title too long", err.GetErrorMessage())
 	repo.AssertExpectations(t)
@@ -124,13 +553,33 @@ func TestCompleteTodoUseCase_Success(t *testing.T) {
 	todo := model.NewTodo("Test", "Desc", model.TodoPriorityMedium)
 
 	repo.On("FindByID", todo.GetID()).Return(todo, nil)
-	repo.On("Save", todo).Return(nil)
+	repo.On("Update", todo).Return(nil)
 
-	err := uc.CompleteTodoUseCase(todo.GetID())
+	err := uc.CompleteTodoUseCase(context.Background(), todo.GetID())
 	assert.Nil(t, err)
 	repo.AssertExpectations(t)
 }
 
+func TestCompleteTodoUseCase_PublishesCompleted(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	todo := model.NewTodo("Test", "Desc", model.TodoPriorityMedium)
+
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	assert.Nil(t, uc.CompleteTodoUseCase(context.Background(), todo.GetID()))
+	assert.Len(t, publisher.events, 1)
+	completed, ok := publisher.events[0].(*event.TodoCompletedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, todo.GetID(), completed.TodoID)
+
+	// Completing again fails and publishes nothing further
+	assert.NotNil(t, uc.CompleteTodoUseCase(context.Background(), todo.GetID()))
+	assert.Len(t, publisher.events, 1)
+}
+
 func TestCompleteTodoUseCase_NotFound(t *testing.T) {
 	repo := new(MockTodoRepository)
 	domainService := service.NewTodoDomainService()
@@ -139,7 +588,7 @@ func TestCompleteTodoUseCase_NotFound(t *testing.T) {
 
 	repo.On("FindByID", id).Return(nil, errors.New("not found"))
 
-	err := uc.CompleteTodoUseCase(id)
+	err := uc.CompleteTodoUseCase(context.Background(), id)
 	assert.NotNil(t, err)
 	assert.Equal(t, "Todo not found", err.GetErrorMessage())
 	repo.AssertExpectations(t)
@@ -154,79 +603,273 @@ func TestCompleteTodoUseCase_AlreadyCompleted(t *testing.T) {
 
 	repo.On("FindByID", todo.GetID()).Return(todo, nil)
 
-	err := uc.CompleteTodoUseCase(todo.GetID())
+	err := uc.CompleteTodoUseCase(context.Background(), todo.GetID())
 	assert.NotNil(t, err)
 	assert.Equal(t, "Cannot complete todo", err.GetErrorMessage())
 	repo.AssertExpectations(t)
 }
 
-func TestArchiveTodoUseCase_Success(t *testing.T) {
+func TestCompleteTodoUseCase_PersistedStateMismatch(t *testing.T) {
 	repo := new(MockTodoRepository)
 	domainService := service.NewTodoDomainService()
 	uc := NewTodoUseCase(repo, domainService)
 	todo := model.NewTodo("Test", "Desc", model.TodoPriorityMedium)
+	// Simulates a faulty mapper that drops completed_at when reading the row back
+	faulty := model.NewTodoFromData(todo.GetID(), "Test", "Desc", model.TodoStatusCompleted,
+		model.TodoPriorityMedium, todo.GetCreatedAt(), todo.GetUpdatedAt(), nil, "", "", nil, nil, "")
 
-	repo.On("FindByID", todo.GetID()).Return(todo, nil)
-	repo.On("Save", todo).Return(nil)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil).Once()
+	repo.On("Update", todo).Return(nil)
+	repo.On("FindByID", todo.GetID()).Return(faulty, nil).Once()
 
-	err := uc.ArchiveTodoUseCase(todo.GetID())
-	assert.Nil(t, err)
+	err := uc.CompleteTodoUseCase(context.Background(), todo.GetID())
+	assert.NotNil(t, err)
+	assert.Equal(t, "Failed to save completed todo", err.GetErrorMessage())
 	repo.AssertExpectations(t)
 }
 
-func TestArchiveTodoUseCase_NotFound(t *testing.T) {
-	repo := new(MockTodoRepository)
-	domainService := service.NewTodoDomainService()
-	uc := NewTodoUseCase(repo, domainService)
-	id := model.TodoID("notfound")
+func TestCompleteTodoUseCase_ReadsBackFromPrimaryDespiteStaleReplica(t *testing.T) {
+	ctx := context.Background()
+	todo := model.NewSimpleTodo("Test")
+	primary, replica := repository.NewInMemoryTodoRepository(), repository.NewInMemoryTodoRepository()
+	assert.NoError(t, primary.Save(ctx, todo))
+	// The replica lags behind and keeps serving the pending row
+	assert.NoError(t, replica.Save(ctx, todo))
+	uc := NewTodoUseCase(repository.NewRoutingTodoRepository(primary, replica), service.NewTodoDomainService())
 
-	repo.On("FindByID", id).Return(nil, errors.New("not found"))
+	err := uc.CompleteTodoUseCase(ctx, todo.GetID())
+	assert.Nil(t, err)
+	stale, findErr := replica.FindByID(ctx, todo.GetID())
+	assert.NoError(t, findErr)
+	assert.Equal(t, model.TodoStatusPending, stale.GetStatus())
+	saved, findErr := primary.FindByID(ctx, todo.GetID())
+	assert.NoError(t, findErr)
+	assert.Equal(t, model.TodoStatusCompleted, saved.GetStatus())
+}
 
-	err := uc.ArchiveTodoUseCase(id)
-	assert.NotNil(t, err)
-	assert.Equal(t, "Todo not found", err.GetErrorMessage())
-	repo.AssertExpectations(t)
+func TestWriteUseCases_LoadFromPrimaryDespiteStaleReplica(t *testing.T) {
+	ctx := context.Background()
+	for name, write := range map[string]func(uc *TodoUseCase, id model.TodoID) *model.DomainError{
+		"update": func(uc *TodoUseCase, id model.TodoID) *model.DomainError {
+			return uc.UpdateTodoUseCase(ctx, command.UpdateTodoCommand{ID: string(id), Description: "Changed"})
+		},
+		"complete": func(uc *TodoUseCase, id model.TodoID) *model.DomainError { return uc.CompleteTodoUseCase(ctx, id) },
+		"archive":  func(uc *TodoUseCase, id model.TodoID) *model.DomainError { return uc.ArchiveTodoUseCase(ctx, id) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			todo := model.NewTodo("Original", "Desc", model.TodoPriorityLow)
+			primary, replica := repository.NewInMemoryTodoRepository(), repository.NewInMemoryTodoRepository()
+			// The replica has not yet seen the rename committed on the primary
+			assert.NoError(t, replica.Save(ctx, todo))
+			_, err := todo.UpdateTitle("Renamed", model.DefaultTodoLimits())
+			assert.NoError(t, err)
+			assert.NoError(t, primary.Save(ctx, todo))
+			uc := NewTodoUseCase(repository.NewRoutingTodoRepository(primary, replica), service.NewTodoDomainService())
+
+			assert.Nil(t, write(uc, todo.GetID()))
+			saved, findErr := primary.FindByID(ctx, todo.GetID())
+			assert.NoError(t, findErr)
+			assert.Equal(t, "Renamed", saved.GetTitle())
+		})
+	}
 }
 
-func TestGetTodoUseCase_Success(t *testing.T) {
+func TestDeleteTodoUseCase_FindsTodoMissingFromStaleReplica(t *testing.T) {
+	ctx := context.Background()
+	todo := model.NewSimpleTodo("Just created")
+	primary, replica := repository.NewInMemoryTodoRepository(), repository.NewInMemoryTodoRepository()
+	assert.NoError(t, primary.Save(ctx, todo))
+	uc := NewTodoUseCase(repository.NewRoutingTodoRepository(primary, replica), service.NewTodoDomainService())
+
+	assert.Nil(t, uc.DeleteTodoUseCase(ctx, todo.GetID()))
+	_, err := primary.FindByID(ctx, todo.GetID())
+	assert.Error(t, err)
+}
+
+func TestUncompleteTodoUseCase_Success(t *testing.T) {
 	repo := new(MockTodoRepository)
-	domainService := service.NewTodoDomainService()
-	uc := NewTodoUseCase(repo, domainService)
-	todo := model.NewTodo("Test", "Desc", model.TodoPriorityMedium)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Done", "Desc", model.TodoPriorityMedium)
+	todo.MarkAsCompleted()
+
 	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", mock.MatchedBy(func(saved *model.Todo) bool {
+		return saved.IsPending() && saved.GetCompletedAt() == nil
+	})).Return(nil)
 
-	resp, err := uc.GetTodoUseCase(todo.GetID())
-	assert.NotNil(t, resp)
+	err := uc.UncompleteTodoUseCase(context.Background(), todo.GetID())
 	assert.Nil(t, err)
-	assert.Equal(t, string(todo.GetID()), resp.ID)
 	repo.AssertExpectations(t)
 }
 
-func TestGetTodoUseCase_NotFound(t *testing.T) {
+func TestUncompleteTodoUseCase_NotCompleted(t *testing.T) {
 	repo := new(MockTodoRepository)
-	domainService := service.NewTodoDomainService()
-	uc := NewTodoUseCase(repo, domainService)
-	id := model.TodoID("notfound")
-	repo.On("FindByID", id).Return(nil, errors.New("not found"))
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Pending", "Desc", model.TodoPriorityMedium)
 
-	resp, err := uc.GetTodoUseCase(id)
-	assert.Nil(t, resp)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+
+	err := uc.UncompleteTodoUseCase(context.Background(), todo.GetID())
 	assert.NotNil(t, err)
-	assert.Equal(t, "Todo not found", err.GetErrorMessage())
+	assert.Equal(t, "Cannot uncomplete todo", err.GetErrorMessage())
+	repo.AssertNotCalled(t, "Update", mock.Anything)
 	repo.AssertExpectations(t)
 }
 
-func TestListTodosUseCase_Success(t *testing.T) {
+func TestUnarchiveTodoUseCase_Success(t *testing.T) {
 	repo := new(MockTodoRepository)
-	domainService := service.NewTodoDomainService()
-	uc := NewTodoUseCase(repo, domainService)
-	todos := []*model.Todo{
-		model.NewTodo("Todo 1", "Desc 1", model.TodoPriorityHigh),
-		model.NewTodo("Todo 2", "Desc 2", model.TodoPriorityMedium),
-	}
-	repo.On("FindAll").Return(todos, nil)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Shelved", "Desc", model.TodoPriorityMedium)
+	assert.NoError(t, todo.ArchiveTodo())
 
-	resp, err := uc.ListTodosUseCase()
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", mock.MatchedBy(func(saved *model.Todo) bool { return saved.IsPending() })).Return(nil)
+
+	err := uc.UnarchiveTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestUnarchiveTodoUseCase_NotArchived(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Pending", "Desc", model.TodoPriorityMedium)
+
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+
+	err := uc.UnarchiveTodoUseCase(context.Background(), todo.GetID())
+	assert.Equal(t, 3003, err.GetErrorCode())
+	assert.Equal(t, model.ErrCannotUnarchiveTodo.GetErrorMessage(), err.GetErrorMessage())
+	repo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestReopenTodoUseCase_Success(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Done", "Desc", model.TodoPriorityMedium)
+	assert.NoError(t, todo.MarkAsCompleted())
+
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", mock.MatchedBy(func(saved *model.Todo) bool {
+		return saved.IsPending() && saved.GetCompletedAt() == nil
+	})).Return(nil)
+
+	err := uc.ReopenTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestReopenTodoUseCase_Archived(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Shelved", "Desc", model.TodoPriorityMedium)
+	assert.NoError(t, todo.ArchiveTodo())
+
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+
+	err := uc.ReopenTodoUseCase(context.Background(), todo.GetID())
+	assert.Equal(t, model.ErrCannotReopenTodo.GetErrorCode(), err.GetErrorCode())
+	repo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestReopenTodoUseCase_NotFound(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("FindByID", model.TodoID("missing")).Return(nil, errors.New("not found"))
+
+	err := uc.ReopenTodoUseCase(context.Background(), "missing")
+	assert.Equal(t, model.ErrTodoNotFound.GetErrorCode(), err.GetErrorCode())
+}
+
+func TestArchiveTodoUseCase_Success(t *testing.T) {
+	repo := new(MockTodoRepository)
+	domainService := service.NewTodoDomainService()
+	uc := NewTodoUseCase(repo, domainService)
+	todo := model.NewTodo("Test", "Desc", model.TodoPriorityMedium)
+
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.ArchiveTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestArchiveTodoUseCase_PublishesArchived(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	todo := model.NewTodo("Test", "Desc", model.TodoPriorityMedium)
+
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(errors.New("db error"))
+
+	assert.Equal(t, model.ErrFailedToSaveArchivedTodo, uc.ArchiveTodoUseCase(context.Background(), todo.GetID()))
+	assert.Empty(t, publisher.events)
+
+	todo = model.NewTodo("Test", "Desc", model.TodoPriorityMedium)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+	assert.Nil(t, uc.ArchiveTodoUseCase(context.Background(), todo.GetID()))
+	assert.Len(t, publisher.events, 1)
+	archived, ok := publisher.events[0].(*event.TodoArchivedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, todo.GetID(), archived.TodoID)
+}
+
+func TestArchiveTodoUseCase_NotFound(t *testing.T) {
+	repo := new(MockTodoRepository)
+	domainService := service.NewTodoDomainService()
+	uc := NewTodoUseCase(repo, domainService)
+	id := model.TodoID("notfound")
+
+	repo.On("FindByID", id).Return(nil, errors.New("not found"))
+
+	err := uc.ArchiveTodoUseCase(context.Background(), id)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Todo not found", err.GetErrorMessage())
+	repo.AssertExpectations(t)
+}
+
+func TestGetTodoUseCase_Success(t *testing.T) {
+	repo := new(MockTodoRepository)
+	domainService := service.NewTodoDomainService()
+	uc := NewTodoUseCase(repo, domainService)
+	todo := model.NewTodo("Test", "Desc", model.TodoPriorityMedium)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+
+	resp, err := uc.GetTodoUseCase(context.Background(), todo.GetID())
+	assert.NotNil(t, resp)
+	assert.Nil(t, err)
+	assert.Equal(t, string(todo.GetID()), resp.ID)
+	repo.AssertExpectations(t)
+}
+
+func TestGetTodoUseCase_NotFound(t *testing.T) {
+	repo := new(MockTodoRepository)
+	domainService := service.NewTodoDomainService()
+	uc := NewTodoUseCase(repo, domainService)
+	id := model.TodoID("notfound")
+	repo.On("FindByID", id).Return(nil, errors.New("not found"))
+
+	resp, err := uc.GetTodoUseCase(context.Background(), id)
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Todo not found", err.GetErrorMessage())
+	repo.AssertExpectations(t)
+}
+
+func TestListTodosUseCase_Success(t *testing.T) {
+	repo := new(MockTodoRepository)
+	domainService := service.NewTodoDomainService()
+	uc := NewTodoUseCase(repo, domainService)
+	todos := []*model.Todo{
+		model.NewTodo("Todo 1", "Desc 1", model.TodoPriorityHigh),
+		model.NewTodo("Todo 2", "Desc 2", model.TodoPriorityMedium),
+	}
+	repo.On("FindPaginated", 0, 0).Return(todos, 2, nil)
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
 	assert.NotNil(t, resp)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, resp.Count)
@@ -237,22 +880,1480 @@ func TestListTodosUseCase_RepoError(t *testing.T) {
 	repo := new(MockTodoRepository)
 	domainService := service.NewTodoDomainService()
 	uc := NewTodoUseCase(repo, domainService)
-	repo.On("FindAll").Return(nil, errors.New("db error"))
+	repo.On("FindPaginated", 0, 0).Return(nil, 0, errors.New("db error"))
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Failed to retrieve todos", err.GetErrorMessage())
+	repo.AssertExpectations(t)
+}
+
+func TestDeleteTodosUseCase_ReportsMissing(t *testing.T) {
+	repo := new(MockTodoRepository)
+	domainService := service.NewTodoDomainService()
+	uc := NewTodoUseCase(repo, domainService)
+	ids := []model.TodoID{"present-1", "absent", "present-2"}
+
+	repo.On("DeleteByIDs", ids).Return([]model.TodoID{"absent"}, nil)
+
+	failed, err := uc.DeleteTodosUseCase(context.Background(), ids)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.TodoID{"absent"}, failed)
+	repo.AssertExpectations(t)
+}
+
+func TestDeleteTodosUseCase_RepoError(t *testing.T) {
+	repo := new(MockTodoRepository)
+	domainService := service.NewTodoDomainService()
+	uc := NewTodoUseCase(repo, domainService)
+	ids := []model.TodoID{"present-1"}
+
+	repo.On("DeleteByIDs", ids).Return(nil, errors.New("db error"))
+
+	failed, err := uc.DeleteTodosUseCase(context.Background(), ids)
+	assert.Nil(t, failed)
+	assert.NotNil(t, err)
+	assert.Equal(t, "Failed to delete todo", err.GetErrorMessage())
+	repo.AssertExpectations(t)
+}
+
+func TestGetDashboardUseCase_Figures(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	owner := model.UserID("user-1")
+	now := time.Now()
+	weekAgo := now.Add(-8 * 24 * time.Hour)
+	recent := now.Add(-24 * time.Hour)
+
+	todos := []*model.Todo{
+		model.NewTodoFromData("p1", "Pending 1", "", model.TodoStatusPending, model.TodoPriorityLow, weekAgo, weekAgo, nil, owner, "", nil, nil, ""),
+		model.NewTodoFromData("p2", "Pending 2", "", model.TodoStatusPending, model.TodoPriorityHigh, weekAgo, weekAgo, nil, owner, "", nil, nil, ""),
+		model.NewTodoFromData("c1", "Done recently", "", model.TodoStatusCompleted, model.TodoPriorityLow, weekAgo, recent, &recent, owner, "", nil, nil, ""),
+		model.NewTodoFromData("c2", "Done long ago", "", model.TodoStatusCompleted, model.TodoPriorityLow, weekAgo, weekAgo, &weekAgo, owner, "", nil, nil, ""),
+		model.NewTodoFromData("a1", "Archived", "", model.TodoStatusArchived, model.TodoPriorityLow, weekAgo, weekAgo, nil, owner, "", nil, nil, ""),
+	}
+	repo.On("FindByCreatedBy", owner).Return(todos, nil)
 
-	resp, err := uc.ListTodosUseCase()
+	resp, err := uc.GetDashboardUseCase(context.Background(), owner)
+	assert.Nil(t, err)
+	assert.Equal(t, "user-1", resp.UserID)
+	assert.Equal(t, 5, resp.Total)
+	assert.Equal(t, 2, resp.Pending)
+	assert.Equal(t, 2, resp.Completed)
+	assert.Equal(t, 1, resp.Archived)
+	assert.Equal(t, 1, resp.CompletedThisWeek)
+	assert.InDelta(t, 1.0/3.0, resp.CompletionRateThisWeek, 0.0001)
+	repo.AssertExpectations(t)
+}
+
+func TestDashboardResponseMapper_DueDates(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 15, 0, 0, 0, time.UTC)
+	at := func(hour int) *time.Time {
+		due := time.Date(2024, time.March, 10, hour, 0, 0, 0, time.UTC)
+		return &due
+	}
+	yesterday, tomorrow := now.Add(-24*time.Hour), now.Add(24*time.Hour)
+	pending := func(id model.TodoID, due *time.Time) *model.Todo {
+		return model.NewTodoFromData(id, string(id), "", model.TodoStatusPending, model.TodoPriorityLow, now, now, nil, "user-1", "", due, nil, "")
+	}
+	todos := []*model.Todo{
+		pending("overdue-yesterday", &yesterday),
+		pending("overdue-this-morning", at(9)),
+		pending("due-tonight", at(23)),
+		pending("due-tomorrow", &tomorrow),
+		pending("no-due-date", nil),
+		// Completed todos are neither overdue nor due
+		model.NewTodoFromData("done", "Done", "", model.TodoStatusCompleted, model.TodoPriorityLow, now, now, &now, "user-1", "", at(20), nil, ""),
+	}
+
+	response := appmodel.DashboardResponseMapper("user-1", todos, now)
+	assert.Equal(t, 2, response.Overdue)
+	assert.Equal(t, 1, response.DueToday)
+}
+
+func TestGetDashboardUseCase_RepoError(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("FindByCreatedBy", model.UserID("user-1")).Return(nil, errors.New("db error"))
+
+	resp, err := uc.GetDashboardUseCase(context.Background(), "user-1")
 	assert.Nil(t, resp)
 	assert.NotNil(t, err)
 	assert.Equal(t, "Failed to retrieve todos", err.GetErrorMessage())
 	repo.AssertExpectations(t)
 }
 
+func TestListTodosByPriorityUseCase_Buckets(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	now := time.Now()
+	todos := []*model.Todo{
+		model.NewTodoFromData("h1", "Urgent 1", "", model.TodoStatusPending, model.TodoPriorityHigh, now, now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("h2", "Urgent 2", "", model.TodoStatusPending, model.TodoPriorityHigh, now, now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("m1", "Soon", "", model.TodoStatusPending, model.TodoPriorityMedium, now, now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("l1", "Someday", "", model.TodoStatusPending, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, ""),
+	}
+	repo.On("FindOrderedByPriority", false).Return(todos, nil).Once()
+
+	resp, err := uc.ListTodosByPriorityUseCase(context.Background(), false)
+	assert.Nil(t, err)
+	assert.Len(t, resp.High, 2)
+	assert.Len(t, resp.Medium, 1)
+	assert.Len(t, resp.Low, 1)
+	assert.Equal(t, "h1", resp.High[0].ID)
+	assert.Equal(t, "h2", resp.High[1].ID)
+	assert.Equal(t, "m1", resp.Medium[0].ID)
+	assert.Equal(t, "l1", resp.Low[0].ID)
+	repo.AssertExpectations(t)
+}
+
+func TestListTodosByPriorityUseCase_RepoError(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("FindOrderedByPriority", true).Return(nil, errors.New("db error"))
+
+	resp, err := uc.ListTodosByPriorityUseCase(context.Background(), true)
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrFailedToRetrieveTodos.GetErrorCode(), err.GetErrorCode())
+}
+
+// stubSearchIndex answers every query with fixed IDs
+type stubSearchIndex struct {
+	ids   []model.TodoID
+	terms []string
+}
+
+func (s *stubSearchIndex) Name() string                                   { return "stub" }
+func (s *stubSearchIndex) Reset(ctx context.Context) error                { return nil }
+func (s *stubSearchIndex) Apply(ctx context.Context, e interface{}) error { return nil }
+func (s *stubSearchIndex) Ready() bool                                    { return true }
+func (s *stubSearchIndex) Query(ctx context.Context, terms []string) ([]model.TodoID, error) {
+	s.terms = terms
+	return s.ids, nil
+}
+
+func TestSearchTodosUseCase_ConsultsIndex(t *testing.T) {
+	repo := new(MockTodoRepository)
+	index := &stubSearchIndex{ids: []model.TodoID{"a", "gone", "archived"}}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithSearchProjection(index))
+	now := time.Now()
+	// Every hit is loaded in one query, which skips the deleted one
+	repo.On("FindByIDs", []model.TodoID{"a", "gone", "archived"}).Return([]*model.Todo{
+		model.NewTodoFromData("archived", "Milk", "", model.TodoStatusArchived, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("a", "Buy milk", "", model.TodoStatusPending, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, ""),
+	}, nil).Once()
+
+	resp, err := uc.SearchTodosUseCase(context.Background(), "  Milk!  ")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"milk"}, index.terms)
+	assert.Equal(t, 1, resp.Count)
+	assert.Equal(t, "a", resp.Todos[0].ID)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "FindAll")
+	repo.AssertNotCalled(t, "FindByID", mock.Anything)
+}
+
+func TestSearchTodosUseCase_IndexedHitsLoadFailure(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithSearchProjection(&stubSearchIndex{ids: []model.TodoID{"a"}}))
+	repo.On("FindByIDs", []model.TodoID{"a"}).Return(nil, errors.New("db error"))
+
+	resp, err := uc.SearchTodosUseCase(context.Background(), "milk")
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrFailedToRetrieveTodos.GetErrorCode(), err.GetErrorCode())
+}
+
+func TestSearchTodosUseCase_ScansWithoutIndex(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	now := time.Now()
+	repo.On("FindAll").Return([]*model.Todo{
+		model.NewTodoFromData("a", "Buy milk", "", model.TodoStatusPending, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("b", "Buy bread", "milk", model.TodoStatusPending, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("c", "Milk, buy", "", model.TodoStatusArchived, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, ""),
+	}, nil)
+
+	resp, err := uc.SearchTodosUseCase(context.Background(), "milk buy")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, resp.Count)
+	assert.Equal(t, "a", resp.Todos[0].ID)
+}
+
+func TestSearchTodosUseCase_IndexCoversStoredUnarchivedAndRestoredTodos(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewInMemoryTodoRepository()
+	stored := model.NewSimpleTodo("Yearly report")
+	assert.NoError(t, repo.Save(ctx, stored))
+	index := projection.NewTodoSearchIndex()
+	publisher := messaging.NewInMemoryEventPublisher()
+	publisher.Subscribe(index.Apply)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithSearchProjection(index), WithEventPublisher(publisher))
+	search := func(text string) []string {
+		resp, err := uc.SearchTodosUseCase(ctx, text)
+		assert.Nil(t, err)
+		ids := []string{}
+		for _, todo := range resp.Todos {
+			ids = append(ids, todo.ID)
+		}
+		return ids
+	}
+
+	// Until the index is loaded searches scan the repository
+	assert.Equal(t, []string{string(stored.GetID())}, search("yearly"))
+	assert.NoError(t, index.Load(ctx, repo))
+	assert.Equal(t, []string{string(stored.GetID())}, search("yearly"))
+
+	id, err := uc.CreateTodoUseCase(ctx, command.CreateTodoCommand{Title: "Quarterly report", Priority: "low"})
+	assert.Nil(t, err)
+	assert.Len(t, search("quarterly report"), 1)
+	assert.Nil(t, uc.ArchiveTodoUseCase(ctx, id))
+	assert.Empty(t, search("quarterly report"))
+	assert.Nil(t, uc.UnarchiveTodoUseCase(ctx, id))
+	assert.Equal(t, []string{string(id)}, search("quarterly report"))
+
+	data := []byte(`{"version":1,"todos":[{"id":"restored","title":"Monthly report","status":"pending","priority":"low"}]}`)
+	assert.Nil(t, uc.RestoreSnapshotUseCase(ctx, data, false))
+	assert.Equal(t, []string{"restored"}, search("monthly"))
+}
+
+func TestSearchIndex_DropsDeletedTodos(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewInMemoryTodoRepository()
+	index := projection.NewTodoSearchIndex()
+	assert.NoError(t, index.Load(ctx, repo))
+	publisher := messaging.NewInMemoryEventPublisher()
+	publisher.Subscribe(index.Apply)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithSearchProjection(index), WithEventPublisher(publisher))
+	indexed := func() []model.TodoID {
+		ids, err := index.Query(ctx, []string{"report"})
+		assert.NoError(t, err)
+		return ids
+	}
+	create := func(title string) model.TodoID {
+		id, err := uc.CreateTodoUseCase(ctx, command.CreateTodoCommand{Title: title, Priority: "low"})
+		assert.Nil(t, err)
+		return id
+	}
+
+	single := create("Yearly report")
+	assert.Nil(t, uc.DeleteTodoUseCase(ctx, single))
+	assert.Empty(t, indexed())
+
+	first, second := create("Weekly report"), create("Daily report")
+	failed, err := uc.DeleteTodosUseCase(ctx, []model.TodoID{first, second})
+	assert.Nil(t, err)
+	assert.Empty(t, failed)
+	assert.Empty(t, indexed())
+
+	create("Monthly report")
+	data := []byte(`{"version":1,"todos":[{"id":"restored","title":"Restored report","status":"pending","priority":"low"}]}`)
+	assert.Nil(t, uc.RestoreSnapshotUseCase(ctx, data, true))
+	assert.Equal(t, []model.TodoID{"restored"}, indexed())
+}
+
+func TestDeleteTodosUseCase_PublishesDeletedOncePerRemovedTodo(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	ids := []model.TodoID{"a", "absent", "a", "b"}
+	repo.On("DeleteByIDs", ids).Return([]model.TodoID{"absent"}, nil)
+
+	_, err := uc.DeleteTodosUseCase(context.Background(), ids)
+	assert.Nil(t, err)
+	var deleted []model.TodoID
+	for _, e := range publisher.events {
+		if e, ok := e.(*event.TodoDeletedEvent); ok {
+			deleted = append(deleted, e.TodoID)
+		}
+	}
+	assert.Equal(t, []model.TodoID{"a", "b"}, deleted)
+}
+
+func TestSearchTodosUseCase_EmptyQuery(t *testing.T) {
+	uc := NewTodoUseCase(new(MockTodoRepository), service.NewTodoDomainService())
+
+	resp, err := uc.SearchTodosUseCase(context.Background(), " ?! ")
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrInvalidQueryParam.GetErrorCode(), err.GetErrorCode())
+}
+
+func TestGetTodoUseCase_RepairsCompletedWithoutTimestamp(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.RepairInconsistentState = true
+	var logs bytes.Buffer
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(),
+		WithConfig(cfg),
+		WithLogger(slog.New(slog.NewJSONHandler(&logs, nil))),
+	)
+	updatedAt := time.Now().Add(-time.Hour)
+	broken := model.NewTodoFromData("broken", "Broken", "", model.TodoStatusCompleted, model.TodoPriorityLow, updatedAt, updatedAt, nil, "", "", nil, nil, "")
+	repo.On("FindByID", model.TodoID("broken")).Return(broken, nil)
+
+	resp, err := uc.GetTodoUseCase(context.Background(), "broken")
+	assert.Nil(t, err)
+	if assert.NotNil(t, resp.CompletedAt) {
+		assert.True(t, updatedAt.Equal(*resp.CompletedAt))
+	}
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Equal(t, "WARN", entry["level"])
+	assert.Equal(t, "broken", entry["todo_id"])
+}
+
+func TestListTodosUseCase_RepairDisabledLeavesTimestampMissing(t *testing.T) {
+	repo := new(MockTodoRepository)
+	var logs bytes.Buffer
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(),
+		WithLogger(slog.New(slog.NewJSONHandler(&logs, nil))),
+	)
+	now := time.Now()
+	broken := model.NewTodoFromData("broken", "Broken", "", model.TodoStatusCompleted, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, "")
+	repo.On("FindPaginated", 0, 0).Return([]*model.Todo{broken}, 1, nil)
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.Todos[0].CompletedAt)
+	assert.Empty(t, logs.String())
+}
+
+func TestListTodosUseCase_IncludeDeletedAppendsTombstones(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	live := model.NewTodo("Live", "", model.TodoPriorityLow)
+	repo.On("FindPaginated", 0, 0).Return([]*model.Todo{live}, 1, nil)
+	repo.On("FindDeletedIDs").Return([]model.TodoID{"gone"}, nil)
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{IncludeDeleted: true})
+	assert.Nil(t, err)
+	assert.Len(t, resp.Todos, 2)
+	assert.Equal(t, 2, resp.Count)
+	assert.Equal(t, 1, resp.Total)
+	assert.False(t, resp.Todos[0].Deleted)
+	assert.Equal(t, "gone", resp.Todos[1].ID)
+	assert.True(t, resp.Todos[1].Deleted)
+	repo.AssertExpectations(t)
+}
+
+func TestListTodosUseCase_ExcludesDeletedByDefault(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	live := model.NewTodo("Live", "", model.TodoPriorityLow)
+	repo.On("FindPaginated", 0, 0).Return([]*model.Todo{live}, 1, nil)
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
+	assert.Nil(t, err)
+	assert.Len(t, resp.Todos, 1)
+	repo.AssertNotCalled(t, "FindDeletedIDs")
+}
+
+func TestCreateTodoUseCase_AssignsCreator(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	cmd := command.CreateTodoCommand{Title: "Owned", Priority: "low", CreatedBy: "user-1"}
+
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetCreatedBy() == "user-1"
+	})).Return(nil)
+
+	_, err := uc.CreateTodoUseCase(context.Background(), cmd)
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
 func TestTestErrorUseCase(t *testing.T) {
 	repo := new(MockTodoRepository)
 	domainService := service.NewTodoDomainService()
 	uc := NewTodoUseCase(repo, domainService)
 
-	err := uc.TestErrorUseCase()
+	err := uc.TestErrorUseCase(context.Background())
 	assert.NotNil(t, err)
 	assert.Equal(t, "Test error message", err.GetErrorMessage())
 	assert.Equal(t, 400, err.GetHttpStatus())
 }
+
+func TestReadinessUseCase(t *testing.T) {
+	repo := new(MockTodoRepository)
+	var logs bytes.Buffer
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(),
+		WithLogger(slog.New(slog.NewJSONHandler(&logs, nil))))
+
+	repo.On("Ping").Return(nil).Once()
+	assert.Nil(t, uc.ReadinessUseCase(context.Background()))
+
+	repo.On("Ping").Return(errors.New("dial tcp db.internal:5432: user=todo_user connection refused")).Once()
+	err := uc.ReadinessUseCase(context.Background())
+	assert.Equal(t, model.ErrDatabaseUnavailable.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, 503, err.GetHttpStatus())
+	// The connection error is logged but never sent to the unauthenticated caller
+	assert.Empty(t, err.GetDetails())
+	assert.Contains(t, logs.String(), "db.internal:5432")
+}
+
+func TestCompletionTimeStatsUseCase_OrdersByPriority(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("CompletionTimeStats").Return([]model.CompletionTimeStat{
+		{Priority: model.TodoPriorityLow, Completed: 1, AverageDuration: 3 * time.Hour},
+		{Priority: model.TodoPriorityHigh, Completed: 2, AverageDuration: 90 * time.Minute},
+	}, nil)
+
+	resp, err := uc.CompletionTimeStatsUseCase(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, resp.Priorities, 2)
+	assert.Equal(t, "high", resp.Priorities[0].Priority)
+	assert.Equal(t, 2, resp.Priorities[0].Completed)
+	assert.Equal(t, 5400.0, resp.Priorities[0].AverageSeconds)
+	assert.Equal(t, "low", resp.Priorities[1].Priority)
+	repo.AssertExpectations(t)
+}
+
+func TestCompletionTimeStatsUseCase_RepoError(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("CompletionTimeStats").Return(nil, errors.New("db error"))
+
+	resp, err := uc.CompletionTimeStatsUseCase(context.Background())
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrFailedToRetrieveTodos, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateTodoUseCase_EmptyTitleRejectedByDefault(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+	id, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "", Priority: "low"})
+	assert.Empty(t, id)
+	assert.Equal(t, model.ErrEmptyTitle, err)
+	repo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCreateTodoUseCase_EmptyTitleAllowed(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(service.WithAllowEmptyTitle(true)))
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetTitle() == model.UntitledTitle
+	})).Return(nil)
+
+	id, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "   ", Priority: "low"})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, id)
+	repo.AssertExpectations(t)
+}
+
+func TestGetRandomTodoUseCase_Success(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Pick me", "", model.TodoPriorityHigh)
+	repo.On("FindRandom").Return(todo, nil)
+
+	resp, err := uc.GetRandomTodoUseCase(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, string(todo.GetID()), resp.ID)
+	repo.AssertExpectations(t)
+}
+
+func TestGetRandomTodoUseCase_NonePending(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("FindRandom").Return(nil, fmt.Errorf("no pending todos found: %w", model.ErrTodoNotFound))
+
+	resp, err := uc.GetRandomTodoUseCase(context.Background())
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrTodoNotFound, err)
+	repo.AssertExpectations(t)
+}
+
+func TestGetRandomTodoUseCase_RepositoryFailure(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("FindRandom").Return(nil, errors.New("connection refused"))
+
+	resp, err := uc.GetRandomTodoUseCase(context.Background())
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrFailedToRetrieveTodos, err)
+	repo.AssertExpectations(t)
+}
+
+func TestListTodosUseCase_ServesStaleOnError(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.StaleOnError = true
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	todo := model.NewTodo("Cached", "", model.TodoPriorityLow)
+	repo.On("FindPaginated", 0, 0).Return([]*model.Todo{todo}, 1, nil).Once()
+	repo.On("FindPaginated", 0, 0).Return(nil, 0, errors.New("db down")).Once()
+
+	fresh, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
+	assert.Nil(t, err)
+	assert.False(t, fresh.Stale)
+
+	stale, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
+	assert.Nil(t, err)
+	assert.True(t, stale.Stale)
+	assert.Equal(t, 1, stale.Count)
+	assert.Equal(t, "Cached", stale.Todos[0].Title)
+	repo.AssertExpectations(t)
+}
+
+func TestGetTodoUseCase_ServesStaleOnError(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.StaleOnError = true
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	todo := model.NewTodo("Cached", "", model.TodoPriorityLow)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil).Once()
+	repo.On("FindByID", todo.GetID()).Return(nil, errors.New("db down")).Once()
+
+	_, err := uc.GetTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+
+	stale, err := uc.GetTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+	assert.True(t, stale.Stale)
+	assert.Equal(t, "Cached", stale.Title)
+	repo.AssertExpectations(t)
+}
+
+func TestGetTodoUseCase_NotFoundIsNeverServedStale(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.StaleOnError = true
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	todo := model.NewTodo("Cached", "", model.TodoPriorityLow)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil).Once()
+	repo.On("FindByID", todo.GetID()).Return(nil, fmt.Errorf("todo with id %s not found: %w", todo.GetID(), model.ErrTodoNotFound)).Once()
+	repo.On("FindByID", todo.GetID()).Return(nil, errors.New("db down")).Once()
+
+	_, err := uc.GetTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+
+	// Deleted elsewhere: reported as missing and forgotten
+	resp, err := uc.GetTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrTodoNotFound, err)
+
+	resp, err = uc.GetTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrTodoNotFound, err)
+	repo.AssertExpectations(t)
+}
+
+func TestListTodosUseCase_StaleListOmitsDeletedTodos(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.StaleOnError = true
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	kept, deleted := model.NewTodo("Kept", "", model.TodoPriorityLow), model.NewTodo("Deleted", "", model.TodoPriorityLow)
+	repo.On("FindPaginated", 0, 0).Return([]*model.Todo{kept, deleted}, 2, nil).Once()
+	repo.On("FindPaginated", 0, 0).Return(nil, 0, errors.New("db down")).Once()
+	repo.On("FindByID", deleted.GetID()).Return(deleted, nil)
+	repo.On("Delete", deleted.GetID()).Return(nil)
+
+	_, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
+	assert.Nil(t, err)
+	assert.Nil(t, uc.DeleteTodoUseCase(context.Background(), deleted.GetID()))
+
+	stale, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
+	assert.Nil(t, err)
+	assert.True(t, stale.Stale)
+	assert.Equal(t, 1, stale.Count)
+	assert.Equal(t, 1, stale.Total)
+	assert.Equal(t, "Kept", stale.Todos[0].Title)
+}
+
+func TestGetTodoUseCase_StaleOnErrorDisabledCachesNothing(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Fresh", "", model.TodoPriorityLow)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("FindPaginated", 0, 0).Return([]*model.Todo{todo}, 1, nil)
+
+	_, err := uc.GetTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+	_, err = uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
+	assert.Nil(t, err)
+
+	_, ok := uc.staleCache.todo(todo.GetID())
+	assert.False(t, ok)
+	_, ok = uc.staleCache.list()
+	assert.False(t, ok)
+}
+
+func TestListTodosUseCase_StaleOnErrorDisabled(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("FindPaginated", 0, 0).Return([]*model.Todo{model.NewTodo("Cached", "", model.TodoPriorityLow)}, 1, nil).Once()
+	repo.On("FindPaginated", 0, 0).Return(nil, 0, errors.New("db down")).Once()
+
+	_, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
+	assert.Nil(t, err)
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrFailedToRetrieveTodos, err)
+	repo.AssertExpectations(t)
+}
+
+// withMaxDescriptionLength returns a domain service allowing descriptions of at most length bytes
+func withMaxDescriptionLength(length int) *service.TodoDomainService {
+	return service.NewTodoDomainService(service.WithTodoLimits(model.TodoLimits{MaxTitleLength: model.DefaultMaxTitleLength, MaxDescriptionLength: length}))
+}
+
+func TestCreateTodoUseCase_ConfiguredDescriptionLimit(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, withMaxDescriptionLength(10))
+	repo.On("Create", mock.AnythingOfType("*model.Todo")).Return(nil)
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "At limit", Description: strings.Repeat("a", 10), Priority: "low"})
+	assert.Nil(t, err)
+
+	_, err = uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Over limit", Description: strings.Repeat("a", 11), Priority: "low"})
+	assert.NotNil(t, err)
+	assert.Equal(t, model.ErrInvalidDescription.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, "10", err.GetDetails()["max_length"])
+	repo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestUpdateTodoUseCase_ConfiguredDescriptionLimit(t *testing.T) {
+	domainService := withMaxDescriptionLength(10)
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, domainService)
+	todo := model.NewTodo("Title", "", model.TodoPriorityLow)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), Description: strings.Repeat("a", 10)})
+	assert.Nil(t, err)
+
+	err = uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), Description: strings.Repeat("a", 11)})
+	assert.NotNil(t, err)
+	assert.Equal(t, model.ErrInvalidDescription.GetErrorCode(), err.GetErrorCode())
+
+	// The aggregate enforces the same limit as the domain service
+	_, updateErr := todo.UpdateDescription(strings.Repeat("a", 11), domainService.Limits())
+	assert.Error(t, updateErr)
+	_, updateErr = todo.UpdateDescription(strings.Repeat("b", 10), domainService.Limits())
+	assert.NoError(t, updateErr)
+}
+
+func TestUpdateTodoUseCase_RaisedTitleLimit(t *testing.T) {
+	limits := model.TodoLimits{MaxTitleLength: 300, MaxDescriptionLength: model.DefaultMaxDescriptionLength}
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(service.WithTodoLimits(limits)))
+	todo := model.NewTodo("Title", "", model.TodoPriorityLow)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	// Longer than the aggregate's old fixed limit of 200
+	title := strings.Repeat("a", 250)
+	err := uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), Title: title})
+	assert.Nil(t, err)
+	assert.Equal(t, title, todo.GetTitle())
+	assert.NoError(t, todo.Validate(limits))
+
+	err = uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), Title: strings.Repeat("b", 301)})
+	assert.Equal(t, model.ErrTitleTooLong.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, "300", err.GetDetails()["max_length"])
+	_, updateErr := todo.UpdateTitle(strings.Repeat("b", 301), limits)
+	assert.EqualError(t, updateErr, "title cannot exceed 300 characters")
+}
+
+func TestUncompleteBatchUseCase_ReopensOnlyCompleted(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	completed := model.NewTodo("Completed", "", model.TodoPriorityLow)
+	assert.NoError(t, completed.MarkAsCompleted())
+	pending := model.NewTodo("Pending", "", model.TodoPriorityLow)
+	repo.On("FindByID", completed.GetID()).Return(completed, nil)
+	repo.On("FindByID", pending.GetID()).Return(pending, nil)
+	repo.On("FindByID", model.TodoID("missing")).Return(nil, errors.New("not found"))
+	repo.On("Update", completed).Return(nil)
+
+	failed, err := uc.UncompleteBatchUseCase(context.Background(), []model.TodoID{completed.GetID(), pending.GetID(), "missing"})
+	assert.Nil(t, err)
+	assert.Equal(t, []model.TodoID{pending.GetID(), "missing"}, failed)
+	assert.Equal(t, model.TodoStatusPending, completed.GetStatus())
+	repo.AssertExpectations(t)
+	repo.AssertNumberOfCalls(t, "Update", 1)
+}
+
+func TestBulkCompleteTodosUseCase_ReportsPerTodoOutcome(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	pending := model.NewTodo("Pending", "", model.TodoPriorityLow)
+	completed := model.NewTodo("Completed", "", model.TodoPriorityLow)
+	assert.NoError(t, completed.MarkAsCompleted())
+	repo.On("FindByID", pending.GetID()).Return(pending, nil)
+	repo.On("FindByID", completed.GetID()).Return(completed, nil)
+	repo.On("FindByID", model.TodoID("missing")).Return(nil, errors.New("not found"))
+	repo.On("UpdateAll", []*model.Todo{pending}).Return(nil)
+
+	result, err := uc.BulkCompleteTodosUseCase(context.Background(), []model.TodoID{pending.GetID(), completed.GetID(), "missing", pending.GetID()})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{string(pending.GetID())}, result.Succeeded)
+	assert.Equal(t, appmodel.BulkFailures{
+		string(completed.GetID()): model.ErrCannotCompleteTodo.GetErrorMessage(),
+		"missing":                 model.ErrTodoNotFound.GetErrorMessage(),
+	}, result.Failed)
+	assert.Equal(t, model.TodoStatusCompleted, pending.GetStatus())
+	repo.AssertExpectations(t)
+	repo.AssertNumberOfCalls(t, "FindByID", 3)
+}
+
+func TestBulkCompleteTodosUseCase_FailedWriteFailsEveryTodo(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	first := model.NewTodo("First", "", model.TodoPriorityLow)
+	second := model.NewTodo("Second", "", model.TodoPriorityLow)
+	repo.On("FindByID", first.GetID()).Return(first, nil)
+	repo.On("FindByID", second.GetID()).Return(second, nil)
+	repo.On("UpdateAll", []*model.Todo{first, second}).Return(errors.New("database unavailable"))
+
+	result, err := uc.BulkCompleteTodosUseCase(context.Background(), []model.TodoID{first.GetID(), second.GetID()})
+	assert.Nil(t, err)
+	assert.Empty(t, result.Succeeded)
+	assert.Len(t, result.Failed, 2)
+	assert.Equal(t, model.ErrFailedToSaveCompletedTodo.GetErrorMessage(), result.Failed[string(first.GetID())])
+}
+
+// failingCommitTransactions runs work in a real transaction but then fails to commit it
+type failingCommitTransactions struct {
+	inner port.TransactionManager
+}
+
+func (m failingCommitTransactions) WithinTransaction(ctx context.Context, fn func(repo port.TodoRepositoryPort) error) error {
+	return m.inner.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		if err := fn(repo); err != nil {
+			return err
+		}
+		return errors.New("commit failed")
+	})
+}
+
+func TestBulkCompleteTodosUseCase_CommitsAllOrNone(t *testing.T) {
+	ctx := context.Background()
+	first, second := model.NewSimpleTodo("First"), model.NewSimpleTodo("Second")
+	repo := repository.NewInMemoryTodoRepository()
+	assert.NoError(t, repo.Save(ctx, first))
+	assert.NoError(t, repo.Save(ctx, second))
+	ids := []model.TodoID{first.GetID(), second.GetID()}
+
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithTransactionManager(failingCommitTransactions{inner: repo}))
+	result, err := uc.BulkCompleteTodosUseCase(ctx, ids)
+	assert.Nil(t, err)
+	assert.Empty(t, result.Succeeded)
+	assert.Len(t, result.Failed, 2)
+	for _, id := range ids {
+		stored, findErr := repo.FindByID(ctx, id)
+		assert.NoError(t, findErr)
+		assert.Equal(t, model.TodoStatusPending, stored.GetStatus())
+	}
+
+	uc = NewTodoUseCase(repo, service.NewTodoDomainService(), WithTransactionManager(repo))
+	result, err = uc.BulkCompleteTodosUseCase(ctx, ids)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{string(first.GetID()), string(second.GetID())}, result.Succeeded)
+	for _, id := range ids {
+		stored, findErr := repo.FindByID(ctx, id)
+		assert.NoError(t, findErr)
+		assert.Equal(t, model.TodoStatusCompleted, stored.GetStatus())
+	}
+}
+
+func TestCreateTodoUseCase_InfersMissingPriority(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.InferPriority = true
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetPriority() == model.TodoPriorityHigh
+	})).Return(nil).Once()
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetPriority() == model.TodoPriorityLow
+	})).Return(nil).Once()
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Renew passport asap"})
+	assert.Nil(t, err)
+
+	// An explicit priority wins over the keywords
+	_, err = uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Urgent-sounding but trivial", Priority: "low"})
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateTodoUseCase_PriorityInferenceDisabled(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Renew passport asap"})
+	assert.Equal(t, model.ErrInvalidPriority, err)
+	repo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestDeleteTodosUseCase_BulkSizeLimit(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.MaxBulkOperationSize = 2
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	atLimit := []model.TodoID{"a", "b"}
+	repo.On("DeleteByIDs", atLimit).Return(nil, nil)
+
+	failed, err := uc.DeleteTodosUseCase(context.Background(), atLimit)
+	assert.Nil(t, err)
+	assert.Empty(t, failed)
+
+	failed, err = uc.DeleteTodosUseCase(context.Background(), []model.TodoID{"a", "b", "c"})
+	assert.Nil(t, failed)
+	assert.Equal(t, model.ErrBulkTooLarge.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, "2", err.GetDetails()["max_size"])
+	repo.AssertExpectations(t)
+	repo.AssertNumberOfCalls(t, "DeleteByIDs", 1)
+}
+
+func TestArchiveTodoUseCase_CompletedTodoAllowedByDefault(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Done", "", model.TodoPriorityLow)
+	assert.NoError(t, todo.MarkAsCompleted())
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.ArchiveTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+	assert.Equal(t, model.TodoStatusArchived, todo.GetStatus())
+	repo.AssertExpectations(t)
+}
+
+func TestArchiveTodoUseCase_CompletedTodoDisallowed(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.AllowArchiveCompleted = false
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	todo := model.NewTodo("Done", "", model.TodoPriorityLow)
+	assert.NoError(t, todo.MarkAsCompleted())
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+
+	err := uc.ArchiveTodoUseCase(context.Background(), todo.GetID())
+	assert.Equal(t, model.ErrCannotArchiveTodo.GetErrorCode(), err.GetErrorCode())
+	assert.NotEmpty(t, err.GetDetails()["reason"])
+	assert.Equal(t, model.TodoStatusCompleted, todo.GetStatus())
+	repo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestCompleteTodoUseCase_AuditLogsTransition(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.AuditLog = true
+	var logs bytes.Buffer
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(),
+		WithConfig(cfg),
+		WithLogger(slog.New(slog.NewJSONHandler(&logs, nil))),
+	)
+	todo := model.NewTodo("Audit me", "", model.TodoPriorityLow)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.CompleteTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Equal(t, "INFO", entry["level"])
+	assert.Equal(t, string(todo.GetID()), entry["todo_id"])
+	assert.Equal(t, "pending", entry["from_status"])
+	assert.Equal(t, "completed", entry["to_status"])
+	assert.Equal(t, "anonymous", entry["actor"])
+}
+
+func TestCompleteTodoUseCase_AuditLogsAuthenticatedActor(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.AuditLog = true
+	var logs bytes.Buffer
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(),
+		WithConfig(cfg),
+		WithLogger(slog.New(slog.NewJSONHandler(&logs, nil))),
+	)
+	todo := model.NewTodo("Audit me", "", model.TodoPriorityLow)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.CompleteTodoUseCase(port.WithAuthenticatedUser(context.Background(), "alice"), todo.GetID())
+	assert.Nil(t, err)
+
+	var entry map[string]interface{}
+	assert.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Equal(t, "alice", entry["actor"])
+}
+
+func TestCompleteTodoUseCase_AuditLogDisabled(t *testing.T) {
+	repo := new(MockTodoRepository)
+	var logs bytes.Buffer
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(),
+		WithLogger(slog.New(slog.NewJSONHandler(&logs, nil))),
+	)
+	todo := model.NewTodo("Quiet", "", model.TodoPriorityLow)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.CompleteTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+	assert.Empty(t, logs.String())
+}
+
+func TestCreateTodoUseCase_DefaultDescription(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.DefaultDescription = "Add details"
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetDescription() == "Add details"
+	})).Return(nil).Once()
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetDescription() == "Explicit"
+	})).Return(nil).Once()
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Templated", Priority: "low"})
+	assert.Nil(t, err)
+	_, err = uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Explicit", Description: "Explicit", Priority: "low"})
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateTodoUseCase_NoDefaultDescription(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetDescription() == ""
+	})).Return(nil)
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Plain", Priority: "low"})
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateTodoUseCase_DefaultDescriptionIsValidated(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.DefaultDescription = "Far too long"
+	uc := NewTodoUseCase(repo, withMaxDescriptionLength(5), WithConfig(cfg))
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Templated", Priority: "low"})
+	assert.Equal(t, model.ErrInvalidDescription.GetErrorCode(), err.GetErrorCode())
+	repo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestValidateFieldUseCase(t *testing.T) {
+	uc := NewTodoUseCase(new(MockTodoRepository), service.NewTodoDomainService())
+
+	tests := []struct {
+		name    string
+		cmd     command.ValidateFieldCommand
+		valid   bool
+		errCode int
+	}{
+		{name: "valid title", cmd: command.ValidateFieldCommand{Field: "title", Value: "Buy milk"}, valid: true},
+		{name: "empty title", cmd: command.ValidateFieldCommand{Field: "title", Value: " "}, errCode: model.ErrEmptyTitle.GetErrorCode()},
+		{name: "long title", cmd: command.ValidateFieldCommand{Field: "title", Value: strings.Repeat("a", 101)}, errCode: model.ErrTitleTooLong.GetErrorCode()},
+		{name: "valid priority", cmd: command.ValidateFieldCommand{Field: "priority", Value: "high"}, valid: true},
+		{name: "invalid priority", cmd: command.ValidateFieldCommand{Field: "priority", Value: "urgent"}, errCode: model.ErrInvalidPriority.GetErrorCode()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := uc.ValidateFieldUseCase(context.Background(), tt.cmd)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.valid, resp.Valid)
+			if tt.valid {
+				assert.Nil(t, resp.Error)
+			} else {
+				assert.Equal(t, tt.errCode, resp.Error.ErrorCode)
+			}
+		})
+	}
+}
+
+func TestValidateFieldUseCase_UnknownField(t *testing.T) {
+	uc := NewTodoUseCase(new(MockTodoRepository), service.NewTodoDomainService())
+
+	resp, err := uc.ValidateFieldUseCase(context.Background(), command.ValidateFieldCommand{Field: "colour", Value: "red"})
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrUnknownField, err)
+}
+
+func TestSnapshotUseCase_RoundTrip(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	completedAt := created.Add(time.Hour)
+	originals := []*model.Todo{
+		model.NewTodoFromData("todo-1", "Done", "Finished task", model.TodoStatusCompleted, model.TodoPriorityHigh, created, completedAt, &completedAt, "alice", "cat-1", nil, nil, model.TodoSourceCLI),
+		model.NewTodoFromData("todo-2", "Open", "", model.TodoStatusPending, model.TodoPriorityLow, created, created, nil, "", "", nil, nil, ""),
+	}
+
+	source := new(MockTodoRepository)
+	source.On("FindAll").Return(originals, nil)
+	data, err := NewTodoUseCase(source, service.NewTodoDomainService()).SnapshotUseCase(context.Background())
+	assert.Nil(t, err)
+
+	var restored []*model.Todo
+	target := new(MockTodoRepository)
+	target.On("Save", mock.Anything).Run(func(args mock.Arguments) {
+		restored = append(restored, args.Get(0).(*model.Todo))
+	}).Return(nil)
+
+	err = NewTodoUseCase(target, service.NewTodoDomainService()).RestoreSnapshotUseCase(context.Background(), data, false)
+	assert.Nil(t, err)
+	assert.Len(t, restored, 2)
+	for i, todo := range restored {
+		assert.Equal(t, originals[i].GetID(), todo.GetID())
+		assert.Equal(t, originals[i].GetStatus(), todo.GetStatus())
+		assert.Equal(t, originals[i].GetPriority(), todo.GetPriority())
+		assert.Equal(t, originals[i].GetCreatedBy(), todo.GetCreatedBy())
+		assert.Equal(t, originals[i].GetCategoryID(), todo.GetCategoryID())
+		assert.True(t, originals[i].GetUpdatedAt().Equal(todo.GetUpdatedAt()))
+	}
+	assert.True(t, completedAt.Equal(*restored[0].GetCompletedAt()))
+	assert.Nil(t, restored[1].GetCompletedAt())
+	assert.Equal(t, model.TodoSourceCLI, restored[0].GetSource())
+	assert.Equal(t, model.TodoSourceImport, restored[1].GetSource(), "todos without a recorded source restore as imported")
+	target.AssertNotCalled(t, "FindAll")
+}
+
+func TestRestoreSnapshotUseCase_Replace(t *testing.T) {
+	repo := new(MockTodoRepository)
+	existing := model.NewTodo("Old", "", model.TodoPriorityLow)
+	repo.On("FindAll").Return([]*model.Todo{existing}, nil)
+	repo.On("DeleteByIDs", []model.TodoID{existing.GetID()}).Return(nil, nil)
+	repo.On("Save", mock.Anything).Return(nil)
+
+	data := []byte(`{"version":1,"todos":[{"id":"todo-1","title":"New","status":"pending","priority":"low"}]}`)
+	err := NewTodoUseCase(repo, service.NewTodoDomainService()).RestoreSnapshotUseCase(context.Background(), data, true)
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestRestoreSnapshotUseCase_FailedReplaceKeepsExistingTodos(t *testing.T) {
+	ctx := context.Background()
+	existing := model.NewSimpleTodo("Old")
+	repo := repository.NewInMemoryTodoRepository()
+	assert.NoError(t, repo.Save(ctx, existing))
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithTransactionManager(failingCommitTransactions{inner: repo}))
+
+	data := []byte(`{"version":1,"todos":[{"id":"todo-1","title":"New","status":"pending","priority":"low"}]}`)
+	err := uc.RestoreSnapshotUseCase(ctx, data, true)
+	assert.NotNil(t, err)
+	assert.Equal(t, model.ErrFailedToSaveTodo.GetErrorCode(), err.GetErrorCode())
+
+	todos, findErr := repo.FindAll(ctx)
+	assert.NoError(t, findErr)
+	if assert.Len(t, todos, 1) {
+		assert.Equal(t, existing.GetID(), todos[0].GetID())
+	}
+}
+
+func TestRestoreSnapshotUseCase_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "malformed json", data: `{"version":`},
+		{name: "unsupported version", data: `{"version":2,"todos":[]}`},
+		{name: "missing id", data: `{"version":1,"todos":[{"title":"New","status":"pending","priority":"low"}]}`},
+		{name: "invalid status", data: `{"version":1,"todos":[{"id":"todo-1","title":"New","status":"done","priority":"low"}]}`},
+		{name: "completed without completion time", data: `{"version":1,"todos":[{"id":"todo-1","title":"New","status":"completed","priority":"low"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockTodoRepository)
+			err := NewTodoUseCase(repo, service.NewTodoDomainService()).RestoreSnapshotUseCase(context.Background(), []byte(tt.data), true)
+			assert.Equal(t, model.ErrInvalidSnapshot.GetErrorCode(), err.GetErrorCode())
+			repo.AssertNotCalled(t, "FindAll")
+			repo.AssertNotCalled(t, "Save", mock.Anything)
+		})
+	}
+}
+
+func TestDeleteTodoUseCase_Success(t *testing.T) {
+	repo := new(MockTodoRepository)
+	publisher := &capturingEventPublisher{}
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithEventPublisher(publisher))
+	todo := model.NewTodo("Test", "Desc", model.TodoPriorityMedium)
+
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Delete", todo.GetID()).Return(nil)
+
+	err := uc.DeleteTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+	if assert.Len(t, publisher.events, 1) {
+		assert.Equal(t, todo.GetID(), publisher.events[0].(*event.TodoDeletedEvent).TodoID)
+	}
+}
+
+func TestDeleteTodoUseCase_NotFound(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	id := model.TodoID("notfound")
+
+	repo.On("FindByID", id).Return(nil, errors.New("not found"))
+
+	err := uc.DeleteTodoUseCase(context.Background(), id)
+	assert.Equal(t, model.ErrTodoNotFound, err)
+	repo.AssertNotCalled(t, "Delete", id)
+}
+
+func TestDeleteTodoUseCase_DeleteFails(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Test", "Desc", model.TodoPriorityMedium)
+
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Delete", todo.GetID()).Return(errors.New("db error"))
+
+	err := uc.DeleteTodoUseCase(context.Background(), todo.GetID())
+	assert.Equal(t, model.ErrFailedToDeleteTodo, err)
+}
+
+func TestListTodosUseCase_Paginated(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	page := []*model.Todo{model.NewTodo("Todo 3", "", model.TodoPriorityLow)}
+	repo.On("FindPaginated", 1, 2).Return(page, 5, nil)
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{Limit: 1, Offset: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, resp.Count)
+	assert.Equal(t, 5, resp.Total)
+	repo.AssertExpectations(t)
+}
+
+func TestListTodosUseCase_PaginatedDoesNotServeStale(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.StaleOnError = true
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	repo.On("FindPaginated", 0, 0).Return([]*model.Todo{model.NewTodo("Cached", "", model.TodoPriorityLow)}, 1, nil).Once()
+	repo.On("FindPaginated", 10, 0).Return(nil, 0, errors.New("db down")).Once()
+
+	_, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{})
+	assert.Nil(t, err)
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{Limit: 10})
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrFailedToRetrieveTodos, err)
+}
+
+func TestListTodosUseCase_StatusFilter(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todos := []*model.Todo{
+		model.NewTodo("Todo 2", "", model.TodoPriorityLow),
+		model.NewTodo("Todo 3", "", model.TodoPriorityLow),
+	}
+	filter := model.TodoFilter{Status: model.TodoStatusPending}
+	repo.On("FindFiltered", filter, model.TodoSort{}, 2, 1).Return(todos, 3, nil)
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{StatusFilter: "pending", Limit: 2, Offset: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, resp.Count)
+	assert.Equal(t, 3, resp.Total)
+	assert.Equal(t, "Todo 2", resp.Todos[0].Title)
+	repo.AssertNotCalled(t, "FindPaginated", mock.Anything, mock.Anything)
+}
+
+func TestListTodosUseCase_InvalidStatusFilter(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{StatusFilter: "done"})
+	assert.Nil(t, resp)
+	assert.Equal(t, model.ErrInvalidStatus, err)
+	repo.AssertNotCalled(t, "FindFiltered", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListTodosUseCase_PriorityFilterAndSort(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	filter := model.TodoFilter{Priority: model.TodoPriorityHigh}
+	sort := model.TodoSort{Field: model.SortByTitle, Descending: true}
+	repo.On("FindFiltered", filter, sort, 0, 0).Return([]*model.Todo{}, 0, nil)
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{PriorityFilter: "high", SortBy: "title", SortOrder: "desc"})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, resp.Total)
+	repo.AssertExpectations(t)
+}
+
+func TestListTodosUseCase_OverdueFilter(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("FindFiltered", mock.MatchedBy(func(filter model.TodoFilter) bool {
+		return filter.OverdueAt != nil && filter.Status == "" && filter.Priority == ""
+	}), model.TodoSort{}, 0, 0).Return([]*model.Todo{}, 0, nil)
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{Overdue: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, resp.Total)
+	repo.AssertExpectations(t)
+}
+
+func TestListTodosUseCase_InvalidSortAndPriority(t *testing.T) {
+	tests := []struct {
+		name    string
+		q       query.ListTodosQuery
+		errCode int
+	}{
+		{name: "priority", q: query.ListTodosQuery{PriorityFilter: "urgent"}, errCode: model.ErrInvalidPriority.GetErrorCode()},
+		{name: "sort field", q: query.ListTodosQuery{SortBy: "id; DROP TABLE todos"}, errCode: model.ErrInvalidQueryParam.GetErrorCode()},
+		{name: "sort order", q: query.ListTodosQuery{SortBy: "title", SortOrder: "up"}, errCode: model.ErrInvalidQueryParam.GetErrorCode()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockTodoRepository)
+			uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+			resp, err := uc.ListTodosUseCase(context.Background(), tt.q)
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.errCode, err.GetErrorCode())
+			repo.AssertNotCalled(t, "FindFiltered", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCreateTodoUseCase_AssignsExistingCategory(t *testing.T) {
+	repo := new(MockTodoRepository)
+	categoryRepo := new(MockCategoryRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithCategoryRepository(categoryRepo))
+
+	category := model.NewCategory("Work", "", model.CategoryColorBlue, "")
+	categoryRepo.On("FindByID", category.GetID()).Return(category, nil)
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetCategoryID() == category.GetID()
+	})).Return(nil)
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low", CategoryID: string(category.GetID())})
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateTodoUseCase_UnknownCategory(t *testing.T) {
+	repo := new(MockTodoRepository)
+	categoryRepo := new(MockCategoryRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithCategoryRepository(categoryRepo))
+
+	categoryRepo.On("FindByID", model.CategoryID("missing")).Return(nil, errors.New("not found"))
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low", CategoryID: "missing"})
+	assert.Equal(t, model.ErrCategoryNotFound.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, "missing", err.GetDetails()["category-id"])
+	repo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCreateTodoUseCase_CategoryWithoutRepository(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low", CategoryID: "cat-1"})
+	assert.Equal(t, model.ErrCategoryNotFound.GetErrorCode(), err.GetErrorCode())
+	repo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestUpdateTodoUseCase_AssignsCategory(t *testing.T) {
+	repo := new(MockTodoRepository)
+	categoryRepo := new(MockCategoryRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithCategoryRepository(categoryRepo))
+
+	todo := model.NewSimpleTodo("Test")
+	category := model.NewCategory("Work", "", model.CategoryColorBlue, "")
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+	categoryRepo.On("FindByID", category.GetID()).Return(category, nil)
+	categoryRepo.On("FindByID", model.CategoryID("missing")).Return(nil, errors.New("not found"))
+
+	err := uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), CategoryID: string(category.GetID())})
+	assert.Nil(t, err)
+	assert.Equal(t, category.GetID(), todo.GetCategoryID())
+
+	err = uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), CategoryID: "missing"})
+	assert.Equal(t, model.ErrCategoryNotFound.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, category.GetID(), todo.GetCategoryID())
+	repo.AssertNumberOfCalls(t, "Update", 1)
+}
+
+func TestCreateTodoUseCase_DueDate(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+	due := time.Now().Add(24 * time.Hour)
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return todo.GetDueDate() != nil && todo.GetDueDate().Equal(due)
+	})).Return(nil)
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low", DueDate: &due})
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateTodoUseCase_PastDueDate(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+	past := time.Now().Add(-time.Hour)
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low", DueDate: &past})
+	assert.Equal(t, model.ErrInvalidDueDate, err)
+	repo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestUpdateTodoUseCase_DueDate(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+	todo := model.NewSimpleTodo("Test")
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	due := time.Now().Add(time.Hour)
+	err := uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), DueDate: &due})
+	assert.Nil(t, err)
+	assert.True(t, todo.GetDueDate().Equal(due))
+
+	past := time.Now().Add(-time.Hour)
+	err = uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), DueDate: &past})
+	assert.Equal(t, model.ErrInvalidDueDate, err)
+	repo.AssertNumberOfCalls(t, "Update", 1)
+}
+
+func TestCreateTodoUseCase_Tags(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+	repo.On("Create", mock.MatchedBy(func(todo *model.Todo) bool {
+		return assert.ObjectsAreEqual([]string{"work", "urgent"}, todo.GetTags())
+	})).Return(nil)
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low", Tags: []string{"work", "urgent"}})
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateTodoUseCase_DuplicateTag(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+	_, err := uc.CreateTodoUseCase(context.Background(), command.CreateTodoCommand{Title: "Test", Priority: "low", Tags: []string{"work", "work"}})
+	assert.Equal(t, model.ErrInvalidTag.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, "work", err.GetDetails()["tag"])
+	repo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestUpdateTodoUseCase_ReplacesTags(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+
+	todo := model.NewSimpleTodo("Test")
+	assert.NoError(t, todo.AddTag("work"))
+	assert.NoError(t, todo.AddTag("home"))
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), Tags: []string{"home", "urgent"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"home", "urgent"}, todo.GetTags())
+
+	// Omitting tags leaves them untouched
+	err = uc.UpdateTodoUseCase(context.Background(), command.UpdateTodoCommand{ID: string(todo.GetID()), Title: "Renamed"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"home", "urgent"}, todo.GetTags())
+}
+
+func TestListTodosUseCase_TagFilter(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("FindFiltered", model.TodoFilter{Tag: "work"}, model.TodoSort{}, 0, 0).Return([]*model.Todo{}, 0, nil)
+
+	_, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{TagFilter: "work"})
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestListTodosUseCase_SearchTerm(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	matches := []*model.Todo{
+		model.NewSimpleTodo("Report 1"), model.NewSimpleTodo("Report 2"), model.NewSimpleTodo("Report 3"),
+	}
+	repo.On("Search", "report").Return(matches, nil)
+
+	resp, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{SearchTerm: "report", Limit: 2, Offset: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, resp.Count)
+	assert.Equal(t, 3, resp.Total)
+	assert.Equal(t, "Report 2", resp.Todos[0].Title)
+	repo.AssertExpectations(t)
+}
+
+func TestListTodosUseCase_SearchTermWithFilters(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("FindFiltered", model.TodoFilter{Tag: "work", Search: "report"}, model.TodoSort{}, 0, 0).Return([]*model.Todo{}, 0, nil)
+
+	_, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{TagFilter: "work", SearchTerm: "report"})
+	assert.Nil(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestListTodosUseCase_SearchRepoError(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	repo.On("Search", "report").Return(nil, errors.New("db down"))
+
+	_, err := uc.ListTodosUseCase(context.Background(), query.ListTodosQuery{SearchTerm: "report"})
+	assert.NotNil(t, err)
+	assert.Equal(t, model.ErrFailedToRetrieveTodos.GetErrorCode(), err.GetErrorCode())
+}
+
+func TestCompleteTodoUseCase_RequireCategoryRejectsUncategorized(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.RequireCategoryForCompletion = true
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	todo := model.NewTodo("Loose", "", model.TodoPriorityLow)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+
+	err := uc.CompleteTodoUseCase(context.Background(), todo.GetID())
+	assert.Equal(t, model.ErrCategoryRequired.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, 409, err.GetHttpStatus())
+	assert.Equal(t, model.TodoStatusPending, todo.GetStatus())
+	repo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestCompleteTodoUseCase_RequireCategoryAllowsCategorized(t *testing.T) {
+	repo := new(MockTodoRepository)
+	cfg := config.Default()
+	cfg.RequireCategoryForCompletion = true
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService(), WithConfig(cfg))
+	todo := model.NewTodo("Filed", "", model.TodoPriorityLow)
+	assert.NoError(t, todo.AssignCategory(model.CategoryID("work")))
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.CompleteTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+	assert.Equal(t, model.TodoStatusCompleted, todo.GetStatus())
+}
+
+func TestCompleteTodoUseCase_UncategorizedAllowedByDefault(t *testing.T) {
+	repo := new(MockTodoRepository)
+	uc := NewTodoUseCase(repo, service.NewTodoDomainService())
+	todo := model.NewTodo("Loose", "", model.TodoPriorityLow)
+	repo.On("FindByID", todo.GetID()).Return(todo, nil)
+	repo.On("Update", todo).Return(nil)
+
+	err := uc.CompleteTodoUseCase(context.Background(), todo.GetID())
+	assert.Nil(t, err)
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// UserUseCase implements the UserUseCasePort using the UserRepositoryPort
+type UserUseCase struct {
+	userRepo port.UserRepositoryPort
+}
+
+var _ port.UserUseCasePort = (*UserUseCase)(nil)
+
+// NewUserUseCase creates a new UserUseCase
+func NewUserUseCase(userRepo port.UserRepositoryPort) *UserUseCase {
+	return &UserUseCase{userRepo: userRepo}
+}
+
+// CreateUserUseCase registers a new user with a unique email
+func (uc *UserUseCase) CreateUserUseCase(cmd command.CreateUserCommand) (model.UserID, *model.DomainError) {
+	email := strings.TrimSpace(cmd.Email)
+	if email == "" || strings.TrimSpace(cmd.Username) == "" || cmd.FirstName == "" || cmd.LastName == "" {
+		return "", model.ErrInvalidUserProfile
+	}
+	if !model.IsValidEmail(email) {
+		return "", model.ErrInvalidEmail
+	}
+	if err := uc.checkEmailAvailable(email, ""); err != nil {
+		return "", err
+	}
+
+	user := model.NewUser(email, strings.TrimSpace(cmd.Username), cmd.FirstName, cmd.LastName)
+	if err := uc.userRepo.Save(user); err != nil {
+		return "", model.ErrFailedToSaveUser
+	}
+	return user.GetID(), nil
+}
+
+// UpdateUserProfileUseCase updates a user's name and email; empty fields are left unchanged
+func (uc *UserUseCase) UpdateUserProfileUseCase(cmd command.UpdateUserProfileCommand) *model.DomainError {
+	user, err := uc.userRepo.FindByID(model.UserID(cmd.ID))
+	if err != nil {
+		return model.ErrUserNotFound
+	}
+
+	if cmd.FirstName != "" || cmd.LastName != "" {
+		firstName, lastName := cmd.FirstName, cmd.LastName
+		if firstName == "" {
+			firstName = user.GetFirstName()
+		}
+		if lastName == "" {
+			lastName = user.GetLastName()
+		}
+		if err := user.UpdateProfile(firstName, lastName); err != nil {
+			return model.ErrInvalidUserProfile
+		}
+	}
+
+	if email := strings.TrimSpace(cmd.Email); email != "" && !strings.EqualFold(email, user.GetEmail()) {
+		if !model.IsValidEmail(email) {
+			return model.ErrInvalidEmail
+		}
+		if err := uc.checkEmailAvailable(email, user.GetID()); err != nil {
+			return err
+		}
+		if err := user.UpdateEmail(email); err != nil {
+			return model.ErrInvalidUserProfile
+		}
+	}
+
+	if err := uc.userRepo.Save(user); err != nil {
+		return model.ErrFailedToSaveUser
+	}
+	return nil
+}
+
+// GetUserUseCase retrieves a single user
+func (uc *UserUseCase) GetUserUseCase(id model.UserID) (*appmodel.UserResponse, *model.DomainError) {
+	user, err := uc.userRepo.FindByID(id)
+	if err != nil {
+		return nil, model.ErrUserNotFound
+	}
+
+	response := appmodel.UserResponseMapper(user)
+	return &response, nil
+}
+
+// PromoteUserUseCase grants a user the admin role
+func (uc *UserUseCase) PromoteUserUseCase(cmd command.PromoteUserCommand) *model.DomainError {
+	user, err := uc.userRepo.FindByID(model.UserID(cmd.ID))
+	if err != nil {
+		return model.ErrUserNotFound
+	}
+	if err := user.PromoteToAdmin(); err != nil {
+		return model.ErrCannotPromoteUser
+	}
+	if err := uc.userRepo.Save(user); err != nil {
+		return model.ErrFailedToSaveUser
+	}
+	return nil
+}
+
+// SuspendUserUseCase suspends a user account
+func (uc *UserUseCase) SuspendUserUseCase(cmd command.SuspendUserCommand) *model.DomainError {
+	user, err := uc.userRepo.FindByID(model.UserID(cmd.ID))
+	if err != nil {
+		return model.ErrUserNotFound
+	}
+	if err := user.SuspendAccount(); err != nil {
+		return model.ErrCannotSuspendUser
+	}
+	if err := uc.userRepo.Save(user); err != nil {
+		return model.ErrFailedToSaveUser
+	}
+	return nil
+}
+
+// checkEmailAvailable rejects an email already registered to a user other than self
+func (uc *UserUseCase) checkEmailAvailable(email string, self model.UserID) *model.DomainError {
+	existing, err := uc.userRepo.FindByEmail(email)
+	if err != nil {
+		return model.ErrFailedToRetrieveUsers
+	}
+	if existing != nil && existing.GetID() != self {
+		return model.ErrDuplicateEmail
+	}
+	return nil
+}
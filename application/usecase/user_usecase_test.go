@@ -0,0 +1,248 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) Save(user *model.User) error {
+	args := m.Called(user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) FindByID(id model.UserID) (*model.User, error) {
+	args := m.Called(id)
+	if user, ok := args.Get(0).(*model.User); ok {
+		return user, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockUserRepository) FindByEmail(email string) (*model.User, error) {
+	args := m.Called(email)
+	if user, ok := args.Get(0).(*model.User); ok {
+		return user, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockUserRepository) FindAll() ([]*model.User, error) {
+	args := m.Called()
+	if users, ok := args.Get(0).([]*model.User); ok {
+		return users, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockUserRepository) Delete(id model.UserID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func validCreateUserCommand() command.CreateUserCommand {
+	return command.CreateUserCommand{Email: "ada@example.com", Username: "ada", FirstName: "Ada", LastName: "Lovelace"}
+}
+
+func TestCreateUserUseCase_Success(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	repo.On("FindByEmail", "ada@example.com").Return(nil, nil)
+	repo.On("Save", mock.AnythingOfType("*model.User")).Return(nil)
+
+	id, err := uc.CreateUserUseCase(validCreateUserCommand())
+	assert.Nil(t, err)
+	assert.NotEmpty(t, id)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateUserUseCase_MissingFields(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	cmd := validCreateUserCommand()
+	cmd.Username = "  "
+	_, err := uc.CreateUserUseCase(cmd)
+	assert.Equal(t, model.ErrInvalidUserProfile, err)
+	repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCreateUserUseCase_DuplicateEmail(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	existing := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	repo.On("FindByEmail", "ada@example.com").Return(existing, nil)
+
+	_, err := uc.CreateUserUseCase(validCreateUserCommand())
+	assert.Equal(t, model.ErrDuplicateEmail, err)
+	repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCreateUserUseCase_LookupError(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	repo.On("FindByEmail", "ada@example.com").Return(nil, errors.New("db down"))
+
+	_, err := uc.CreateUserUseCase(validCreateUserCommand())
+	assert.Equal(t, model.ErrFailedToRetrieveUsers, err)
+}
+
+func TestCreateUserUseCase_SaveError(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	repo.On("FindByEmail", "ada@example.com").Return(nil, nil)
+	repo.On("Save", mock.AnythingOfType("*model.User")).Return(errors.New("db down"))
+
+	_, err := uc.CreateUserUseCase(validCreateUserCommand())
+	assert.Equal(t, model.ErrFailedToSaveUser, err)
+}
+
+func TestUpdateUserProfileUseCase_KeepsUnsetFields(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	repo.On("FindByID", user.GetID()).Return(user, nil)
+	repo.On("Save", user).Return(nil)
+
+	err := uc.UpdateUserProfileUseCase(command.UpdateUserProfileCommand{ID: string(user.GetID()), LastName: "King"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Ada", user.GetFirstName())
+	assert.Equal(t, "King", user.GetLastName())
+	assert.Equal(t, "ada@example.com", user.GetEmail())
+	repo.AssertNotCalled(t, "FindByEmail", mock.Anything)
+}
+
+func TestUpdateUserProfileUseCase_ChangesEmail(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	repo.On("FindByID", user.GetID()).Return(user, nil)
+	repo.On("FindByEmail", "countess@example.com").Return(nil, nil)
+	repo.On("Save", user).Return(nil)
+
+	err := uc.UpdateUserProfileUseCase(command.UpdateUserProfileCommand{ID: string(user.GetID()), Email: "countess@example.com"})
+	assert.Nil(t, err)
+	assert.Equal(t, "countess@example.com", user.GetEmail())
+}
+
+func TestUpdateUserProfileUseCase_DuplicateEmail(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	other := model.NewUser("grace@example.com", "grace", "Grace", "Hopper")
+	repo.On("FindByID", user.GetID()).Return(user, nil)
+	repo.On("FindByEmail", "grace@example.com").Return(other, nil)
+
+	err := uc.UpdateUserProfileUseCase(command.UpdateUserProfileCommand{ID: string(user.GetID()), Email: "grace@example.com"})
+	assert.Equal(t, model.ErrDuplicateEmail, err)
+	repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestUpdateUserProfileUseCase_NotFound(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	repo.On("FindByID", model.UserID("missing")).Return(nil, errors.New("not found"))
+
+	err := uc.UpdateUserProfileUseCase(command.UpdateUserProfileCommand{ID: "missing", FirstName: "Ada"})
+	assert.Equal(t, model.ErrUserNotFound, err)
+}
+
+func TestPromoteUserUseCase(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	repo.On("FindByID", user.GetID()).Return(user, nil)
+	repo.On("Save", user).Return(nil)
+
+	assert.Nil(t, uc.PromoteUserUseCase(command.PromoteUserCommand{ID: string(user.GetID())}))
+	assert.True(t, user.IsAdmin())
+
+	err := uc.PromoteUserUseCase(command.PromoteUserCommand{ID: string(user.GetID())})
+	assert.Equal(t, model.ErrCannotPromoteUser, err)
+	repo.AssertNumberOfCalls(t, "Save", 1)
+}
+
+func TestSuspendUserUseCase(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	repo.On("FindByID", user.GetID()).Return(user, nil)
+	repo.On("Save", user).Return(nil)
+
+	assert.Nil(t, uc.SuspendUserUseCase(command.SuspendUserCommand{ID: string(user.GetID())}))
+	assert.True(t, user.IsSuspended())
+
+	err := uc.SuspendUserUseCase(command.SuspendUserCommand{ID: string(user.GetID())})
+	assert.Equal(t, model.ErrCannotSuspendUser, err)
+}
+
+func TestSuspendUserUseCase_NotFound(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	repo.On("FindByID", model.UserID("missing")).Return(nil, errors.New("not found"))
+
+	err := uc.SuspendUserUseCase(command.SuspendUserCommand{ID: "missing"})
+	assert.Equal(t, model.ErrUserNotFound, err)
+}
+
+func TestCreateUserUseCase_InvalidEmail(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	for _, email := range []string{"not-an-email", "Ada <ada@example.com>", "ada@"} {
+		cmd := validCreateUserCommand()
+		cmd.Email = email
+		_, err := uc.CreateUserUseCase(cmd)
+		assert.Equal(t, model.ErrInvalidEmail, err, email)
+	}
+	repo.AssertNotCalled(t, "FindByEmail", mock.Anything)
+}
+
+func TestUpdateUserProfileUseCase_InvalidEmail(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	repo.On("FindByID", user.GetID()).Return(user, nil)
+
+	err := uc.UpdateUserProfileUseCase(command.UpdateUserProfileCommand{ID: string(user.GetID()), Email: "nope"})
+	assert.Equal(t, model.ErrInvalidEmail, err)
+	repo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestGetUserUseCase(t *testing.T) {
+	repo := new(MockUserRepository)
+	uc := NewUserUseCase(repo)
+
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	repo.On("FindByID", user.GetID()).Return(user, nil)
+	repo.On("FindByID", model.UserID("missing")).Return(nil, errors.New("not found"))
+
+	response, err := uc.GetUserUseCase(user.GetID())
+	assert.Nil(t, err)
+	assert.Equal(t, "ada@example.com", response.Email)
+	assert.Equal(t, string(model.UserRoleUser), response.Role)
+
+	_, err = uc.GetUserUseCase("missing")
+	assert.Equal(t, model.ErrUserNotFound, err)
+}
@@ -0,0 +1,26 @@
+package event
+
+import (
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// TodoArchivedEvent represents a domain event when a Todo is archived
+type TodoArchivedEvent struct {
+	TodoID     model.TodoID
+	ArchivedAt time.Time
+}
+
+// NewTodoArchivedEvent creates a new TodoArchivedEvent
+func NewTodoArchivedEvent(todoID model.TodoID) *TodoArchivedEvent {
+	return &TodoArchivedEvent{
+		TodoID:     todoID,
+		ArchivedAt: time.Now(),
+	}
+}
+
+// AggregateID returns the ID of the todo the event is about
+func (e *TodoArchivedEvent) AggregateID() model.TodoID {
+	return e.TodoID
+}
@@ -19,3 +19,8 @@ func NewTodoCompletedEvent(todoID model.TodoID) *TodoCompletedEvent {
 		CompletedAt: time.Now(),
 	}
 }
+
+// AggregateID returns the ID of the todo the event is about
+func (e *TodoCompletedEvent) AggregateID() model.TodoID {
+	return e.TodoID
+}
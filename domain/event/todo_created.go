@@ -0,0 +1,31 @@
+package event
+
+import (
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// TodoCreatedEvent represents a domain event when a Todo is first stored
+type TodoCreatedEvent struct {
+	TodoID model.TodoID
+	Title  string
+	// Source is where the todo was created, or "" when the adapter did not say
+	Source    model.TodoSource
+	CreatedAt time.Time
+}
+
+// NewTodoCreatedEvent creates a new TodoCreatedEvent
+func NewTodoCreatedEvent(todoID model.TodoID, title string, source model.TodoSource) *TodoCreatedEvent {
+	return &TodoCreatedEvent{
+		TodoID:    todoID,
+		Title:     title,
+		Source:    source,
+		CreatedAt: time.Now(),
+	}
+}
+
+// AggregateID returns the ID of the todo the event is about
+func (e *TodoCreatedEvent) AggregateID() model.TodoID {
+	return e.TodoID
+}
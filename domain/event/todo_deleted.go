@@ -0,0 +1,26 @@
+package event
+
+import (
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// TodoDeletedEvent represents a domain event when a Todo is deleted
+type TodoDeletedEvent struct {
+	TodoID    model.TodoID
+	DeletedAt time.Time
+}
+
+// NewTodoDeletedEvent creates a new TodoDeletedEvent
+func NewTodoDeletedEvent(todoID model.TodoID) *TodoDeletedEvent {
+	return &TodoDeletedEvent{
+		TodoID:    todoID,
+		DeletedAt: time.Now(),
+	}
+}
+
+// AggregateID returns the ID of the todo the event is about
+func (e *TodoDeletedEvent) AggregateID() model.TodoID {
+	return e.TodoID
+}
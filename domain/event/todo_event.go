@@ -0,0 +1,20 @@
+package event
+
+import "github.com/mr3iscuit/ddd-golang/domain/model"
+
+// TodoEvent is implemented by every event about a single todo, so dispatchers
+// can keep the events of one todo in the order they were published
+type TodoEvent interface {
+	AggregateID() model.TodoID
+}
+
+var (
+	_ TodoEvent = (*TodoArchivedEvent)(nil)
+	_ TodoEvent = (*TodoCompletedEvent)(nil)
+	_ TodoEvent = (*TodoCreatedEvent)(nil)
+	_ TodoEvent = (*TodoDeletedEvent)(nil)
+	_ TodoEvent = (*TodoPriorityChangedEvent)(nil)
+	_ TodoEvent = (*TodoRestoredEvent)(nil)
+	_ TodoEvent = (*TodoUnarchivedEvent)(nil)
+	_ TodoEvent = (*TodoUpdatedEvent)(nil)
+)
@@ -0,0 +1,30 @@
+package event
+
+import (
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// TodoPriorityChangedEvent represents a domain event when a Todo's priority changes
+type TodoPriorityChangedEvent struct {
+	TodoID      model.TodoID
+	OldPriority model.TodoPriority
+	NewPriority model.TodoPriority
+	ChangedAt   time.Time
+}
+
+// NewTodoPriorityChangedEvent creates a new TodoPriorityChangedEvent
+func NewTodoPriorityChangedEvent(todoID model.TodoID, oldPriority model.TodoPriority, newPriority model.TodoPriority) *TodoPriorityChangedEvent {
+	return &TodoPriorityChangedEvent{
+		TodoID:      todoID,
+		OldPriority: oldPriority,
+		NewPriority: newPriority,
+		ChangedAt:   time.Now(),
+	}
+}
+
+// AggregateID returns the ID of the todo the event is about
+func (e *TodoPriorityChangedEvent) AggregateID() model.TodoID {
+	return e.TodoID
+}
@@ -0,0 +1,31 @@
+package event
+
+import (
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// TodoRestoredEvent represents a domain event when a Todo is written back from a snapshot
+type TodoRestoredEvent struct {
+	TodoID model.TodoID
+	Title  string
+	// Archived reports whether the todo was restored in the archived state
+	Archived   bool
+	RestoredAt time.Time
+}
+
+// NewTodoRestoredEvent creates a new TodoRestoredEvent
+func NewTodoRestoredEvent(todoID model.TodoID, title string, archived bool) *TodoRestoredEvent {
+	return &TodoRestoredEvent{
+		TodoID:     todoID,
+		Title:      title,
+		Archived:   archived,
+		RestoredAt: time.Now(),
+	}
+}
+
+// AggregateID returns the ID of the todo the event is about
+func (e *TodoRestoredEvent) AggregateID() model.TodoID {
+	return e.TodoID
+}
@@ -0,0 +1,29 @@
+package event
+
+import (
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// TodoUnarchivedEvent represents a domain event when an archived Todo returns to pending
+type TodoUnarchivedEvent struct {
+	TodoID model.TodoID
+	// Title is carried so read models that dropped the archived todo can restore it
+	Title        string
+	UnarchivedAt time.Time
+}
+
+// NewTodoUnarchivedEvent creates a new TodoUnarchivedEvent
+func NewTodoUnarchivedEvent(todoID model.TodoID, title string) *TodoUnarchivedEvent {
+	return &TodoUnarchivedEvent{
+		TodoID:       todoID,
+		Title:        title,
+		UnarchivedAt: time.Now(),
+	}
+}
+
+// AggregateID returns the ID of the todo the event is about
+func (e *TodoUnarchivedEvent) AggregateID() model.TodoID {
+	return e.TodoID
+}
@@ -0,0 +1,29 @@
+package event
+
+import (
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// TodoUpdatedEvent represents a domain event when a stored Todo is overwritten
+type TodoUpdatedEvent struct {
+	TodoID model.TodoID
+	// Title is the title after the update
+	Title     string
+	UpdatedAt time.Time
+}
+
+// NewTodoUpdatedEvent creates a new TodoUpdatedEvent
+func NewTodoUpdatedEvent(todoID model.TodoID, title string) *TodoUpdatedEvent {
+	return &TodoUpdatedEvent{
+		TodoID:    todoID,
+		Title:     title,
+		UpdatedAt: time.Now(),
+	}
+}
+
+// AggregateID returns the ID of the todo the event is about
+func (e *TodoUpdatedEvent) AggregateID() model.TodoID {
+	return e.TodoID
+}
@@ -23,6 +23,22 @@ const (
 	CategoryColorGray   CategoryColor = "gray"
 )
 
+// CategoryColors lists the supported category colors
+var CategoryColors = []CategoryColor{
+	CategoryColorRed, CategoryColorBlue, CategoryColorGreen, CategoryColorYellow,
+	CategoryColorPurple, CategoryColorOrange, CategoryColorGray,
+}
+
+// IsValid reports whether the color is one of the supported CategoryColors
+func (c CategoryColor) IsValid() bool {
+	for _, color := range CategoryColors {
+		if c == color {
+			return true
+		}
+	}
+	return false
+}
+
 // Category represents a category for organizing todos
 type Category struct {
 	id          CategoryID
@@ -50,6 +66,20 @@ func NewCategory(name string, description string, color CategoryColor, createdBy
 	}
 }
 
+// NewCategoryFromData recreates a Category from persisted data
+func NewCategoryFromData(id CategoryID, name, description string, color CategoryColor, createdBy UserID, createdAt, updatedAt time.Time, isDefault bool) *Category {
+	return &Category{
+		id:          id,
+		name:        name,
+		description: description,
+		color:       color,
+		createdBy:   createdBy,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+		isDefault:   isDefault,
+	}
+}
+
 // NewDefaultCategory creates a default category
 func NewDefaultCategory(name string, color CategoryColor) *Category {
 	now := time.Now()
@@ -123,15 +153,13 @@ func (c *Category) UpdateDescription(newDescription string) error {
 }
 
 func (c *Category) UpdateColor(newColor CategoryColor) error {
-	switch newColor {
-	case CategoryColorRed, CategoryColorBlue, CategoryColorGreen,
-		CategoryColorYellow, CategoryColorPurple, CategoryColorOrange, CategoryColorGray:
-		c.color = newColor
-		c.updatedAt = time.Now()
-		return nil
-	default:
+	if !newColor.IsValid() {
 		return errors.New("invalid category color")
 	}
+
+	c.color = newColor
+	c.updatedAt = time.Now()
+	return nil
 }
 
 func (c *Category) MarkAsDefault() error {
@@ -165,5 +193,8 @@ func (c *Category) IsValid() error {
 	if len(c.description) > 200 {
 		return errors.New("category description is too long")
 	}
+	if !c.color.IsValid() {
+		return errors.New("invalid category color")
+	}
 	return nil
 }
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+)
+
 // DomainError represents a domain-specific error following DDD principles
 type DomainError struct {
 	errorCode      int
@@ -22,11 +28,36 @@ type DomainErrorPort interface {
 
 // DomainErrorResponse represents a standardized error response structure
 type DomainErrorResponse struct {
-	ErrorCode      int               `json:"error_code"`
-	HttpStatus     int               `json:"http_status"`
-	ErrorMessage   string            `json:"error_message"`
-	InternalReason string            `json:"internal_reason,omitempty"`
-	Details        map[string]string `json:"details,omitempty"`
+	XMLName        xml.Name     `json:"-" xml:"error"`
+	ErrorCode      int          `json:"error_code" xml:"error-code"`
+	HttpStatus     int          `json:"http_status" xml:"http-status"`
+	ErrorMessage   string       `json:"error_message" xml:"error-message"`
+	InternalReason string       `json:"internal_reason,omitempty" xml:"internal-reason,omitempty"`
+	Details        ErrorDetails `json:"details,omitempty" xml:"details,omitempty"`
+}
+
+// ErrorDetails holds additional key/value context for an error response
+type ErrorDetails map[string]string
+
+// MarshalXML encodes the details as <key>value</key> elements, since encoding/xml cannot marshal maps
+func (d ErrorDetails) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(d) == 0 {
+		return nil
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(d))
+	for key := range d {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := e.EncodeElement(d[key], xml.StartElement{Name: xml.Name{Local: key}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
 }
 
 // GetErrorCode returns the error code
@@ -59,6 +90,17 @@ func (e *DomainError) Error() string {
 	return e.errorMessage
 }
 
+// WithDetails returns a copy of the error carrying the given details
+func (e *DomainError) WithDetails(details map[string]string) *DomainError {
+	return &DomainError{
+		errorCode:      e.errorCode,
+		httpStatus:     e.httpStatus,
+		errorMessage:   e.errorMessage,
+		internalReason: e.internalReason,
+		details:        details,
+	}
+}
+
 // ToResponse converts a DomainError to a DomainErrorResponse
 func (e *DomainError) ToResponse() DomainErrorResponse {
 	return DomainErrorResponse{
@@ -78,144 +120,550 @@ func (e *DomainError) ToResponseWithInternal(includeInternal bool) DomainErrorRe
 	return response
 }
 
+// registry holds every predefined domain error keyed by error code
+var registry = map[int]*DomainError{}
+
+// register adds a predefined error to the catalog, panicking on duplicate codes
+func register(e *DomainError) *DomainError {
+	if existing, ok := registry[e.errorCode]; ok {
+		panic(fmt.Sprintf("duplicate domain error code %d: %q and %q", e.errorCode, existing.errorMessage, e.errorMessage))
+	}
+	registry[e.errorCode] = e
+	return e
+}
+
+// ErrorCatalog returns every predefined domain error ordered by error code
+func ErrorCatalog() []*DomainError {
+	catalog := make([]*DomainError, 0, len(registry))
+	for _, e := range registry {
+		catalog = append(catalog, e)
+	}
+	sort.Slice(catalog, func(i, j int) bool { return catalog[i].errorCode < catalog[j].errorCode })
+	return catalog
+}
+
 // Predefined domain errors organized by category
 
 // Validation errors (1000-1999)
 var (
-	ErrInvalidTitle = &DomainError{
+	ErrInvalidTitle = register(&DomainError{
 		errorCode:      1001,
 		httpStatus:     400,
 		errorMessage:   "Invalid title",
 		internalReason: "Title validation failed",
 		details:        nil,
-	}
+	})
 
-	ErrInvalidDescription = &DomainError{
+	ErrInvalidDescription = register(&DomainError{
 		errorCode:      1002,
 		httpStatus:     400,
 		errorMessage:   "Invalid description",
 		internalReason: "Description validation failed",
 		details:        nil,
-	}
+	})
 
-	ErrInvalidPriority = &DomainError{
+	ErrInvalidPriority = register(&DomainError{
 		errorCode:      1003,
 		httpStatus:     400,
 		errorMessage:   "Invalid priority",
 		internalReason: "Priority must be low, medium, or high",
 		details:        nil,
-	}
+	})
 
-	ErrEmptyTitle = &DomainError{
+	ErrEmptyTitle = register(&DomainError{
 		errorCode:      1004,
 		httpStatus:     400,
 		errorMessage:   "Title cannot be empty",
 		internalReason: "Empty title provided",
 		details:        nil,
-	}
+	})
 
-	ErrTitleTooLong = &DomainError{
+	ErrTitleTooLong = register(&DomainError{
 		errorCode:      1005,
 		httpStatus:     400,
 		errorMessage:   "Title too long",
-		internalReason: "Title exceeds maximum length of 100 characters",
-		details:        map[string]string{"max_length": "100"},
-	}
+		internalReason: "Title exceeds the configured maximum length",
+		details:        nil,
+	})
+
+	ErrInvalidStatus = register(&DomainError{
+		errorCode:      1006,
+		httpStatus:     400,
+		errorMessage:   "Invalid status",
+		internalReason: "Status must be pending, completed, or archived",
+		details:        nil,
+	})
+
+	ErrInvalidQueryParam = register(&DomainError{
+		errorCode:      1007,
+		httpStatus:     400,
+		errorMessage:   "Invalid query parameter",
+		internalReason: "Query parameter could not be parsed or is out of range",
+		details:        nil,
+	})
+
+	ErrBulkTooLarge = register(&DomainError{
+		errorCode:      1008,
+		httpStatus:     400,
+		errorMessage:   "Bulk operation too large",
+		internalReason: "Bulk operation exceeds the maximum number of IDs",
+		details:        nil,
+	})
+
+	ErrUnknownField = register(&DomainError{
+		errorCode:      1009,
+		httpStatus:     400,
+		errorMessage:   "Unknown field",
+		internalReason: "Field cannot be validated individually",
+		details:        map[string]string{"supported": "title, description, priority"},
+	})
+
+	ErrInvalidSnapshot = register(&DomainError{
+		errorCode:      1010,
+		httpStatus:     400,
+		errorMessage:   "Invalid snapshot",
+		internalReason: "Snapshot could not be decoded or contains invalid todos",
+		details:        nil,
+	})
+
+	ErrInvalidUserProfile = register(&DomainError{
+		errorCode:      1011,
+		httpStatus:     400,
+		errorMessage:   "Invalid user profile",
+		internalReason: "Email, username, first name and last name are required",
+		details:        nil,
+	})
+
+	ErrInvalidEmail = register(&DomainError{
+		errorCode:      1012,
+		httpStatus:     400,
+		errorMessage:   "Invalid email",
+		internalReason: "Email address is malformed",
+		details:        nil,
+	})
+
+	ErrInvalidCategory = register(&DomainError{
+		errorCode:      1013,
+		httpStatus:     400,
+		errorMessage:   "Invalid category",
+		internalReason: "Category name is required and limited to 50 characters; description to 200",
+		details:        nil,
+	})
+
+	ErrInvalidCategoryColor = register(&DomainError{
+		errorCode:      1014,
+		httpStatus:     400,
+		errorMessage:   "Invalid category color",
+		internalReason: "Color is not one of the supported category colors",
+		details:        nil,
+	})
+
+	ErrInvalidPathParam = register(&DomainError{
+		errorCode:      1015,
+		httpStatus:     400,
+		errorMessage:   "Invalid path parameter",
+		internalReason: "Request path contains control characters",
+		details:        nil,
+	})
+
+	ErrInvalidDueDate = register(&DomainError{
+		errorCode:      1016,
+		httpStatus:     400,
+		errorMessage:   "Invalid due date",
+		internalReason: "Due date cannot be in the past",
+		details:        nil,
+	})
+
+	ErrInvalidTag = register(&DomainError{
+		errorCode:      1017,
+		httpStatus:     400,
+		errorMessage:   "Invalid tag",
+		internalReason: "Tags must be unique, non-empty, at most 30 characters and at most 20 per todo",
+		details:        nil,
+	})
+
+	ErrInvalidSource = register(&DomainError{
+		errorCode:      1018,
+		httpStatus:     400,
+		errorMessage:   "Invalid source",
+		internalReason: "Source must be one of: http, cli, grpc, import",
+		details:        nil,
+	})
+
+	ErrInvalidDependency = register(&DomainError{
+		errorCode:      1019,
+		httpStatus:     400,
+		errorMessage:   "Invalid dependency",
+		internalReason: "A todo cannot depend on itself, on the same todo twice or on a todo that depends on it",
+		details:        nil,
+	})
 )
 
 // Not found errors (2000-2999)
 var (
-	ErrTodoNotFound = &DomainError{
+	ErrTodoNotFound = register(&DomainError{
 		errorCode:      2001,
 		httpStatus:     404,
 		errorMessage:   "Todo not found",
 		internalReason: "Todo with specified ID not found",
 		details:        nil,
-	}
+	})
+
+	ErrRouteNotFound = register(&DomainError{
+		errorCode:      2002,
+		httpStatus:     404,
+		errorMessage:   "Route not found",
+		internalReason: "No route matches the request path",
+		details:        nil,
+	})
+
+	ErrUserNotFound = register(&DomainError{
+		errorCode:      2003,
+		httpStatus:     404,
+		errorMessage:   "User not found",
+		internalReason: "User with specified ID not found",
+		details:        nil,
+	})
+
+	ErrCategoryNotFound = register(&DomainError{
+		errorCode:      2004,
+		httpStatus:     404,
+		errorMessage:   "Category not found",
+		internalReason: "Category with specified ID not found",
+		details:        nil,
+	})
 )
 
 // Operation errors (3000-3999)
 var (
-	ErrCannotCompleteTodo = &DomainError{
+	ErrCannotCompleteTodo = register(&DomainError{
 		errorCode:      3001,
 		httpStatus:     400,
 		errorMessage:   "Cannot complete todo",
 		internalReason: "Todo cannot be completed",
 		details:        nil,
-	}
+	})
 
-	ErrCannotArchiveTodo = &DomainError{
+	ErrCannotArchiveTodo = register(&DomainError{
 		errorCode:      3002,
 		httpStatus:     400,
 		errorMessage:   "Cannot archive todo",
 		internalReason: "Todo cannot be archived",
 		details:        nil,
-	}
+	})
+
+	ErrCannotUnarchiveTodo = register(&DomainError{
+		errorCode:      3003,
+		httpStatus:     400,
+		errorMessage:   "Cannot unarchive todo",
+		internalReason: "Only archived todos can be unarchived",
+		details:        nil,
+	})
+
+	ErrCannotUncompleteTodo = register(&DomainError{
+		errorCode:      3004,
+		httpStatus:     400,
+		errorMessage:   "Cannot uncomplete todo",
+		internalReason: "Only completed todos can be uncompleted",
+		details:        nil,
+	})
+
+	ErrDuplicateEmail = register(&DomainError{
+		errorCode:      3005,
+		httpStatus:     409,
+		errorMessage:   "Email already in use",
+		internalReason: "Another user is registered with this email",
+		details:        nil,
+	})
+
+	ErrCannotPromoteUser = register(&DomainError{
+		errorCode:      3006,
+		httpStatus:     400,
+		errorMessage:   "Cannot promote user",
+		internalReason: "User is already an admin",
+		details:        nil,
+	})
+
+	ErrCannotSuspendUser = register(&DomainError{
+		errorCode:      3007,
+		httpStatus:     400,
+		errorMessage:   "Cannot suspend user",
+		internalReason: "User is already suspended",
+		details:        nil,
+	})
+
+	ErrCannotDeleteDefaultCategory = register(&DomainError{
+		errorCode:      3008,
+		httpStatus:     409,
+		errorMessage:   "Cannot delete default category",
+		internalReason: "Default categories are protected from deletion",
+		details:        nil,
+	})
+
+	ErrCannotReopenTodo = register(&DomainError{
+		errorCode:      3010,
+		httpStatus:     400,
+		errorMessage:   "Cannot reopen todo",
+		internalReason: "Only completed todos can be reopened; archived todos stay archived",
+		details:        nil,
+	})
+
+	ErrCategoryRequired = register(&DomainError{
+		errorCode:      3009,
+		httpStatus:     409,
+		errorMessage:   "Category required",
+		internalReason: "Todo must be assigned a category before it can be completed",
+		details:        nil,
+	})
+
+	ErrDependencyCycle = register(&DomainError{
+		errorCode:      3011,
+		httpStatus:     409,
+		errorMessage:   "Dependency cycle",
+		internalReason: "The dependency would make a todo depend, directly or transitively, on itself",
+		details:        nil,
+	})
 )
 
 // Repository errors (4000-4999)
 var (
-	ErrRepositoryNotInitialized = &DomainError{
+	ErrRepositoryNotInitialized = register(&DomainError{
 		errorCode:      4001,
 		httpStatus:     500,
 		errorMessage:   "Repository not initialized",
 		internalReason: "Repository is nil",
 		details:        map[string]string{"operation": "list_todos"},
-	}
+	})
 
-	ErrFailedToSaveTodo = &DomainError{
+	ErrFailedToSaveTodo = register(&DomainError{
 		errorCode:      4002,
 		httpStatus:     500,
 		errorMessage:   "Failed to save todo",
 		internalReason: "Database save operation failed",
 		details:        nil,
-	}
+	})
 
-	ErrFailedToSaveCompletedTodo = &DomainError{
+	ErrFailedToSaveCompletedTodo = register(&DomainError{
 		errorCode:      4003,
 		httpStatus:     500,
 		errorMessage:   "Failed to save completed todo",
 		internalReason: "Database save operation failed for completed todo",
 		details:        nil,
-	}
+	})
 
-	ErrFailedToSaveArchivedTodo = &DomainError{
+	ErrFailedToSaveArchivedTodo = register(&DomainError{
 		errorCode:      4004,
 		httpStatus:     500,
 		errorMessage:   "Failed to save archived todo",
 		internalReason: "Database save operation failed for archived todo",
 		details:        nil,
-	}
+	})
 
-	ErrFailedToRetrieveTodos = &DomainError{
+	ErrFailedToRetrieveTodos = register(&DomainError{
 		errorCode:      4005,
 		httpStatus:     500,
 		errorMessage:   "Failed to retrieve todos",
 		internalReason: "Database retrieve operation failed",
 		details:        map[string]string{"operation": "list_todos"},
-	}
+	})
+
+	ErrFailedToDeleteTodo = register(&DomainError{
+		errorCode:      4006,
+		httpStatus:     500,
+		errorMessage:   "Failed to delete todo",
+		internalReason: "Database delete operation failed",
+		details:        nil,
+	})
+
+	ErrFailedToSaveUser = register(&DomainError{
+		errorCode:      4007,
+		httpStatus:     500,
+		errorMessage:   "Failed to save user",
+		internalReason: "Database save operation failed for user",
+		details:        nil,
+	})
+
+	ErrFailedToRetrieveUsers = register(&DomainError{
+		errorCode:      4008,
+		httpStatus:     500,
+		errorMessage:   "Failed to retrieve users",
+		internalReason: "Database retrieve operation failed for users",
+		details:        nil,
+	})
+
+	ErrFailedToSaveCategory = register(&DomainError{
+		errorCode:      4009,
+		httpStatus:     500,
+		errorMessage:   "Failed to save category",
+		internalReason: "Database save operation failed for category",
+		details:        nil,
+	})
+
+	ErrFailedToRetrieveCategories = register(&DomainError{
+		errorCode:      4010,
+		httpStatus:     500,
+		errorMessage:   "Failed to retrieve categories",
+		internalReason: "Database retrieve operation failed for categories",
+		details:        nil,
+	})
+
+	ErrFailedToDeleteCategory = register(&DomainError{
+		errorCode:      4011,
+		httpStatus:     500,
+		errorMessage:   "Failed to delete category",
+		internalReason: "Database delete operation failed for category",
+		details:        nil,
+	})
+
+	ErrDatabaseUnavailable = register(&DomainError{
+		errorCode:      4013,
+		httpStatus:     503,
+		errorMessage:   "Database unavailable",
+		internalReason: "The todo repository did not answer a ping",
+		details:        nil,
+	})
+
+	ErrFailedToReplayEvents = register(&DomainError{
+		errorCode:      4012,
+		httpStatus:     500,
+		errorMessage:   "Failed to replay events",
+		internalReason: "Reading the event store or applying an event to a projection failed",
+		details:        nil,
+	})
 )
 
 // HTTP errors (5000-5999)
 var (
-	ErrInvalidJSON = &DomainError{
+	ErrInvalidJSON = register(&DomainError{
 		errorCode:      5001,
 		httpStatus:     400,
 		errorMessage:   "Invalid JSON",
 		internalReason: "JSON parsing failed",
 		details:        nil,
-	}
+	})
+
+	ErrInternalServer = register(&DomainError{
+		errorCode:      5002,
+		httpStatus:     500,
+		errorMessage:   "Internal server error",
+		internalReason: "A handler panicked while serving the request",
+		details:        nil,
+	})
+
+	ErrUnauthorized = register(&DomainError{
+		errorCode:      5003,
+		httpStatus:     401,
+		errorMessage:   "Unauthorized",
+		internalReason: "Authorization header is missing, is not a bearer token, or the token was rejected",
+		details:        nil,
+	})
+
+	ErrNotAcceptable = register(&DomainError{
+		errorCode:      5004,
+		httpStatus:     406,
+		errorMessage:   "Not acceptable",
+		internalReason: "Accept header excludes every supported response format",
+		details:        map[string]string{"supported": "application/json, application/xml"},
+	})
+
+	ErrEmptyBody = register(&DomainError{
+		errorCode:      5005,
+		httpStatus:     400,
+		errorMessage:   "Empty request body",
+		internalReason: "Request body is empty",
+		details:        nil,
+	})
+
+	ErrMalformedJSON = register(&DomainError{
+		errorCode:      5006,
+		httpStatus:     400,
+		errorMessage:   "Malformed JSON",
+		internalReason: "Request body is not syntactically valid JSON",
+		details:        nil,
+	})
+
+	ErrJSONTypeMismatch = register(&DomainError{
+		errorCode:      5007,
+		httpStatus:     400,
+		errorMessage:   "JSON type mismatch",
+		internalReason: "A JSON value has the wrong type for its field",
+		details:        nil,
+	})
+
+	ErrUnsupportedFormat = register(&DomainError{
+		errorCode:      5008,
+		httpStatus:     400,
+		errorMessage:   "Unsupported format",
+		internalReason: "Requested output format is not supported",
+		details:        map[string]string{"supported": "json, ts"},
+	})
+
+	ErrMethodNotAllowed = register(&DomainError{
+		errorCode:      5009,
+		httpStatus:     405,
+		errorMessage:   "Method not allowed",
+		internalReason: "Route exists but does not support the request method",
+		details:        nil,
+	})
+
+	ErrUnknownCommand = register(&DomainError{
+		errorCode:      5010,
+		httpStatus:     500,
+		errorMessage:   "Unknown command",
+		internalReason: "No handler is registered for the dispatched command",
+		details:        nil,
+	})
+
+	ErrUnknownQuery = register(&DomainError{
+		errorCode:      5011,
+		httpStatus:     500,
+		errorMessage:   "Unknown query",
+		internalReason: "No handler is registered for the dispatched query",
+		details:        nil,
+	})
+
+	ErrURITooLong = register(&DomainError{
+		errorCode:      5012,
+		httpStatus:     414,
+		errorMessage:   "URI too long",
+		internalReason: "Request URL exceeds the configured maximum length",
+		details:        nil,
+	})
+
+	ErrInvalidHeader = register(&DomainError{
+		errorCode:      5014,
+		httpStatus:     400,
+		errorMessage:   "Invalid header",
+		internalReason: "A request header has an unsupported value",
+		details:        nil,
+	})
+
+	ErrAdminAuthRequired = register(&DomainError{
+		errorCode:      5013,
+		httpStatus:     401,
+		errorMessage:   "Admin authentication required",
+		internalReason: "X-Admin-Token is missing, does not match ADMIN_TOKEN, or no ADMIN_TOKEN is configured",
+		details:        nil,
+	})
+
+	ErrForbidden = register(&DomainError{
+		errorCode:      5015,
+		httpStatus:     403,
+		errorMessage:   "Forbidden",
+		internalReason: "The authenticated user may not access another user's resources",
+		details:        nil,
+	})
 )
 
 // Test errors (9000-9999)
 var (
-	ErrTestError = &DomainError{
+	ErrTestError = register(&DomainError{
 		errorCode:      9001,
 		httpStatus:     400,
 		errorMessage:   "Test error message",
 		internalReason: "This is a test error for testing error handling",
 		details:        map[string]string{"test": "true"},
-	}
+	})
 )
 
 // NewDomainError creates a new domain error (kept for backward compatibility)
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TitleTerms splits text into the lowercase words a title search matches on,
+// without duplicates and in order of first appearance
+func TitleTerms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := make([]string, 0, len(words))
+	seen := make(map[string]bool, len(words))
+	for _, word := range words {
+		if !seen[word] {
+			seen[word] = true
+			terms = append(terms, word)
+		}
+	}
+	return terms
+}
+
+// MatchesTitleTerms reports whether the todo's title contains every search term
+func (t *Todo) MatchesTitleTerms(terms []string) bool {
+	titleTerms := make(map[string]bool)
+	for _, term := range TitleTerms(t.title) {
+		titleTerms[term] = true
+	}
+	for _, term := range terms {
+		if !titleTerms[term] {
+			return false
+		}
+	}
+	return true
+}
@@ -2,7 +2,11 @@ package model
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
@@ -28,6 +32,53 @@ const (
 	TodoPriorityHigh   TodoPriority = "high"
 )
 
+// TodoSource records which adapter or process created a Todo
+type TodoSource string
+
+const (
+	TodoSourceHTTP   TodoSource = "http"
+	TodoSourceCLI    TodoSource = "cli"
+	TodoSourceGRPC   TodoSource = "grpc"
+	TodoSourceImport TodoSource = "import"
+)
+
+// IsValid reports whether the source is one of the known creation sources
+func (s TodoSource) IsValid() bool {
+	switch s {
+	case TodoSourceHTTP, TodoSourceCLI, TodoSourceGRPC, TodoSourceImport:
+		return true
+	}
+	return false
+}
+
+// DefaultMaxDescriptionLength is the description limit used unless configured otherwise
+const DefaultMaxDescriptionLength = 1000
+
+// DefaultMaxTitleLength is the title limit used unless configured otherwise
+const DefaultMaxTitleLength = 100
+
+// TodoLimits is the configurable length policy for todo titles and descriptions.
+// The domain service checks input against it, and the aggregate's mutators and
+// Validate take it so both enforce the same limits.
+type TodoLimits struct {
+	MaxTitleLength       int
+	MaxDescriptionLength int
+}
+
+// DefaultTodoLimits returns the limits used unless configured otherwise
+func DefaultTodoLimits() TodoLimits {
+	return TodoLimits{MaxTitleLength: DefaultMaxTitleLength, MaxDescriptionLength: DefaultMaxDescriptionLength}
+}
+
+// MaxTags is the largest number of tags a todo can carry
+const MaxTags = 20
+
+// MaxTagLength is the longest tag allowed, in characters
+const MaxTagLength = 30
+
+// UntitledTitle is stored for todos created without a title when empty titles are allowed
+const UntitledTitle = "Untitled"
+
 // Todo represents the Todo aggregate root in DDD
 type Todo struct {
 	id          TodoID
@@ -38,6 +89,14 @@ type Todo struct {
 	createdAt   time.Time
 	updatedAt   time.Time
 	completedAt *time.Time
+	createdBy   UserID
+	categoryID  CategoryID
+	dueDate     *time.Time
+	tags        []string
+	// dependsOn lists the todos that must be completed before this one is actionable
+	dependsOn []TodoID
+	// source is empty for todos created before sources were tracked
+	source TodoSource
 }
 
 // NewTodo creates a new Todo aggregate root with descriptive factory method
@@ -83,7 +142,7 @@ func NewSimpleTodo(title string) *Todo {
 }
 
 // NewTodoFromData reconstructs a Todo object from persistent data
-func NewTodoFromData(id TodoID, title, description string, status TodoStatus, priority TodoPriority, createdAt, updatedAt time.Time, completedAt *time.Time) *Todo {
+func NewTodoFromData(id TodoID, title, description string, status TodoStatus, priority TodoPriority, createdAt, updatedAt time.Time, completedAt *time.Time, createdBy UserID, categoryID CategoryID, dueDate *time.Time, tags []string, source TodoSource) *Todo {
 	return &Todo{
 		id:          id,
 		title:       title,
@@ -93,9 +152,19 @@ func NewTodoFromData(id TodoID, title, description string, status TodoStatus, pr
 		createdAt:   createdAt,
 		updatedAt:   updatedAt,
 		completedAt: completedAt,
+		createdBy:   createdBy,
+		categoryID:  categoryID,
+		dueDate:     dueDate,
+		tags:        append([]string(nil), tags...),
+		source:      source,
 	}
 }
 
+// NormalizeTitle trims a title and collapses internal runs of whitespace to single spaces
+func NormalizeTitle(title string) string {
+	return strings.Join(strings.Fields(title), " ")
+}
+
 // Getters following DDD encapsulation principles with descriptive names
 func (t *Todo) GetID() TodoID {
 	return t.id
@@ -129,6 +198,58 @@ func (t *Todo) GetCompletedAt() *time.Time {
 	return t.completedAt
 }
 
+func (t *Todo) GetCreatedBy() UserID {
+	return t.createdBy
+}
+
+func (t *Todo) GetCategoryID() CategoryID {
+	return t.categoryID
+}
+
+func (t *Todo) GetDueDate() *time.Time {
+	return t.dueDate
+}
+
+// GetTags returns a copy of the todo's tags, never nil
+func (t *Todo) GetTags() []string {
+	return append([]string{}, t.tags...)
+}
+
+// GetSource returns where the todo was created, or "" when unknown
+func (t *Todo) GetSource() TodoSource {
+	return t.source
+}
+
+// GetDependsOn returns a copy of the IDs of the todos this todo depends on, never nil
+func (t *Todo) GetDependsOn() []TodoID {
+	return append([]TodoID{}, t.dependsOn...)
+}
+
+// HasTag checks if the todo carries the given tag
+func (t *Todo) HasTag(tag string) bool {
+	return slices.Contains(t.tags, tag)
+}
+
+// DependsOn checks if the todo depends directly on the todo with the given ID
+func (t *Todo) DependsOn(id TodoID) bool {
+	return slices.Contains(t.dependsOn, id)
+}
+
+// IsActionable checks if the todo is pending and none of its dependencies is
+// still open. dependencies holds the todos it depends on; a dependency missing
+// from it has been deleted and no longer blocks.
+func (t *Todo) IsActionable(dependencies []*Todo) bool {
+	if !t.IsPending() {
+		return false
+	}
+	for _, dependency := range dependencies {
+		if t.DependsOn(dependency.id) && !dependency.IsCompleted() {
+			return false
+		}
+	}
+	return true
+}
+
 // IsCompleted checks if the todo is completed
 func (t *Todo) IsCompleted() bool {
 	return t.status == TodoStatusCompleted
@@ -144,6 +265,16 @@ func (t *Todo) IsArchived() bool {
 	return t.status == TodoStatusArchived
 }
 
+// IsOverdue checks if the todo is still pending after its due date
+func (t *Todo) IsOverdue() bool {
+	return t.IsOverdueAt(time.Now())
+}
+
+// IsOverdueAt checks if the todo is still pending after its due date at the given time
+func (t *Todo) IsOverdueAt(now time.Time) bool {
+	return t.IsPending() && t.dueDate != nil && t.dueDate.Before(now)
+}
+
 // MarkAsCompleted is a domain behavior that enforces business rules
 func (t *Todo) MarkAsCompleted() error {
 	if t.IsCompleted() {
@@ -160,10 +291,37 @@ func (t *Todo) MarkAsCompleted() error {
 	return nil
 }
 
-// MarkAsPending resets the todo to pending status
+// Uncomplete reverts a completed todo to pending and clears its completion timestamp
+func (t *Todo) Uncomplete() error {
+	if !t.IsCompleted() {
+		return errors.New("todo is not completed")
+	}
+
+	t.status = TodoStatusPending
+	t.completedAt = nil
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// RepairCompletedAt backfills a missing completion timestamp on a completed todo
+// from its last update time and reports whether anything changed
+func (t *Todo) RepairCompletedAt() bool {
+	if !t.IsCompleted() || t.completedAt != nil {
+		return false
+	}
+	completedAt := t.updatedAt
+	t.completedAt = &completedAt
+	return true
+}
+
+// MarkAsPending reopens a completed todo, clearing its completion timestamp.
+// Archived todos cannot be reopened.
 func (t *Todo) MarkAsPending() error {
-	if t.IsCompleted() {
-		return errors.New("cannot mark completed todo as pending")
+	if t.IsArchived() {
+		return errors.New("cannot reopen an archived todo")
+	}
+	if t.IsPending() {
+		return errors.New("todo is already pending")
 	}
 
 	t.status = TodoStatusPending
@@ -172,6 +330,125 @@ func (t *Todo) MarkAsPending() error {
 	return nil
 }
 
+// AssignCreator records the user who owns the todo
+func (t *Todo) AssignCreator(userID UserID) error {
+	if userID == "" {
+		return errors.New("creator cannot be empty")
+	}
+
+	t.createdBy = userID
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// AssignCategory files the todo under a category
+func (t *Todo) AssignCategory(id CategoryID) error {
+	if id == "" {
+		return errors.New("category cannot be empty")
+	}
+
+	t.categoryID = id
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// SetSource records where the todo was created
+func (t *Todo) SetSource(source TodoSource) error {
+	if !source.IsValid() {
+		return fmt.Errorf("unknown source %q", source)
+	}
+
+	t.source = source
+	return nil
+}
+
+// SetDueDate sets when the todo is due; the date cannot be in the past
+func (t *Todo) SetDueDate(dueDate time.Time) error {
+	if dueDate.Before(time.Now()) {
+		return errors.New("due date cannot be in the past")
+	}
+
+	t.dueDate = &dueDate
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// validateTag checks a single tag against the length rules
+func validateTag(tag string) error {
+	if strings.TrimSpace(tag) == "" {
+		return errors.New("tag cannot be empty")
+	}
+	if utf8.RuneCountInString(tag) > MaxTagLength {
+		return fmt.Errorf("tag cannot exceed %d characters", MaxTagLength)
+	}
+	return nil
+}
+
+// AddTag attaches a tag to the todo; tags are unique and limited in number and length
+func (t *Todo) AddTag(tag string) error {
+	if err := validateTag(tag); err != nil {
+		return err
+	}
+	if t.HasTag(tag) {
+		return fmt.Errorf("todo is already tagged %q", tag)
+	}
+	if len(t.tags) >= MaxTags {
+		return fmt.Errorf("todo cannot carry more than %d tags", MaxTags)
+	}
+
+	// Build a new slice so copies of the aggregate never share tag storage
+	t.tags = append(slices.Clip(t.tags), tag)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// RemoveTag detaches a tag from the todo
+func (t *Todo) RemoveTag(tag string) error {
+	if !t.HasTag(tag) {
+		return fmt.Errorf("todo is not tagged %q", tag)
+	}
+
+	t.tags = slices.DeleteFunc(slices.Clone(t.tags), func(existing string) bool { return existing == tag })
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// AddDependency blocks the todo until dependency is completed. A todo cannot
+// depend on itself or on a todo that already depends on it; longer cycles
+// span several aggregates and are not detected here.
+func (t *Todo) AddDependency(dependency *Todo) error {
+	if dependency.id == t.id {
+		return errors.New("todo cannot depend on itself")
+	}
+	if t.DependsOn(dependency.id) {
+		return fmt.Errorf("todo already depends on %s", dependency.id)
+	}
+	if dependency.DependsOn(t.id) {
+		return fmt.Errorf("todo %s already depends on this todo", dependency.id)
+	}
+
+	// Build a new slice so copies of the aggregate never share dependency storage
+	t.dependsOn = append(slices.Clip(t.dependsOn), dependency.id)
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// RemoveDependency unblocks the todo from the todo with the given ID
+func (t *Todo) RemoveDependency(id TodoID) error {
+	if !t.DependsOn(id) {
+		return fmt.Errorf("todo does not depend on %s", id)
+	}
+
+	t.dependsOn = slices.DeleteFunc(slices.Clone(t.dependsOn), func(existing TodoID) bool { return existing == id })
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// RestoreDependencies sets the dependencies of a todo rebuilt from persistent data
+func (t *Todo) RestoreDependencies(ids []TodoID) {
+	t.dependsOn = append([]TodoID(nil), ids...)
+}
+
 // ArchiveTodo archives the todo
 func (t *Todo) ArchiveTodo() error {
 	if t.IsArchived() {
@@ -183,41 +460,108 @@ func (t *Todo) ArchiveTodo() error {
 	return nil
 }
 
-// UpdateTitle allows updating the todo title with validation
-func (t *Todo) UpdateTitle(newTitle string) error {
+// UnarchiveTodo returns an archived todo to pending. A completion time kept
+// from before archiving is cleared, as pending todos never carry one.
+func (t *Todo) UnarchiveTodo() error {
+	if !t.IsArchived() {
+		return errors.New("todo is not archived")
+	}
+
+	t.status = TodoStatusPending
+	t.completedAt = nil
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// UpdateTitle allows updating the todo title with validation and reports whether it changed.
+// Setting the current title is a no-op and leaves updatedAt untouched.
+func (t *Todo) UpdateTitle(newTitle string, limits TodoLimits) (bool, error) {
+	if newTitle == t.title {
+		return false, nil
+	}
 	if newTitle == "" {
-		return errors.New("title cannot be empty")
+		return false, errors.New("title cannot be empty")
 	}
-	if len(newTitle) > 200 {
-		return errors.New("title cannot exceed 200 characters")
+	if len(newTitle) > limits.MaxTitleLength {
+		return false, fmt.Errorf("title cannot exceed %d characters", limits.MaxTitleLength)
 	}
 
 	t.title = newTitle
 	t.updatedAt = time.Now()
-	return nil
+	return true, nil
 }
 
-// UpdateDescription allows updating the todo description
-func (t *Todo) UpdateDescription(newDescription string) error {
-	if len(newDescription) > 1000 {
-		return errors.New("description cannot exceed 1000 characters")
+// UpdateDescription allows updating the todo description and reports whether it changed.
+// Setting the current description is a no-op and leaves updatedAt untouched.
+func (t *Todo) UpdateDescription(newDescription string, limits TodoLimits) (bool, error) {
+	if newDescription == t.description {
+		return false, nil
+	}
+	if len(newDescription) > limits.MaxDescriptionLength {
+		return false, fmt.Errorf("description cannot exceed %d characters", limits.MaxDescriptionLength)
 	}
 
 	t.description = newDescription
 	t.updatedAt = time.Now()
-	return nil
+	return true, nil
 }
 
-// UpdatePriority allows updating the todo priority
-func (t *Todo) UpdatePriority(newPriority TodoPriority) error {
+// UpdatePriority allows updating the todo priority and reports whether it changed.
+// Setting the current priority is a no-op and leaves updatedAt untouched.
+func (t *Todo) UpdatePriority(newPriority TodoPriority) (bool, error) {
 	switch newPriority {
 	case TodoPriorityLow, TodoPriorityMedium, TodoPriorityHigh:
+		if newPriority == t.priority {
+			return false, nil
+		}
 		t.priority = newPriority
 		t.updatedAt = time.Now()
-		return nil
+		return true, nil
+	default:
+		return false, errors.New("invalid priority level")
+	}
+}
+
+// Validate checks the aggregate invariants, with the given length limits, and
+// returns a descriptive error for the first violation
+func (t *Todo) Validate(limits TodoLimits) error {
+	if t.title == "" {
+		return errors.New("title cannot be empty")
+	}
+	if len(t.title) > limits.MaxTitleLength {
+		return fmt.Errorf("title cannot exceed %d characters", limits.MaxTitleLength)
+	}
+	if len(t.description) > limits.MaxDescriptionLength {
+		return fmt.Errorf("description cannot exceed %d characters", limits.MaxDescriptionLength)
+	}
+	switch t.status {
+	case TodoStatusPending, TodoStatusCompleted, TodoStatusArchived:
 	default:
-		return errors.New("invalid priority level")
+		return fmt.Errorf("invalid status %q", t.status)
 	}
+	switch t.priority {
+	case TodoPriorityLow, TodoPriorityMedium, TodoPriorityHigh:
+	default:
+		return fmt.Errorf("invalid priority %q", t.priority)
+	}
+	if t.status == TodoStatusCompleted && t.completedAt == nil {
+		return errors.New("completed todo must have a completion time")
+	}
+	if len(t.tags) > MaxTags {
+		return fmt.Errorf("todo cannot carry more than %d tags", MaxTags)
+	}
+	for _, tag := range t.tags {
+		if err := validateTag(tag); err != nil {
+			return err
+		}
+	}
+	if t.DependsOn(t.id) {
+		return errors.New("todo cannot depend on itself")
+	}
+	if t.source != "" && !t.source.IsValid() {
+		return fmt.Errorf("invalid source: %s", t.source)
+	}
+	return nil
 }
 
 // GetElapsedTimeSinceCreation returns the time elapsed since todo creation
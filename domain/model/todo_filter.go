@@ -0,0 +1,57 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// TodoFilter narrows a set of todos; zero-valued fields match every todo
+type TodoFilter struct {
+	Status   TodoStatus
+	Priority TodoPriority
+	// Search matches case-insensitively against the title and description
+	Search string
+	// OverdueAt keeps only todos that are overdue at the given time
+	OverdueAt *time.Time
+	// Tag keeps only todos carrying the given tag
+	Tag string
+	// Source keeps only todos created through the given source
+	Source TodoSource
+	// CreatedBy keeps only todos created by the given user
+	CreatedBy UserID
+	// Category keeps only todos assigned to the given category
+	Category CategoryID
+}
+
+// Matches reports whether the todo satisfies every set criterion
+func (f TodoFilter) Matches(todo *Todo) bool {
+	if f.Status != "" && todo.GetStatus() != f.Status {
+		return false
+	}
+	if f.Priority != "" && todo.GetPriority() != f.Priority {
+		return false
+	}
+	if f.OverdueAt != nil && !todo.IsOverdueAt(*f.OverdueAt) {
+		return false
+	}
+	if f.Tag != "" && !todo.HasTag(f.Tag) {
+		return false
+	}
+	if f.Source != "" && todo.GetSource() != f.Source {
+		return false
+	}
+	if f.CreatedBy != "" && todo.GetCreatedBy() != f.CreatedBy {
+		return false
+	}
+	if f.Category != "" && todo.GetCategoryID() != f.Category {
+		return false
+	}
+	if f.Search != "" {
+		search := strings.ToLower(f.Search)
+		if !strings.Contains(strings.ToLower(todo.GetTitle()), search) &&
+			!strings.Contains(strings.ToLower(todo.GetDescription()), search) {
+			return false
+		}
+	}
+	return true
+}
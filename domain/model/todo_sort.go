@@ -0,0 +1,76 @@
+package model
+
+import "strings"
+
+// TodoSortField names a field todos can be ordered by
+type TodoSortField string
+
+const (
+	SortByCreatedAt TodoSortField = "created_at"
+	SortByUpdatedAt TodoSortField = "updated_at"
+	SortByPriority  TodoSortField = "priority"
+	SortByTitle     TodoSortField = "title"
+)
+
+// TodoSort orders a set of todos; the zero value orders by creation time, oldest first
+type TodoSort struct {
+	Field      TodoSortField
+	Descending bool
+}
+
+// priorityRanks orders priorities by urgency rather than alphabetically
+var priorityRanks = map[TodoPriority]int{
+	TodoPriorityLow:    1,
+	TodoPriorityMedium: 2,
+	TodoPriorityHigh:   3,
+}
+
+// Rank returns the priority's urgency, higher being more urgent; unknown priorities rank 0
+func (p TodoPriority) Rank() int {
+	return priorityRanks[p]
+}
+
+// ParseTodoSort validates a sort field and order ("asc" or "desc"); empty values select the defaults
+func ParseTodoSort(field, order string) (TodoSort, *DomainError) {
+	parsed := TodoSort{Field: TodoSortField(field)}
+	switch parsed.Field {
+	case "", SortByCreatedAt, SortByUpdatedAt, SortByPriority, SortByTitle:
+	default:
+		return TodoSort{}, ErrInvalidQueryParam.WithDetails(map[string]string{"param": "sort_by", "value": field})
+	}
+
+	switch order {
+	case "", "asc":
+	case "desc":
+		parsed.Descending = true
+	default:
+		return TodoSort{}, ErrInvalidQueryParam.WithDetails(map[string]string{"param": "sort_order", "value": order})
+	}
+	return parsed, nil
+}
+
+// Less reports whether a sorts before b, breaking ties by creation time and then ID
+func (s TodoSort) Less(a, b *Todo) bool {
+	var cmp int
+	switch s.Field {
+	case SortByUpdatedAt:
+		cmp = a.GetUpdatedAt().Compare(b.GetUpdatedAt())
+	case SortByPriority:
+		cmp = a.GetPriority().Rank() - b.GetPriority().Rank()
+	case SortByTitle:
+		cmp = strings.Compare(a.GetTitle(), b.GetTitle())
+	default:
+		cmp = a.GetCreatedAt().Compare(b.GetCreatedAt())
+	}
+	if s.Descending {
+		cmp = -cmp
+	}
+	if cmp != 0 {
+		return cmp < 0
+	}
+
+	if c := a.GetCreatedAt().Compare(b.GetCreatedAt()); c != 0 {
+		return c < 0
+	}
+	return a.GetID() < b.GetID()
+}
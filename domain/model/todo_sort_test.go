@@ -0,0 +1,53 @@
+package model
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTodoSort(t *testing.T) {
+	parsed, err := ParseTodoSort("priority", "desc")
+	assert.Nil(t, err)
+	assert.Equal(t, TodoSort{Field: SortByPriority, Descending: true}, parsed)
+
+	parsed, err = ParseTodoSort("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, TodoSort{}, parsed)
+
+	_, err = ParseTodoSort("id", "")
+	assert.Equal(t, ErrInvalidQueryParam.GetErrorCode(), err.GetErrorCode())
+
+	_, err = ParseTodoSort("title", "ascending")
+	assert.Equal(t, ErrInvalidQueryParam.GetErrorCode(), err.GetErrorCode())
+}
+
+func TestTodoSort_PriorityOrdersByUrgency(t *testing.T) {
+	now := time.Now()
+	todos := []*Todo{
+		NewTodoFromData("a", "A", "", TodoStatusPending, TodoPriorityMedium, now, now, nil, "", "", nil, nil, ""),
+		NewTodoFromData("b", "B", "", TodoStatusPending, TodoPriorityHigh, now, now, nil, "", "", nil, nil, ""),
+		NewTodoFromData("c", "C", "", TodoStatusPending, TodoPriorityLow, now, now, nil, "", "", nil, nil, ""),
+	}
+
+	order := TodoSort{Field: SortByPriority}
+	sort.Slice(todos, func(i, j int) bool { return order.Less(todos[i], todos[j]) })
+	assert.Equal(t, []TodoID{"c", "a", "b"}, []TodoID{todos[0].GetID(), todos[1].GetID(), todos[2].GetID()})
+
+	order.Descending = true
+	sort.Slice(todos, func(i, j int) bool { return order.Less(todos[i], todos[j]) })
+	assert.Equal(t, []TodoID{"b", "a", "c"}, []TodoID{todos[0].GetID(), todos[1].GetID(), todos[2].GetID()})
+}
+
+func TestTodoSort_BreaksTiesByCreationThenID(t *testing.T) {
+	now := time.Now()
+	later := NewTodoFromData("a", "Same", "", TodoStatusPending, TodoPriorityLow, now.Add(time.Minute), now, nil, "", "", nil, nil, "")
+	earlier := NewTodoFromData("z", "Same", "", TodoStatusPending, TodoPriorityLow, now, now, nil, "", "", nil, nil, "")
+	sibling := NewTodoFromData("b", "Same", "", TodoStatusPending, TodoPriorityLow, now.Add(time.Minute), now, nil, "", "", nil, nil, "")
+
+	order := TodoSort{Field: SortByTitle, Descending: true}
+	assert.True(t, order.Less(earlier, later))
+	assert.True(t, order.Less(later, sibling))
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// CompletionTimeStat aggregates how long completed todos of one priority took
+// from creation to completion
+type CompletionTimeStat struct {
+	Priority        TodoPriority
+	Completed       int
+	AverageDuration time.Duration
+}
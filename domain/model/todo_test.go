@@ -1,6 +1,8 @@
 package model
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -33,35 +35,39 @@ func TestMarkAsCompleted(t *testing.T) {
 
 func TestUpdateTitle(t *testing.T) {
 	todo := NewSimpleTodo("Old Title")
-	err := todo.UpdateTitle("New Title")
+	changed, err := todo.UpdateTitle("New Title", DefaultTodoLimits())
 	assert.NoError(t, err)
+	assert.True(t, changed)
 	assert.Equal(t, "New Title", todo.GetTitle())
 
-	err = todo.UpdateTitle("")
+	changed, err = todo.UpdateTitle("", DefaultTodoLimits())
 	assert.Error(t, err)
+	assert.False(t, changed)
 }
 
 func TestUpdateDescription(t *testing.T) {
 	todo := NewSimpleTodo("Desc Test")
-	err := todo.UpdateDescription("New Description")
+	changed, err := todo.UpdateDescription("New Description", DefaultTodoLimits())
 	assert.NoError(t, err)
+	assert.True(t, changed)
 	assert.Equal(t, "New Description", todo.GetDescription())
 
 	longDesc := make([]byte, 1001)
 	for i := range longDesc {
 		longDesc[i] = 'a'
 	}
-	err = todo.UpdateDescription(string(longDesc))
+	_, err = todo.UpdateDescription(string(longDesc), DefaultTodoLimits())
 	assert.Error(t, err)
 }
 
 func TestUpdatePriority(t *testing.T) {
 	todo := NewSimpleTodo("Priority Test")
-	err := todo.UpdatePriority(TodoPriorityHigh)
+	changed, err := todo.UpdatePriority(TodoPriorityHigh)
 	assert.NoError(t, err)
+	assert.True(t, changed)
 	assert.Equal(t, TodoPriorityHigh, todo.GetPriority())
 
-	err = todo.UpdatePriority("invalid")
+	_, err = todo.UpdatePriority("invalid")
 	assert.Error(t, err)
 }
 
@@ -74,3 +80,222 @@ func TestArchiveTodo(t *testing.T) {
 	err = todo.ArchiveTodo()
 	assert.Error(t, err)
 }
+
+func TestNormalizeTitle(t *testing.T) {
+	assert.Equal(t, "Buy milk", NormalizeTitle("  Buy   milk  "))
+	assert.Equal(t, "Buy milk today", NormalizeTitle("Buy\tmilk\n today"))
+	assert.Equal(t, "", NormalizeTitle("   "))
+}
+
+func TestUncomplete(t *testing.T) {
+	todo := NewSimpleTodo("Uncomplete Me")
+	assert.NoError(t, todo.MarkAsCompleted())
+
+	err := todo.Uncomplete()
+	assert.NoError(t, err)
+	assert.Equal(t, TodoStatusPending, todo.GetStatus())
+	assert.Nil(t, todo.GetCompletedAt())
+
+	// A pending todo cannot be uncompleted
+	err = todo.Uncomplete()
+	assert.Error(t, err)
+}
+
+func TestMarkAsPending(t *testing.T) {
+	todo := NewSimpleTodo("Reopen Me")
+	assert.Error(t, todo.MarkAsPending(), "a pending todo is already open")
+
+	assert.NoError(t, todo.MarkAsCompleted())
+	assert.NoError(t, todo.MarkAsPending())
+	assert.Equal(t, TodoStatusPending, todo.GetStatus())
+	assert.Nil(t, todo.GetCompletedAt())
+
+	assert.NoError(t, todo.ArchiveTodo())
+	assert.Error(t, todo.MarkAsPending())
+	assert.Equal(t, TodoStatusArchived, todo.GetStatus())
+}
+
+func TestRepairCompletedAt(t *testing.T) {
+	updatedAt := time.Now().Add(-time.Hour)
+	todo := NewTodoFromData("broken", "Broken", "", TodoStatusCompleted, TodoPriorityLow, updatedAt, updatedAt, nil, "", "", nil, nil, "")
+
+	assert.True(t, todo.RepairCompletedAt())
+	assert.Equal(t, updatedAt, *todo.GetCompletedAt())
+	assert.False(t, todo.RepairCompletedAt(), "an already consistent todo is left alone")
+
+	pending := NewSimpleTodo("Pending")
+	assert.False(t, pending.RepairCompletedAt())
+	assert.Nil(t, pending.GetCompletedAt())
+}
+
+func TestUnarchiveTodo(t *testing.T) {
+	todo := NewSimpleTodo("Shelve Me")
+	assert.Error(t, todo.UnarchiveTodo(), "a pending todo is not archived")
+
+	assert.NoError(t, todo.MarkAsCompleted())
+	assert.NoError(t, todo.ArchiveTodo())
+	before := todo.GetUpdatedAt()
+
+	assert.NoError(t, todo.UnarchiveTodo())
+	assert.Equal(t, TodoStatusPending, todo.GetStatus())
+	assert.Nil(t, todo.GetCompletedAt())
+	assert.False(t, todo.GetUpdatedAt().Before(before))
+}
+
+func TestAssignCategory(t *testing.T) {
+	todo := NewSimpleTodo("File Me")
+	assert.Empty(t, todo.GetCategoryID())
+
+	assert.NoError(t, todo.AssignCategory("cat-1"))
+	assert.Equal(t, CategoryID("cat-1"), todo.GetCategoryID())
+
+	assert.Error(t, todo.AssignCategory(""))
+	assert.Equal(t, CategoryID("cat-1"), todo.GetCategoryID())
+}
+
+func TestSetDueDate(t *testing.T) {
+	todo := NewSimpleTodo("Plan")
+	assert.Nil(t, todo.GetDueDate())
+
+	assert.Error(t, todo.SetDueDate(time.Now().Add(-time.Hour)))
+	assert.Nil(t, todo.GetDueDate())
+
+	due := time.Now().Add(time.Hour)
+	assert.NoError(t, todo.SetDueDate(due))
+	assert.Equal(t, due, *todo.GetDueDate())
+	assert.False(t, todo.IsOverdue())
+}
+
+func TestIsOverdue(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+
+	overdue := NewTodoFromData("id-1", "Late", "", TodoStatusPending, TodoPriorityLow, past, past, nil, "", "", &past, nil, "")
+	assert.True(t, overdue.IsOverdue())
+	assert.False(t, overdue.IsOverdueAt(past.Add(-time.Minute)))
+
+	// Completing an overdue todo still succeeds and it is no longer overdue
+	assert.NoError(t, overdue.MarkAsCompleted())
+	assert.False(t, overdue.IsOverdue())
+
+	noDueDate := NewTodoFromData("id-2", "Someday", "", TodoStatusPending, TodoPriorityLow, past, past, nil, "", "", nil, nil, "")
+	assert.False(t, noDueDate.IsOverdue())
+}
+
+func TestTags(t *testing.T) {
+	todo := NewSimpleTodo("Tag Me")
+	assert.Equal(t, []string{}, todo.GetTags())
+
+	assert.NoError(t, todo.AddTag("work"))
+	assert.NoError(t, todo.AddTag("urgent"))
+	assert.Error(t, todo.AddTag("work"))
+	assert.Error(t, todo.AddTag(""))
+	assert.Error(t, todo.AddTag(strings.Repeat("x", MaxTagLength+1)))
+	assert.Equal(t, []string{"work", "urgent"}, todo.GetTags())
+	assert.True(t, todo.HasTag("work"))
+
+	assert.NoError(t, todo.RemoveTag("work"))
+	assert.Error(t, todo.RemoveTag("work"))
+	assert.Equal(t, []string{"urgent"}, todo.GetTags())
+
+	// The returned slice is a copy
+	todo.GetTags()[0] = "changed"
+	assert.Equal(t, []string{"urgent"}, todo.GetTags())
+}
+
+func TestAddTagLimit(t *testing.T) {
+	todo := NewSimpleTodo("Busy")
+	for i := 0; i < MaxTags; i++ {
+		assert.NoError(t, todo.AddTag(fmt.Sprintf("tag-%d", i)))
+	}
+	assert.Error(t, todo.AddTag("one-too-many"))
+	assert.Len(t, todo.GetTags(), MaxTags)
+}
+
+func TestDependencies(t *testing.T) {
+	design, build := NewSimpleTodo("Design"), NewSimpleTodo("Build")
+	assert.Equal(t, []TodoID{}, build.GetDependsOn())
+
+	assert.NoError(t, build.AddDependency(design))
+	assert.Error(t, build.AddDependency(design))
+	assert.Equal(t, []TodoID{design.GetID()}, build.GetDependsOn())
+	assert.True(t, build.DependsOn(design.GetID()))
+
+	assert.NoError(t, build.RemoveDependency(design.GetID()))
+	assert.Error(t, build.RemoveDependency(design.GetID()))
+	assert.Empty(t, build.GetDependsOn())
+}
+
+func TestAddDependencyRejectsCycles(t *testing.T) {
+	design, build := NewSimpleTodo("Design"), NewSimpleTodo("Build")
+
+	assert.EqualError(t, design.AddDependency(design), "todo cannot depend on itself")
+
+	assert.NoError(t, build.AddDependency(design))
+	assert.ErrorContains(t, design.AddDependency(build), "already depends on this todo")
+	assert.Empty(t, design.GetDependsOn())
+}
+
+func TestIsActionable(t *testing.T) {
+	design, review, build := NewSimpleTodo("Design"), NewSimpleTodo("Review"), NewSimpleTodo("Build")
+	assert.NoError(t, build.AddDependency(design))
+	assert.NoError(t, build.AddDependency(review))
+
+	assert.True(t, design.IsActionable(nil))
+	assert.False(t, build.IsActionable([]*Todo{design, review}))
+
+	assert.NoError(t, design.MarkAsCompleted())
+	assert.False(t, build.IsActionable([]*Todo{design, review}))
+
+	assert.NoError(t, review.MarkAsCompleted())
+	assert.True(t, build.IsActionable([]*Todo{design, review}))
+
+	// A deleted dependency no longer blocks
+	assert.True(t, build.IsActionable([]*Todo{design}))
+
+	// Only pending todos are actionable
+	assert.False(t, design.IsActionable(nil))
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Now()
+
+	assert.NoError(t, NewTodo("Valid", "", TodoPriorityLow).Validate(DefaultTodoLimits()))
+
+	completedWithoutTime := NewTodoFromData("id-1", "Done", "", TodoStatusCompleted, TodoPriorityLow, now, now, nil, "", "", nil, nil, "")
+	assert.EqualError(t, completedWithoutTime.Validate(DefaultTodoLimits()), "completed todo must have a completion time")
+
+	invalidStatus := NewTodoFromData("id-2", "Odd", "", TodoStatus("paused"), TodoPriorityLow, now, now, nil, "", "", nil, nil, "")
+	assert.ErrorContains(t, invalidStatus.Validate(DefaultTodoLimits()), "invalid status")
+
+	invalidPriority := NewTodoFromData("id-3", "Odd", "", TodoStatusPending, TodoPriority("urgent"), now, now, nil, "", "", nil, nil, "")
+	assert.ErrorContains(t, invalidPriority.Validate(DefaultTodoLimits()), "invalid priority")
+
+	emptyTitle := NewTodoFromData("id-4", "", "", TodoStatusPending, TodoPriorityLow, now, now, nil, "", "", nil, nil, "")
+	assert.Error(t, emptyTitle.Validate(DefaultTodoLimits()))
+
+	selfDependent := NewTodoFromData("id-5", "Loop", "", TodoStatusPending, TodoPriorityLow, now, now, nil, "", "", nil, nil, "")
+	selfDependent.RestoreDependencies([]TodoID{"id-5"})
+	assert.EqualError(t, selfDependent.Validate(DefaultTodoLimits()), "todo cannot depend on itself")
+}
+
+func TestUpdateWithSameValueLeavesUpdatedAtUnchanged(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	todo := NewTodoFromData("id-1", "Title", "Description", TodoStatusPending, TodoPriorityMedium, past, past, nil, "", "", nil, nil, "")
+
+	for _, update := range []func() (bool, error){
+		func() (bool, error) { return todo.UpdateTitle("Title", DefaultTodoLimits()) },
+		func() (bool, error) { return todo.UpdateDescription("Description", DefaultTodoLimits()) },
+		func() (bool, error) { return todo.UpdatePriority(TodoPriorityMedium) },
+	} {
+		changed, err := update()
+		assert.NoError(t, err)
+		assert.False(t, changed)
+	}
+	assert.Equal(t, past, todo.GetUpdatedAt())
+
+	changed, err := todo.UpdatePriority(TodoPriorityHigh)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.True(t, todo.GetUpdatedAt().After(past))
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"errors"
+	"net/mail"
 	"time"
 
 	"github.com/google/uuid"
@@ -59,6 +60,22 @@ func NewUser(email string, username string, firstName string, lastName string) *
 	}
 }
 
+// NewUserFromData reconstructs a User object from persistent data
+func NewUserFromData(id UserID, email, username, firstName, lastName string, role UserRole, status UserStatus, createdAt, updatedAt time.Time, lastLoginAt *time.Time) *User {
+	return &User{
+		id:          id,
+		email:       email,
+		username:    username,
+		firstName:   firstName,
+		lastName:    lastName,
+		role:        role,
+		status:      status,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+		lastLoginAt: lastLoginAt,
+	}
+}
+
 // NewAdminUser creates a new admin user
 func NewAdminUser(email string, username string, firstName string, lastName string) *User {
 	user := NewUser(email, username, firstName, lastName)
@@ -124,6 +141,13 @@ func (u *User) IsSuspended() bool {
 	return u.status == UserStatusSuspended
 }
 
+// IsValidEmail reports whether email is a bare address such as "ada@example.com".
+// Display-name forms like "Ada <ada@example.com>" are rejected.
+func IsValidEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email
+}
+
 // Domain behaviors
 func (u *User) UpdateProfile(firstName string, lastName string) error {
 	if firstName == "" || lastName == "" {
@@ -140,7 +164,9 @@ func (u *User) UpdateEmail(newEmail string) error {
 	if newEmail == "" {
 		return errors.New("email cannot be empty")
 	}
-	// Add email validation logic here if needed
+	if !IsValidEmail(newEmail) {
+		return errors.New("email is malformed")
+	}
 
 	u.email = newEmail
 	u.updatedAt = time.Now()
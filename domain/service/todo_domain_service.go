@@ -1,7 +1,10 @@
 package service
 
 import (
+	"slices"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/mr3iscuit/ddd-golang/application/port"
 	"github.com/mr3iscuit/ddd-golang/domain/model"
@@ -9,31 +12,63 @@ import (
 
 // TodoDomainService handles domain-specific business logic for todos
 // Implements port.TodoDomainServicePort
-type TodoDomainService struct{}
+type TodoDomainService struct {
+	allowEmptyTitle bool
+	limits          model.TodoLimits
+}
+
+// TodoDomainServiceOption configures optional validation policies of a TodoDomainService
+type TodoDomainServiceOption func(*TodoDomainService)
+
+// WithAllowEmptyTitle permits empty titles, for quick-capture of draft todos
+func WithAllowEmptyTitle(allow bool) TodoDomainServiceOption {
+	return func(s *TodoDomainService) {
+		s.allowEmptyTitle = allow
+	}
+}
+
+// WithTodoLimits sets the title and description length limits, which default to model.DefaultTodoLimits
+func WithTodoLimits(limits model.TodoLimits) TodoDomainServiceOption {
+	return func(s *TodoDomainService) {
+		s.limits = limits
+	}
+}
 
 // Ensure TodoDomainService implements TodoDomainServicePort
 var _ port.TodoDomainServicePort = (*TodoDomainService)(nil)
 
 // NewTodoDomainService creates a new todo domain service
-func NewTodoDomainService() *TodoDomainService {
-	return &TodoDomainService{}
+func NewTodoDomainService(opts ...TodoDomainServiceOption) *TodoDomainService {
+	s := &TodoDomainService{limits: model.DefaultTodoLimits()}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Limits returns the length limits the service validates against, for the aggregate to enforce too
+func (s *TodoDomainService) Limits() model.TodoLimits {
+	return s.limits
 }
 
 // ValidateTitle validates a todo title
 func (s *TodoDomainService) ValidateTitle(title string) *model.DomainError {
 	if strings.TrimSpace(title) == "" {
+		if s.allowEmptyTitle {
+			return nil
+		}
 		return model.ErrEmptyTitle
 	}
-	if len(title) > 100 {
-		return model.ErrTitleTooLong
+	if maxLength := s.limits.MaxTitleLength; len(title) > maxLength {
+		return model.ErrTitleTooLong.WithDetails(map[string]string{"max_length": strconv.Itoa(maxLength)})
 	}
 	return nil
 }
 
 // ValidateDescription validates a todo description
 func (s *TodoDomainService) ValidateDescription(description string) *model.DomainError {
-	if len(description) > 1000 {
-		return model.ErrInvalidDescription
+	if maxLength := s.limits.MaxDescriptionLength; len(description) > maxLength {
+		return model.ErrInvalidDescription.WithDetails(map[string]string{"max_length": strconv.Itoa(maxLength)})
 	}
 	return nil
 }
@@ -48,6 +83,16 @@ func (s *TodoDomainService) ValidatePriority(priority string) *model.DomainError
 	}
 }
 
+// ValidateStatus validates a todo status
+func (s *TodoDomainService) ValidateStatus(status string) *model.DomainError {
+	switch model.TodoStatus(status) {
+	case model.TodoStatusPending, model.TodoStatusCompleted, model.TodoStatusArchived:
+		return nil
+	default:
+		return model.ErrInvalidStatus
+	}
+}
+
 // ValidateCreateTodoCommand validates all fields for creating a todo
 func (s *TodoDomainService) ValidateCreateTodoCommand(title string, description string, priority string) *model.DomainError {
 	if err := s.ValidateTitle(title); err != nil {
@@ -81,3 +126,31 @@ func (s *TodoDomainService) ValidateUpdateTodoCommand(title string, description
 	}
 	return nil
 }
+
+// Title keywords that suggest a priority when none is given
+var (
+	highPriorityKeywords = []string{"urgent", "asap", "critical", "immediately"}
+	lowPriorityKeywords  = []string{"someday", "eventually", "maybe"}
+)
+
+// SuggestPriority infers a priority from keywords in the title, defaulting to medium
+func (s *TodoDomainService) SuggestPriority(title string) model.TodoPriority {
+	if strings.Contains(title, "!!!") {
+		return model.TodoPriorityHigh
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if slices.Contains(highPriorityKeywords, word) {
+			return model.TodoPriorityHigh
+		}
+	}
+	for _, word := range words {
+		if slices.Contains(lowPriorityKeywords, word) {
+			return model.TodoPriorityLow
+		}
+	}
+	return model.TodoPriorityMedium
+}
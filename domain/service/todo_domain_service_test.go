@@ -0,0 +1,59 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+func TestSuggestPriority(t *testing.T) {
+	s := NewTodoDomainService()
+
+	tests := []struct {
+		title string
+		want  model.TodoPriority
+	}{
+		{title: "URGENT: fix login", want: model.TodoPriorityHigh},
+		{title: "Send invoice asap", want: model.TodoPriorityHigh},
+		{title: "Call the bank!!!", want: model.TodoPriorityHigh},
+		{title: "Maybe learn the banjo", want: model.TodoPriorityLow},
+		{title: "Someday, urgent or not", want: model.TodoPriorityHigh},
+		{title: "Buy milk", want: model.TodoPriorityMedium},
+		{title: "Check the wasapp chat", want: model.TodoPriorityMedium},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, s.SuggestPriority(tt.title))
+		})
+	}
+}
+
+func TestValidateStatus(t *testing.T) {
+	s := NewTodoDomainService()
+
+	for _, status := range []string{"pending", "completed", "archived"} {
+		assert.Nil(t, s.ValidateStatus(status), status)
+	}
+	assert.Equal(t, model.ErrInvalidStatus, s.ValidateStatus("done"))
+	assert.Equal(t, model.ErrInvalidStatus, s.ValidateStatus("Pending"))
+}
+
+func TestValidateLengths_ConfiguredLimits(t *testing.T) {
+	s := NewTodoDomainService(WithTodoLimits(model.TodoLimits{MaxTitleLength: 5, MaxDescriptionLength: 10}))
+
+	assert.Nil(t, s.ValidateTitle("Short"))
+	err := s.ValidateTitle("Longer")
+	assert.Equal(t, model.ErrTitleTooLong.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, "5", err.GetDetails()["max_length"])
+
+	assert.Nil(t, s.ValidateDescription(strings.Repeat("a", 10)))
+	err = s.ValidateDescription(strings.Repeat("a", 11))
+	assert.Equal(t, model.ErrInvalidDescription.GetErrorCode(), err.GetErrorCode())
+	assert.Equal(t, "10", err.GetDetails()["max_length"])
+
+	assert.Equal(t, model.DefaultTodoLimits(), NewTodoDomainService().Limits())
+}
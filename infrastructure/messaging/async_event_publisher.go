@@ -0,0 +1,126 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"sync"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/event"
+)
+
+var (
+	// ErrEventQueueFull is returned when an event is dropped under the drop policy
+	ErrEventQueueFull = errors.New("event queue is full")
+	// ErrPublisherClosed is returned when publishing after Close
+	ErrPublisherClosed = errors.New("event publisher is closed")
+)
+
+// queuedEvent is an event waiting for dispatch with the context it was published under
+type queuedEvent struct {
+	ctx   context.Context
+	event interface{}
+}
+
+// AsyncEventPublisher implements port.EventPublisherPort by queueing events on
+// bounded buffers that worker goroutines dispatch to an inner publisher, so slow
+// subscribers do not delay the caller. Each worker drains its own queue and the
+// events of one todo always share a queue, so they are delivered in the order
+// they were published.
+type AsyncEventPublisher struct {
+	inner        port.EventPublisherPort
+	queues       []chan queuedEvent
+	dropWhenFull bool
+	wg           sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+var _ port.EventPublisherPort = (*AsyncEventPublisher)(nil)
+
+// NewAsyncEventPublisher starts workers dispatching queued events to inner,
+// splitting bufferSize between their queues. When a queue is full, Publish
+// blocks until there is room, or returns ErrEventQueueFull immediately if
+// dropWhenFull is set.
+func NewAsyncEventPublisher(inner port.EventPublisherPort, bufferSize, workers int, dropWhenFull bool) *AsyncEventPublisher {
+	if workers < 1 {
+		workers = 1
+	}
+	p := &AsyncEventPublisher{
+		inner:        inner,
+		queues:       make([]chan queuedEvent, workers),
+		dropWhenFull: dropWhenFull,
+	}
+	p.wg.Add(workers)
+	for i := range p.queues {
+		p.queues[i] = make(chan queuedEvent, (bufferSize+workers-1)/workers)
+		go p.work(p.queues[i])
+	}
+	return p
+}
+
+// queueFor returns the queue of the worker dispatching e. Events about the same
+// todo hash to the same queue; events about no todo all go to the first.
+func (p *AsyncEventPublisher) queueFor(e interface{}) chan queuedEvent {
+	todoEvent, ok := e.(event.TodoEvent)
+	if !ok {
+		return p.queues[0]
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(todoEvent.AggregateID()))
+	return p.queues[hash.Sum32()%uint32(len(p.queues))]
+}
+
+// work dispatches the events of queue until it is closed and drained
+func (p *AsyncEventPublisher) work(queue chan queuedEvent) {
+	defer p.wg.Done()
+	for queued := range queue {
+		if err := p.inner.Publish(queued.ctx, queued.event); err != nil {
+			slog.Warn("event handler failed", "event", fmt.Sprintf("%T", queued.event), "error", err)
+		}
+	}
+}
+
+// Publish queues the event for dispatch. Handlers run after the caller has
+// moved on, so they receive ctx's values but not its cancellation.
+func (p *AsyncEventPublisher) Publish(ctx context.Context, event interface{}) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return ErrPublisherClosed
+	}
+
+	queue := p.queueFor(event)
+	queued := queuedEvent{ctx: context.WithoutCancel(ctx), event: event}
+	if p.dropWhenFull {
+		select {
+		case queue <- queued:
+			return nil
+		default:
+			return ErrEventQueueFull
+		}
+	}
+	queue <- queued
+	return nil
+}
+
+// Close stops accepting events and waits for every queued event to be dispatched
+func (p *AsyncEventPublisher) Close() error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+	p.closed = true
+	for _, queue := range p.queues {
+		close(queue)
+	}
+	p.mu.Unlock()
+
+	p.wg.Wait()
+	return nil
+}
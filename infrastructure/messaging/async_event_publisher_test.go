@@ -0,0 +1,151 @@
+package messaging
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mr3iscuit/ddd-golang/domain/event"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+func TestInMemoryEventPublisher_DispatchesSynchronously(t *testing.T) {
+	publisher := NewInMemoryEventPublisher()
+	var received []interface{}
+	publisher.Subscribe(func(_ context.Context, event interface{}) error {
+		received = append(received, event)
+		return nil
+	})
+
+	require.NoError(t, publisher.Publish(context.Background(), "created"))
+	assert.Equal(t, []interface{}{"created"}, received)
+}
+
+type contextKey struct{}
+
+func TestAsyncEventPublisher_DetachesCancellation(t *testing.T) {
+	inner := NewInMemoryEventPublisher()
+	received := make(chan context.Context, 1)
+	inner.Subscribe(func(ctx context.Context, event interface{}) error {
+		received <- ctx
+		return nil
+	})
+
+	publisher := NewAsyncEventPublisher(inner, 1, 1, false)
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), contextKey{}, "request-1"))
+	require.NoError(t, publisher.Publish(ctx, "created"))
+	cancel()
+	require.NoError(t, publisher.Close())
+
+	handled := <-received
+	assert.NoError(t, handled.Err())
+	assert.Equal(t, "request-1", handled.Value(contextKey{}))
+}
+
+func TestAsyncEventPublisher_CloseDrainsQueue(t *testing.T) {
+	inner := NewInMemoryEventPublisher()
+	var mu sync.Mutex
+	received := 0
+	inner.Subscribe(func(_ context.Context, event interface{}) error {
+		mu.Lock()
+		defer mu.Unlock()
+		received++
+		return nil
+	})
+
+	publisher := NewAsyncEventPublisher(inner, 10, 2, false)
+	for i := 0; i < 50; i++ {
+		require.NoError(t, publisher.Publish(context.Background(), i))
+	}
+	require.NoError(t, publisher.Close())
+
+	assert.Equal(t, 50, received)
+	assert.ErrorIs(t, publisher.Publish(context.Background(), "late"), ErrPublisherClosed)
+}
+
+func TestAsyncEventPublisher_KeepsEachTodosEventsInOrder(t *testing.T) {
+	inner := NewInMemoryEventPublisher()
+	var mu sync.Mutex
+	received := map[model.TodoID][]string{}
+	inner.Subscribe(func(_ context.Context, e interface{}) error {
+		updated := e.(*event.TodoUpdatedEvent)
+		// Uneven handler times would reorder events shared between workers
+		time.Sleep(time.Duration(len(updated.Title)%3) * time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		received[updated.TodoID] = append(received[updated.TodoID], updated.Title)
+		return nil
+	})
+
+	publisher := NewAsyncEventPublisher(inner, 16, 4, false)
+	want := map[model.TodoID][]string{}
+	for i := 0; i < 20; i++ {
+		for _, id := range []model.TodoID{"a", "b", "c", "d", "e"} {
+			title := strings.Repeat("x", i)
+			want[id] = append(want[id], title)
+			require.NoError(t, publisher.Publish(context.Background(), event.NewTodoUpdatedEvent(id, title)))
+		}
+	}
+	require.NoError(t, publisher.Close())
+
+	assert.Equal(t, want, received)
+}
+
+func TestAsyncEventPublisher_DropsWhenFull(t *testing.T) {
+	inner := NewInMemoryEventPublisher()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	inner.Subscribe(func(_ context.Context, event interface{}) error {
+		if event == "first" {
+			close(started)
+			<-release
+		}
+		return nil
+	})
+
+	publisher := NewAsyncEventPublisher(inner, 1, 1, true)
+	require.NoError(t, publisher.Publish(context.Background(), "first"))
+	<-started // the only worker is now busy
+
+	require.NoError(t, publisher.Publish(context.Background(), "buffered"))
+	assert.ErrorIs(t, publisher.Publish(context.Background(), "dropped"), ErrEventQueueFull)
+
+	close(release)
+	require.NoError(t, publisher.Close())
+}
+
+func TestAsyncEventPublisher_BlocksWhenFull(t *testing.T) {
+	inner := NewInMemoryEventPublisher()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	inner.Subscribe(func(_ context.Context, event interface{}) error {
+		if event == "first" {
+			close(started)
+			<-release
+		}
+		return nil
+	})
+
+	publisher := NewAsyncEventPublisher(inner, 1, 1, false)
+	require.NoError(t, publisher.Publish(context.Background(), "first"))
+	<-started
+	require.NoError(t, publisher.Publish(context.Background(), "buffered"))
+
+	published := make(chan error)
+	go func() { published <- publisher.Publish(context.Background(), "waiting") }()
+
+	select {
+	case <-published:
+		t.Fatal("Publish returned while the queue was full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	assert.NoError(t, <-published)
+	require.NoError(t, publisher.Close())
+}
@@ -0,0 +1,49 @@
+package messaging
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+)
+
+// EventHandler handles a published domain event
+type EventHandler func(ctx context.Context, event interface{}) error
+
+// InMemoryEventPublisher implements port.EventPublisherPort by dispatching
+// events synchronously to every registered subscriber
+type InMemoryEventPublisher struct {
+	mu       sync.RWMutex
+	handlers []EventHandler
+}
+
+var _ port.EventPublisherPort = (*InMemoryEventPublisher)(nil)
+
+// NewInMemoryEventPublisher creates a new InMemoryEventPublisher
+func NewInMemoryEventPublisher() *InMemoryEventPublisher {
+	return &InMemoryEventPublisher{}
+}
+
+// Subscribe registers a handler that receives every published event
+func (p *InMemoryEventPublisher) Subscribe(handler EventHandler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handlers = append(p.handlers, handler)
+}
+
+// Publish dispatches the event to all subscribers in registration order,
+// returning the first handler error after every handler has run
+func (p *InMemoryEventPublisher) Publish(ctx context.Context, event interface{}) error {
+	p.mu.RLock()
+	handlers := make([]EventHandler, len(p.handlers))
+	copy(handlers, p.handlers)
+	p.mu.RUnlock()
+
+	var firstErr error
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
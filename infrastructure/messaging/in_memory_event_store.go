@@ -0,0 +1,45 @@
+package messaging
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+)
+
+// InMemoryEventStore implements port.EventStorePort by keeping every appended event in memory.
+// Subscribe its Append method to an InMemoryEventPublisher to record published events.
+type InMemoryEventStore struct {
+	mu     sync.RWMutex
+	events []interface{}
+}
+
+var _ port.EventStorePort = (*InMemoryEventStore)(nil)
+
+// NewInMemoryEventStore creates a new InMemoryEventStore
+func NewInMemoryEventStore() *InMemoryEventStore {
+	return &InMemoryEventStore{}
+}
+
+// Append records the event at the end of the log
+func (s *InMemoryEventStore) Append(ctx context.Context, event interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+// LoadAll returns a copy of every stored event in append order
+func (s *InMemoryEventStore) LoadAll(ctx context.Context) ([]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	events := make([]interface{}, len(s.events))
+	copy(events, s.events)
+	return events, nil
+}
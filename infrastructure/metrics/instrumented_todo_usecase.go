@@ -0,0 +1,265 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/application/command"
+	appmodel "github.com/mr3iscuit/ddd-golang/application/model"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/application/query"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// Use case operation names used as metric labels
+const (
+	UseCaseCreate              = "create_todo"
+	UseCaseUpdate              = "update_todo"
+	UseCaseComplete            = "complete_todo"
+	UseCaseUncomplete          = "uncomplete_todo"
+	UseCaseUncompleteBatch     = "uncomplete_batch"
+	UseCaseBulkComplete        = "bulk_complete_todos"
+	UseCaseReopen              = "reopen_todo"
+	UseCaseArchive             = "archive_todo"
+	UseCaseUnarchive           = "unarchive_todo"
+	UseCaseGet                 = "get_todo"
+	UseCaseGetRandom           = "get_random_todo"
+	UseCaseList                = "list_todos"
+	UseCaseCount               = "count_todos"
+	UseCaseListStale           = "list_stale_todos"
+	UseCaseSearch              = "search_todos"
+	UseCaseListByPriority      = "list_todos_by_priority"
+	UseCaseDelete              = "delete_todo"
+	UseCaseDeleteBatch         = "delete_todos"
+	UseCaseDashboard           = "get_dashboard"
+	UseCaseCompletionTimeStats = "completion_time_stats"
+	UseCaseSnapshot            = "snapshot"
+	UseCaseRestoreSnapshot     = "restore_snapshot"
+	UseCaseValidateField       = "validate_field"
+	UseCaseTestError           = "test_error"
+	UseCaseReadiness           = "readiness"
+)
+
+// InstrumentedTodoUseCase decorates a port.TodoUseCasePort, counting every
+// invocation and recording its latency labeled by operation and outcome;
+// failures are further labeled with their domain error code
+type InstrumentedTodoUseCase struct {
+	inner    port.TodoUseCasePort
+	registry *Registry
+}
+
+var _ port.TodoUseCasePort = (*InstrumentedTodoUseCase)(nil)
+
+// NewInstrumentedTodoUseCase wraps a use case with metrics recording
+func NewInstrumentedTodoUseCase(inner port.TodoUseCasePort, registry *Registry) *InstrumentedTodoUseCase {
+	return &InstrumentedTodoUseCase{inner: inner, registry: registry}
+}
+
+// record reports an invocation that started at the given time
+func (uc *InstrumentedTodoUseCase) record(operation string, start time.Time, err *model.DomainError) {
+	if err != nil {
+		uc.registry.RecordUseCase(operation, OutcomeFailure, err.GetErrorCode(), time.Since(start))
+		return
+	}
+	uc.registry.RecordUseCase(operation, OutcomeSuccess, 0, time.Since(start))
+}
+
+// CreateTodoUseCase creates a new Todo
+func (uc *InstrumentedTodoUseCase) CreateTodoUseCase(ctx context.Context, cmd command.CreateTodoCommand) (model.TodoID, *model.DomainError) {
+	start := time.Now()
+	id, err := uc.inner.CreateTodoUseCase(ctx, cmd)
+	uc.record(UseCaseCreate, start, err)
+	return id, err
+}
+
+// UpdateTodoUseCase updates an existing Todo
+func (uc *InstrumentedTodoUseCase) UpdateTodoUseCase(ctx context.Context, cmd command.UpdateTodoCommand) *model.DomainError {
+	start := time.Now()
+	err := uc.inner.UpdateTodoUseCase(ctx, cmd)
+	uc.record(UseCaseUpdate, start, err)
+	return err
+}
+
+// CompleteTodoUseCase marks a Todo as completed
+func (uc *InstrumentedTodoUseCase) CompleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	start := time.Now()
+	err := uc.inner.CompleteTodoUseCase(ctx, id)
+	uc.record(UseCaseComplete, start, err)
+	return err
+}
+
+// UncompleteTodoUseCase marks a completed Todo as pending again
+func (uc *InstrumentedTodoUseCase) UncompleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	start := time.Now()
+	err := uc.inner.UncompleteTodoUseCase(ctx, id)
+	uc.record(UseCaseUncomplete, start, err)
+	return err
+}
+
+// UncompleteBatchUseCase marks several completed Todos as pending again
+func (uc *InstrumentedTodoUseCase) UncompleteBatchUseCase(ctx context.Context, ids []model.TodoID) ([]model.TodoID, *model.DomainError) {
+	start := time.Now()
+	uncompleted, err := uc.inner.UncompleteBatchUseCase(ctx, ids)
+	uc.record(UseCaseUncompleteBatch, start, err)
+	return uncompleted, err
+}
+
+// BulkCompleteTodosUseCase completes several Todos, reporting the outcome per Todo
+func (uc *InstrumentedTodoUseCase) BulkCompleteTodosUseCase(ctx context.Context, ids []model.TodoID) (*appmodel.BulkResult, *model.DomainError) {
+	start := time.Now()
+	result, err := uc.inner.BulkCompleteTodosUseCase(ctx, ids)
+	uc.record(UseCaseBulkComplete, start, err)
+	return result, err
+}
+
+// ReopenTodoUseCase reopens a completed Todo
+func (uc *InstrumentedTodoUseCase) ReopenTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	start := time.Now()
+	err := uc.inner.ReopenTodoUseCase(ctx, id)
+	uc.record(UseCaseReopen, start, err)
+	return err
+}
+
+// ArchiveTodoUseCase archives a Todo
+func (uc *InstrumentedTodoUseCase) ArchiveTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	start := time.Now()
+	err := uc.inner.ArchiveTodoUseCase(ctx, id)
+	uc.record(UseCaseArchive, start, err)
+	return err
+}
+
+// UnarchiveTodoUseCase restores an archived Todo
+func (uc *InstrumentedTodoUseCase) UnarchiveTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	start := time.Now()
+	err := uc.inner.UnarchiveTodoUseCase(ctx, id)
+	uc.record(UseCaseUnarchive, start, err)
+	return err
+}
+
+// GetTodoUseCase retrieves a Todo by ID
+func (uc *InstrumentedTodoUseCase) GetTodoUseCase(ctx context.Context, id model.TodoID) (*appmodel.TodoResponse, *model.DomainError) {
+	start := time.Now()
+	response, err := uc.inner.GetTodoUseCase(ctx, id)
+	uc.record(UseCaseGet, start, err)
+	return response, err
+}
+
+// GetRandomTodoUseCase retrieves a random Todo
+func (uc *InstrumentedTodoUseCase) GetRandomTodoUseCase(ctx context.Context) (*appmodel.TodoResponse, *model.DomainError) {
+	start := time.Now()
+	response, err := uc.inner.GetRandomTodoUseCase(ctx)
+	uc.record(UseCaseGetRandom, start, err)
+	return response, err
+}
+
+// ListTodosUseCase lists Todos matching the query
+func (uc *InstrumentedTodoUseCase) ListTodosUseCase(ctx context.Context, q query.ListTodosQuery) (*appmodel.TodoListResponse, *model.DomainError) {
+	start := time.Now()
+	response, err := uc.inner.ListTodosUseCase(ctx, q)
+	uc.record(UseCaseList, start, err)
+	return response, err
+}
+
+// CountTodosUseCase counts Todos matching the filter
+func (uc *InstrumentedTodoUseCase) CountTodosUseCase(ctx context.Context, filter model.TodoFilter) (*appmodel.CountResponse, *model.DomainError) {
+	start := time.Now()
+	response, err := uc.inner.CountTodosUseCase(ctx, filter)
+	uc.record(UseCaseCount, start, err)
+	return response, err
+}
+
+// ListStaleTodosUseCase lists Todos not updated within olderThan
+func (uc *InstrumentedTodoUseCase) ListStaleTodosUseCase(ctx context.Context, olderThan time.Duration) (*appmodel.TodoListResponse, *model.DomainError) {
+	start := time.Now()
+	response, err := uc.inner.ListStaleTodosUseCase(ctx, olderThan)
+	uc.record(UseCaseListStale, start, err)
+	return response, err
+}
+
+// SearchTodosUseCase lists Todos whose title matches the search text
+func (uc *InstrumentedTodoUseCase) SearchTodosUseCase(ctx context.Context, text string) (*appmodel.TodoListResponse, *model.DomainError) {
+	start := time.Now()
+	response, err := uc.inner.SearchTodosUseCase(ctx, text)
+	uc.record(UseCaseSearch, start, err)
+	return response, err
+}
+
+// ListTodosByPriorityUseCase lists Todos grouped by priority
+func (uc *InstrumentedTodoUseCase) ListTodosByPriorityUseCase(ctx context.Context, includeArchived bool) (*appmodel.TodosByPriorityResponse, *model.DomainError) {
+	start := time.Now()
+	response, err := uc.inner.ListTodosByPriorityUseCase(ctx, includeArchived)
+	uc.record(UseCaseListByPriority, start, err)
+	return response, err
+}
+
+// DeleteTodoUseCase deletes a Todo by ID
+func (uc *InstrumentedTodoUseCase) DeleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	start := time.Now()
+	err := uc.inner.DeleteTodoUseCase(ctx, id)
+	uc.record(UseCaseDelete, start, err)
+	return err
+}
+
+// DeleteTodosUseCase deletes several Todos by ID
+func (uc *InstrumentedTodoUseCase) DeleteTodosUseCase(ctx context.Context, ids []model.TodoID) ([]model.TodoID, *model.DomainError) {
+	start := time.Now()
+	deleted, err := uc.inner.DeleteTodosUseCase(ctx, ids)
+	uc.record(UseCaseDeleteBatch, start, err)
+	return deleted, err
+}
+
+// GetDashboardUseCase summarises the Todos of an owner
+func (uc *InstrumentedTodoUseCase) GetDashboardUseCase(ctx context.Context, owner model.UserID) (*appmodel.DashboardResponse, *model.DomainError) {
+	start := time.Now()
+	response, err := uc.inner.GetDashboardUseCase(ctx, owner)
+	uc.record(UseCaseDashboard, start, err)
+	return response, err
+}
+
+// CompletionTimeStatsUseCase reports how long Todos take to complete
+func (uc *InstrumentedTodoUseCase) CompletionTimeStatsUseCase(ctx context.Context) (*appmodel.CompletionTimeStatsResponse, *model.DomainError) {
+	start := time.Now()
+	response, err := uc.inner.CompletionTimeStatsUseCase(ctx)
+	uc.record(UseCaseCompletionTimeStats, start, err)
+	return response, err
+}
+
+// SnapshotUseCase exports every Todo
+func (uc *InstrumentedTodoUseCase) SnapshotUseCase(ctx context.Context) ([]byte, *model.DomainError) {
+	start := time.Now()
+	data, err := uc.inner.SnapshotUseCase(ctx)
+	uc.record(UseCaseSnapshot, start, err)
+	return data, err
+}
+
+// RestoreSnapshotUseCase imports Todos from a snapshot
+func (uc *InstrumentedTodoUseCase) RestoreSnapshotUseCase(ctx context.Context, data []byte, replace bool) *model.DomainError {
+	start := time.Now()
+	err := uc.inner.RestoreSnapshotUseCase(ctx, data, replace)
+	uc.record(UseCaseRestoreSnapshot, start, err)
+	return err
+}
+
+// ValidateFieldUseCase validates a single Todo field
+func (uc *InstrumentedTodoUseCase) ValidateFieldUseCase(ctx context.Context, cmd command.ValidateFieldCommand) (*appmodel.FieldValidationResponse, *model.DomainError) {
+	start := time.Now()
+	response, err := uc.inner.ValidateFieldUseCase(ctx, cmd)
+	uc.record(UseCaseValidateField, start, err)
+	return response, err
+}
+
+// TestErrorUseCase always fails, for exercising error handling
+func (uc *InstrumentedTodoUseCase) TestErrorUseCase(ctx context.Context) *model.DomainError {
+	start := time.Now()
+	err := uc.inner.TestErrorUseCase(ctx)
+	uc.record(UseCaseTestError, start, err)
+	return err
+}
+
+// ReadinessUseCase reports whether the application can serve requests
+func (uc *InstrumentedTodoUseCase) ReadinessUseCase(ctx context.Context) *model.DomainError {
+	start := time.Now()
+	err := uc.inner.ReadinessUseCase(ctx)
+	uc.record(UseCaseReadiness, start, err)
+	return err
+}
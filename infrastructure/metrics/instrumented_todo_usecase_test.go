@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// stubTodoUseCase fails CompleteTodoUseCase for unknown IDs; other methods are not exercised
+type stubTodoUseCase struct {
+	port.TodoUseCasePort
+}
+
+func (s *stubTodoUseCase) CompleteTodoUseCase(ctx context.Context, id model.TodoID) *model.DomainError {
+	if id == "missing" {
+		return model.ErrTodoNotFound
+	}
+	return nil
+}
+
+func TestInstrumentedTodoUseCase_CountsSuccess(t *testing.T) {
+	registry := NewRegistry()
+	uc := NewInstrumentedTodoUseCase(&stubTodoUseCase{}, registry)
+
+	assert.Nil(t, uc.CompleteTodoUseCase(context.Background(), "todo-1"))
+	assert.Nil(t, uc.CompleteTodoUseCase(context.Background(), "todo-2"))
+
+	assert.Equal(t, 2.0, registry.Counter(MetricUseCaseCalls,
+		Label{"operation", UseCaseComplete}, Label{"outcome", OutcomeSuccess}, Label{"error_code", ""}))
+	assert.Equal(t, uint64(2), registry.HistogramCount(MetricUseCaseDuration,
+		Label{"operation", UseCaseComplete}, Label{"outcome", OutcomeSuccess}))
+}
+
+func TestInstrumentedTodoUseCase_CountsFailureByErrorCode(t *testing.T) {
+	registry := NewRegistry()
+	uc := NewInstrumentedTodoUseCase(&stubTodoUseCase{}, registry)
+
+	err := uc.CompleteTodoUseCase(context.Background(), "missing")
+
+	assert.Equal(t, model.ErrTodoNotFound, err)
+	code := strconv.Itoa(model.ErrTodoNotFound.GetErrorCode())
+	assert.Equal(t, 1.0, registry.Counter(MetricUseCaseCalls,
+		Label{"operation", UseCaseComplete}, Label{"outcome", OutcomeFailure}, Label{"error_code", code}))
+	assert.Zero(t, registry.Counter(MetricUseCaseCalls,
+		Label{"operation", UseCaseComplete}, Label{"outcome", OutcomeSuccess}, Label{"error_code", ""}))
+}
+
+func TestRegistry_WritePrometheus(t *testing.T) {
+	registry := NewRegistry()
+	registry.IncCounter("jobs_total", "Jobs run.", Label{"name", `a"b`})
+	registry.ObserveHistogram("job_seconds", "Job latency.", 0.2)
+
+	var out bytes.Buffer
+	require.NoError(t, registry.WritePrometheus(&out))
+
+	text := out.String()
+	assert.Contains(t, text, "# TYPE jobs_total counter\n")
+	assert.Contains(t, text, `jobs_total{name="a\"b"} 1`+"\n")
+	assert.Contains(t, text, "# TYPE job_seconds histogram\n")
+	assert.Contains(t, text, `job_seconds_bucket{le="0.1"} 0`+"\n")
+	assert.Contains(t, text, `job_seconds_bucket{le="0.25"} 1`+"\n")
+	assert.Contains(t, text, `job_seconds_bucket{le="+Inf"} 1`+"\n")
+	assert.Contains(t, text, "job_seconds_sum 0.2\njob_seconds_count 1\n")
+}
+
+func TestRegistry_WriteOpenMetrics(t *testing.T) {
+	registry := NewRegistry()
+	registry.IncCounter("jobs_total", "Jobs run.", Label{"name", "a"})
+	registry.ObserveHistogram("job_seconds", "Job latency.", 0.2)
+
+	var out bytes.Buffer
+	require.NoError(t, registry.WriteOpenMetrics(&out))
+
+	text := out.String()
+	assert.Contains(t, text, "# HELP jobs Jobs run.\n# TYPE jobs counter\n")
+	assert.Contains(t, text, `jobs_total{name="a"} 1`+"\n")
+	assert.Contains(t, text, "# TYPE job_seconds histogram\n")
+	assert.Contains(t, text, `job_seconds_bucket{le="+Inf"} 1`+"\n")
+	assert.True(t, strings.HasSuffix(text, "jobs_total{name=\"a\"} 1\n# EOF\n"))
+}
@@ -0,0 +1,14 @@
+package metrics
+
+import "time"
+
+// Operation outcomes used as metric labels
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// Recorder records how often an operation ran and how long it took
+type Recorder interface {
+	RecordOperation(operation string, outcome string, duration time.Duration)
+}
@@ -0,0 +1,232 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metric names exported by the Registry
+const (
+	MetricRepositoryCalls    = "todo_repository_calls_total"
+	MetricRepositoryDuration = "todo_repository_duration_seconds"
+	MetricUseCaseCalls       = "todo_usecase_calls_total"
+	MetricUseCaseDuration    = "todo_usecase_duration_seconds"
+)
+
+// DefaultBuckets are the histogram upper bounds, in seconds
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Label is a metric label name/value pair
+type Label struct {
+	Name  string
+	Value string
+}
+
+// histogram holds the cumulative bucket counts of one series
+type histogram struct {
+	buckets []uint64
+	sum     float64
+	count   uint64
+}
+
+// family groups the series sharing a metric name
+type family struct {
+	help       string
+	kind       string
+	counters   map[string]float64
+	histograms map[string]*histogram
+}
+
+// Registry keeps counters and histograms in memory and writes them in the
+// Prometheus text exposition format
+type Registry struct {
+	mu       sync.Mutex
+	families map[string]*family
+}
+
+var _ Recorder = (*Registry)(nil)
+
+// NewRegistry creates a new empty Registry
+func NewRegistry() *Registry {
+	return &Registry{families: make(map[string]*family)}
+}
+
+// family returns the named family, creating it on first use; callers hold r.mu
+func (r *Registry) family(name, help, kind string) *family {
+	f, ok := r.families[name]
+	if !ok {
+		f = &family{help: help, kind: kind, counters: make(map[string]float64), histograms: make(map[string]*histogram)}
+		r.families[name] = f
+	}
+	return f
+}
+
+// IncCounter adds one to the counter series identified by name and labels
+func (r *Registry) IncCounter(name, help string, labels ...Label) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.family(name, help, "counter").counters[formatLabels(labels)]++
+}
+
+// ObserveHistogram records value in the histogram series identified by name and labels
+func (r *Registry) ObserveHistogram(name, help string, value float64, labels ...Label) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f := r.family(name, help, "histogram")
+	key := formatLabels(labels)
+	h, ok := f.histograms[key]
+	if !ok {
+		h = &histogram{buckets: make([]uint64, len(DefaultBuckets))}
+		f.histograms[key] = h
+	}
+	for i, bound := range DefaultBuckets {
+		if value <= bound {
+			h.buckets[i]++
+		}
+	}
+	h.sum += value
+	h.count++
+}
+
+// Counter returns the current value of a counter series, or 0 if it was never incremented
+func (r *Registry) Counter(name string, labels ...Label) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, ok := r.families[name]
+	if !ok {
+		return 0
+	}
+	return f.counters[formatLabels(labels)]
+}
+
+// HistogramCount returns how many observations a histogram series holds
+func (r *Registry) HistogramCount(name string, labels ...Label) uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, ok := r.families[name]
+	if !ok {
+		return 0
+	}
+	if h, ok := f.histograms[formatLabels(labels)]; ok {
+		return h.count
+	}
+	return 0
+}
+
+// RecordOperation records a repository call, implementing Recorder
+func (r *Registry) RecordOperation(operation string, outcome string, duration time.Duration) {
+	labels := []Label{{"operation", operation}, {"outcome", outcome}}
+	r.IncCounter(MetricRepositoryCalls, "Number of todo repository calls.", labels...)
+	r.ObserveHistogram(MetricRepositoryDuration, "Latency of todo repository calls in seconds.", duration.Seconds(), labels...)
+}
+
+// RecordUseCase records a use case invocation; errorCode is the domain error
+// code of a failed call and is ignored when outcome is OutcomeSuccess
+func (r *Registry) RecordUseCase(operation string, outcome string, errorCode int, duration time.Duration) {
+	code := ""
+	if outcome != OutcomeSuccess {
+		code = strconv.Itoa(errorCode)
+	}
+	r.IncCounter(MetricUseCaseCalls, "Number of todo use case invocations.",
+		Label{"operation", operation}, Label{"outcome", outcome}, Label{"error_code", code})
+	r.ObserveHistogram(MetricUseCaseDuration, "Latency of todo use case invocations in seconds.", duration.Seconds(),
+		Label{"operation", operation}, Label{"outcome", outcome})
+}
+
+// WritePrometheus writes every metric in the Prometheus text exposition
+// format, ordered by name and labels so the output is stable
+func (r *Registry) WritePrometheus(w io.Writer) error {
+	return r.write(w, false)
+}
+
+// WriteOpenMetrics writes every metric in the OpenMetrics text format. It
+// differs from WritePrometheus in that counter families are named without
+// their _total suffix, which stays on the samples, and the output ends with
+// the mandatory # EOF line.
+func (r *Registry) WriteOpenMetrics(w io.Writer) error {
+	return r.write(w, true)
+}
+
+// write renders the families in either text format
+func (r *Registry) write(w io.Writer, openMetrics bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var b strings.Builder
+	for _, name := range sortedKeys(r.families) {
+		f := r.families[name]
+		familyName := name
+		if openMetrics && f.kind == "counter" {
+			familyName = strings.TrimSuffix(name, "_total")
+		}
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n", familyName, f.help, familyName, f.kind)
+		for _, labels := range sortedKeys(f.counters) {
+			fmt.Fprintf(&b, "%s%s %s\n", name, braces(labels), formatFloat(f.counters[labels]))
+		}
+		for _, labels := range sortedKeys(f.histograms) {
+			h := f.histograms[labels]
+			for i, bound := range DefaultBuckets {
+				fmt.Fprintf(&b, "%s_bucket%s %d\n", name, braces(joinLabels(labels, `le="`+formatFloat(bound)+`"`)), h.buckets[i])
+			}
+			fmt.Fprintf(&b, "%s_bucket%s %d\n", name, braces(joinLabels(labels, `le="+Inf"`)), h.count)
+			fmt.Fprintf(&b, "%s_sum%s %s\n", name, braces(labels), formatFloat(h.sum))
+			fmt.Fprintf(&b, "%s_count%s %d\n", name, braces(labels), h.count)
+		}
+	}
+	if openMetrics {
+		b.WriteString("# EOF\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// formatLabels renders labels as the comma-separated body of a label set
+func formatLabels(labels []Label) string {
+	parts := make([]string, len(labels))
+	for i, l := range labels {
+		parts[i] = l.Name + `="` + escapeLabelValue(l.Value) + `"`
+	}
+	return strings.Join(parts, ",")
+}
+
+// escapeLabelValue escapes backslashes, quotes and newlines in a label value
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// joinLabels appends one rendered label to a label set body
+func joinLabels(labels, extra string) string {
+	if labels == "" {
+		return extra
+	}
+	return labels + "," + extra
+}
+
+// braces wraps a non-empty label set body in curly braces
+func braces(labels string) string {
+	if labels == "" {
+		return ""
+	}
+	return "{" + labels + "}"
+}
+
+// formatFloat renders a sample value the way Prometheus clients do
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// sortedKeys returns the keys of m in ascending order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,180 @@
+package projection
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/event"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// TodoSearchIndex implements port.TodoSearchProjectionPort as an in-memory inverted
+// index from title terms to todo IDs. Created, updated, unarchived and restored todos
+// are (re)indexed and archived and deleted ones removed; subscribe Apply to the event publisher to
+// keep it current, and call Load once at startup to index the todos already stored.
+type TodoSearchIndex struct {
+	mu       sync.RWMutex
+	postings map[string]map[model.TodoID]struct{}
+	terms    map[model.TodoID][]string
+	// baseline holds the titles indexed by Load, which Reset restores since the
+	// replayed events only cover what happened after startup
+	baseline map[model.TodoID]string
+	// touched records the todos changed by events applied before Load finished,
+	// whose event-derived entries are newer than what Load reads
+	touched map[model.TodoID]struct{}
+	ready   bool
+}
+
+var _ port.TodoSearchProjectionPort = (*TodoSearchIndex)(nil)
+
+// NewTodoSearchIndex creates an empty TodoSearchIndex
+func NewTodoSearchIndex() *TodoSearchIndex {
+	return &TodoSearchIndex{
+		postings: make(map[string]map[model.TodoID]struct{}),
+		terms:    make(map[model.TodoID][]string),
+		baseline: make(map[model.TodoID]string),
+		touched:  make(map[model.TodoID]struct{}),
+	}
+}
+
+// Load indexes the unarchived todos in repo and marks the index ready. Todos
+// changed by an event since the index was created keep their event-derived entry.
+func (i *TodoSearchIndex) Load(ctx context.Context, repo port.TodoRepositoryPort) error {
+	todos, err := repo.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, todo := range todos {
+		if _, ok := i.touched[todo.GetID()]; ok || todo.IsArchived() {
+			continue
+		}
+		i.baseline[todo.GetID()] = todo.GetTitle()
+		i.index(todo.GetID(), todo.GetTitle())
+	}
+	i.touched = nil
+	i.ready = true
+	return nil
+}
+
+// Ready reports whether Load has indexed the todos stored before startup
+func (i *TodoSearchIndex) Ready() bool {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.ready
+}
+
+// Name identifies the projection in replay responses
+func (i *TodoSearchIndex) Name() string {
+	return "todo_search"
+}
+
+// Reset returns the index to the todos indexed by Load, discarding every event applied since
+func (i *TodoSearchIndex) Reset(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.postings = make(map[string]map[model.TodoID]struct{})
+	i.terms = make(map[model.TodoID][]string)
+	for id, title := range i.baseline {
+		i.index(id, title)
+	}
+	return nil
+}
+
+// Apply updates the index for created, updated, unarchived, restored, archived
+// and deleted todos and ignores other events
+func (i *TodoSearchIndex) Apply(ctx context.Context, e interface{}) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	switch e := e.(type) {
+	case *event.TodoCreatedEvent:
+		i.touch(e.TodoID)
+		i.index(e.TodoID, e.Title)
+	case *event.TodoUpdatedEvent:
+		i.touch(e.TodoID)
+		i.index(e.TodoID, e.Title)
+	case *event.TodoUnarchivedEvent:
+		i.touch(e.TodoID)
+		i.index(e.TodoID, e.Title)
+	case *event.TodoRestoredEvent:
+		i.touch(e.TodoID)
+		if e.Archived {
+			i.remove(e.TodoID)
+		} else {
+			i.index(e.TodoID, e.Title)
+		}
+	case *event.TodoArchivedEvent:
+		i.touch(e.TodoID)
+		i.remove(e.TodoID)
+	case *event.TodoDeletedEvent:
+		i.touch(e.TodoID)
+		i.remove(e.TodoID)
+	}
+	return nil
+}
+
+// touch records that an event changed id while Load has not finished
+func (i *TodoSearchIndex) touch(id model.TodoID) {
+	if !i.ready {
+		i.touched[id] = struct{}{}
+	}
+}
+
+// Query returns the IDs of todos whose titles contain every term, sorted by ID
+func (i *TodoSearchIndex) Query(ctx context.Context, terms []string) ([]model.TodoID, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	if len(terms) == 0 {
+		return []model.TodoID{}, nil
+	}
+
+	ids := []model.TodoID{}
+	for id := range i.postings[terms[0]] {
+		if i.containsAll(id, terms[1:]) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+// containsAll reports whether every term's posting list includes id
+func (i *TodoSearchIndex) containsAll(id model.TodoID, terms []string) bool {
+	for _, term := range terms {
+		if _, ok := i.postings[term][id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// index replaces the terms recorded for id with those of title
+func (i *TodoSearchIndex) index(id model.TodoID, title string) {
+	i.remove(id)
+	terms := model.TitleTerms(title)
+	for _, term := range terms {
+		if i.postings[term] == nil {
+			i.postings[term] = make(map[model.TodoID]struct{})
+		}
+		i.postings[term][id] = struct{}{}
+	}
+	i.terms[id] = terms
+}
+
+// remove drops id from every posting list it appears in
+func (i *TodoSearchIndex) remove(id model.TodoID) {
+	for _, term := range i.terms[id] {
+		delete(i.postings[term], id)
+		if len(i.postings[term]) == 0 {
+			delete(i.postings, term)
+		}
+	}
+	delete(i.terms, id)
+}
@@ -0,0 +1,114 @@
+package projection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mr3iscuit/ddd-golang/domain/event"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository"
+)
+
+func query(t *testing.T, index *TodoSearchIndex, text string) []model.TodoID {
+	t.Helper()
+	ids, err := index.Query(context.Background(), model.TitleTerms(text))
+	require.NoError(t, err)
+	return ids
+}
+
+func TestTodoSearchIndex_CreatedTodoIsSearchable(t *testing.T) {
+	ctx := context.Background()
+	index := NewTodoSearchIndex()
+
+	require.NoError(t, index.Apply(ctx, event.NewTodoCreatedEvent("b", "Buy milk and eggs", model.TodoSourceHTTP)))
+	require.NoError(t, index.Apply(ctx, event.NewTodoCreatedEvent("a", "Buy MILK", model.TodoSourceCLI)))
+
+	assert.Equal(t, []model.TodoID{"a", "b"}, query(t, index, "milk"))
+	assert.Equal(t, []model.TodoID{"b"}, query(t, index, "eggs, milk"))
+	assert.Empty(t, query(t, index, "bread"))
+}
+
+func TestTodoSearchIndex_UpdateReindexesTitle(t *testing.T) {
+	ctx := context.Background()
+	index := NewTodoSearchIndex()
+	require.NoError(t, index.Apply(ctx, event.NewTodoCreatedEvent("a", "Buy milk", model.TodoSourceHTTP)))
+
+	require.NoError(t, index.Apply(ctx, event.NewTodoUpdatedEvent("a", "Buy bread")))
+
+	assert.Empty(t, query(t, index, "milk"))
+	assert.Equal(t, []model.TodoID{"a"}, query(t, index, "bread"))
+}
+
+func TestTodoSearchIndex_ArchivedTodoIsRemoved(t *testing.T) {
+	ctx := context.Background()
+	index := NewTodoSearchIndex()
+	require.NoError(t, index.Apply(ctx, event.NewTodoCreatedEvent("a", "Buy milk", model.TodoSourceHTTP)))
+	require.NoError(t, index.Apply(ctx, event.NewTodoCreatedEvent("b", "Drink milk", model.TodoSourceHTTP)))
+
+	require.NoError(t, index.Apply(ctx, event.NewTodoArchivedEvent("a")))
+
+	assert.Equal(t, []model.TodoID{"b"}, query(t, index, "milk"))
+	assert.Empty(t, query(t, index, "buy"))
+}
+
+func TestTodoSearchIndex_DeletedTodoIsRemoved(t *testing.T) {
+	ctx := context.Background()
+	index := NewTodoSearchIndex()
+	require.NoError(t, index.Apply(ctx, event.NewTodoCreatedEvent("a", "Buy milk", model.TodoSourceHTTP)))
+	require.NoError(t, index.Apply(ctx, event.NewTodoCreatedEvent("b", "Drink milk", model.TodoSourceHTTP)))
+
+	require.NoError(t, index.Apply(ctx, event.NewTodoDeletedEvent("a")))
+
+	assert.Equal(t, []model.TodoID{"b"}, query(t, index, "milk"))
+	assert.Empty(t, query(t, index, "buy"))
+}
+
+func TestTodoSearchIndex_Reset(t *testing.T) {
+	ctx := context.Background()
+	index := NewTodoSearchIndex()
+	require.NoError(t, index.Apply(ctx, event.NewTodoCreatedEvent("a", "Buy milk", model.TodoSourceHTTP)))
+
+	require.NoError(t, index.Reset(ctx))
+
+	assert.Empty(t, query(t, index, "milk"))
+}
+
+func TestTodoSearchIndex_UnarchivedAndRestoredTodosAreIndexed(t *testing.T) {
+	ctx := context.Background()
+	index := NewTodoSearchIndex()
+	require.NoError(t, index.Apply(ctx, event.NewTodoCreatedEvent("a", "Buy milk", model.TodoSourceHTTP)))
+	require.NoError(t, index.Apply(ctx, event.NewTodoArchivedEvent("a")))
+
+	require.NoError(t, index.Apply(ctx, event.NewTodoUnarchivedEvent("a", "Buy milk")))
+	require.NoError(t, index.Apply(ctx, event.NewTodoRestoredEvent("b", "Milk the cow", false)))
+	require.NoError(t, index.Apply(ctx, event.NewTodoRestoredEvent("c", "Spilt milk", true)))
+
+	assert.Equal(t, []model.TodoID{"a", "b"}, query(t, index, "milk"))
+}
+
+func TestTodoSearchIndex_Load(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewInMemoryTodoRepository()
+	stored, renamed, archived := model.NewSimpleTodo("Buy milk"), model.NewSimpleTodo("Old title"), model.NewSimpleTodo("Archived milk")
+	require.NoError(t, archived.ArchiveTodo())
+	for _, todo := range []*model.Todo{stored, renamed, archived} {
+		require.NoError(t, repo.Save(ctx, todo))
+	}
+	index := NewTodoSearchIndex()
+	assert.False(t, index.Ready())
+
+	// An event applied before Load finishes is newer than what Load reads
+	require.NoError(t, index.Apply(ctx, event.NewTodoUpdatedEvent(renamed.GetID(), "Fresh milk")))
+	require.NoError(t, index.Load(ctx, repo))
+
+	assert.True(t, index.Ready())
+	assert.ElementsMatch(t, []model.TodoID{stored.GetID(), renamed.GetID()}, query(t, index, "milk"))
+	assert.Empty(t, query(t, index, "old"))
+
+	// Replays only re-apply events, so Reset keeps the loaded todos
+	require.NoError(t, index.Reset(ctx))
+	assert.Equal(t, []model.TodoID{stored.GetID()}, query(t, index, "milk"))
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// InMemoryCategoryRepository implements port.CategoryRepositoryPort in memory.
+// Categories are stored by value so callers cannot mutate persisted state without calling Save.
+type InMemoryCategoryRepository struct {
+	mu         sync.RWMutex
+	categories map[model.CategoryID]model.Category
+}
+
+// NewInMemoryCategoryRepository creates a new, empty InMemoryCategoryRepository
+func NewInMemoryCategoryRepository() *InMemoryCategoryRepository {
+	return &InMemoryCategoryRepository{categories: make(map[model.CategoryID]model.Category)}
+}
+
+var _ port.CategoryRepositoryPort = (*InMemoryCategoryRepository)(nil)
+
+// Save inserts or updates a Category
+func (r *InMemoryCategoryRepository) Save(category *model.Category) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.categories[category.GetID()] = *category
+	return nil
+}
+
+// FindByID retrieves a Category by ID
+func (r *InMemoryCategoryRepository) FindByID(id model.CategoryID) (*model.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	category, ok := r.categories[id]
+	if !ok {
+		return nil, fmt.Errorf("category with id %s not found", id)
+	}
+	return &category, nil
+}
+
+// FindAll retrieves all Categories ordered by creation time
+func (r *InMemoryCategoryRepository) FindAll() ([]*model.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	categories := make([]*model.Category, 0, len(r.categories))
+	for _, stored := range r.categories {
+		category := stored
+		categories = append(categories, &category)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		if !categories[i].GetCreatedAt().Equal(categories[j].GetCreatedAt()) {
+			return categories[i].GetCreatedAt().Before(categories[j].GetCreatedAt())
+		}
+		return categories[i].GetID() < categories[j].GetID()
+	})
+	return categories, nil
+}
+
+// Delete removes a Category by ID
+func (r *InMemoryCategoryRepository) Delete(id model.CategoryID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.categories[id]; !ok {
+		return fmt.Errorf("category with id %s not found", id)
+	}
+	delete(r.categories, id)
+	return nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+func TestInMemoryCategoryRepository_SaveAndFindByID(t *testing.T) {
+	repo := NewInMemoryCategoryRepository()
+	category := model.NewCategory("Work", "Office tasks", model.CategoryColorBlue, "user-1")
+	require.NoError(t, repo.Save(category))
+
+	found, err := repo.FindByID(category.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, category.GetName(), found.GetName())
+	assert.Equal(t, category.GetColor(), found.GetColor())
+
+	_, err = repo.FindByID("missing")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestInMemoryCategoryRepository_IsolatesStoredCategories(t *testing.T) {
+	repo := NewInMemoryCategoryRepository()
+	category := model.NewCategory("Work", "", model.CategoryColorBlue, "")
+	require.NoError(t, repo.Save(category))
+
+	require.NoError(t, category.UpdateColor(model.CategoryColorRed))
+
+	found, err := repo.FindByID(category.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, model.CategoryColorBlue, found.GetColor())
+}
+
+func TestInMemoryCategoryRepository_FindAllOrdersByCreationTime(t *testing.T) {
+	repo := NewInMemoryCategoryRepository()
+	now := time.Now()
+	second := model.NewCategoryFromData("a", "Second", "", model.CategoryColorRed, "", now, now, false)
+	first := model.NewCategoryFromData("b", "First", "", model.CategoryColorRed, "", now.Add(-time.Minute), now, true)
+	require.NoError(t, repo.Save(second))
+	require.NoError(t, repo.Save(first))
+
+	categories, err := repo.FindAll()
+	require.NoError(t, err)
+	require.Len(t, categories, 2)
+	assert.Equal(t, first.GetID(), categories[0].GetID())
+	assert.True(t, categories[0].IsDefault())
+	assert.Equal(t, second.GetID(), categories[1].GetID())
+}
+
+func TestInMemoryCategoryRepository_Delete(t *testing.T) {
+	repo := NewInMemoryCategoryRepository()
+	category := model.NewCategory("Work", "", model.CategoryColorBlue, "")
+	require.NoError(t, repo.Save(category))
+
+	require.NoError(t, repo.Delete(category.GetID()))
+	assert.ErrorContains(t, repo.Delete(category.GetID()), "not found")
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	gormpostgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository/postgres"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository/sqlite"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+// Repositories bundles the repositories of one backend, sharing its connection
+type Repositories struct {
+	Todos      port.TodoRepositoryPort
+	Users      port.UserRepositoryPort
+	Categories port.CategoryRepositoryPort
+	// Transactions runs todo repository calls as one unit of work on the primary database
+	Transactions port.TransactionManager
+}
+
+// NewTodoRepository returns the todo repository of the backend selected by cfg.DBDriver
+func NewTodoRepository(cfg *config.Config) (port.TodoRepositoryPort, error) {
+	repos, err := NewRepositories(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return repos.Todos, nil
+}
+
+// NewRepositories connects to the backend selected by cfg.DBDriver, migrates its
+// schema and returns its repositories. Todo reads go through a read replica when
+// cfg.DBReplicaDSN is set. Row-count mismatches are logged to slog.Default().
+func NewRepositories(cfg *config.Config) (*Repositories, error) {
+	switch cfg.DBDriver {
+	case config.DBDriverMemory:
+		todos := NewInMemoryTodoRepository(WithTodoLimits(todoLimits(cfg)))
+		return &Repositories{
+			Todos:        todos,
+			Users:        NewInMemoryUserRepository(),
+			Categories:   NewInMemoryCategoryRepository(),
+			Transactions: todos,
+		}, nil
+	case config.DBDriverSQLite:
+		dialector, err := sqliteDialector(cfg.SQLitePath)
+		if err != nil {
+			return nil, err
+		}
+		db, err := gorm.Open(dialector, &gorm.Config{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to SQLite DB: %w", err)
+		}
+		if err := sqlite.Migrate(db); err != nil {
+			return nil, err
+		}
+		todos := sqlite.NewSQLiteTodoRepository(db, todoRepositoryOptions(cfg)...)
+		return gormRepositories(db, todos, todos), nil
+	case config.DBDriverPostgres:
+		dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
+			cfg.DBHost, cfg.DBUser, cfg.DBPassword, cfg.DBName, cfg.DBPort)
+		db, err := gorm.Open(gormpostgres.Open(dsn), &gorm.Config{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to DB: %w", err)
+		}
+		if err := db.AutoMigrate(&postgres.TodoRecord{}, &postgres.TodoDependencyRecord{}, &postgres.UserRecord{}, &postgres.CategoryRecord{}); err != nil {
+			return nil, fmt.Errorf("failed to migrate DB: %w", err)
+		}
+
+		primary := postgres.NewPostgresTodoRepository(db, todoRepositoryOptions(cfg)...)
+		var todos port.TodoRepositoryPort = primary
+		if cfg.DBReplicaDSN != "" {
+			replicaDB, err := gorm.Open(gormpostgres.Open(cfg.DBReplicaDSN), &gorm.Config{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to connect to replica DB: %w", err)
+			}
+			todos = NewRoutingTodoRepository(todos, postgres.NewPostgresTodoRepository(replicaDB),
+				WithReadAfterWriteWindow(time.Duration(cfg.ReplicaReadAfterWriteSeconds)*time.Second))
+		}
+		return gormRepositories(db, todos, primary), nil
+	default:
+		return nil, fmt.Errorf("unsupported DB driver %q", cfg.DBDriver)
+	}
+}
+
+// gormRepositories pairs a todo repository with user and category repositories
+// on the same connection; their queries are portable across the GORM backends
+func gormRepositories(db *gorm.DB, todos port.TodoRepositoryPort, transactions port.TransactionManager) *Repositories {
+	return &Repositories{
+		Todos:        todos,
+		Users:        postgres.NewPostgresUserRepository(db),
+		Categories:   postgres.NewPostgresCategoryRepository(db),
+		Transactions: transactions,
+	}
+}
+
+// todoLimits returns the todo length limits configured by cfg
+func todoLimits(cfg *config.Config) model.TodoLimits {
+	return model.TodoLimits{MaxTitleLength: cfg.MaxTitleLength, MaxDescriptionLength: cfg.MaxDescriptionLength}
+}
+
+// todoRepositoryOptions configures the GORM todo repositories from cfg
+func todoRepositoryOptions(cfg *config.Config) []postgres.PostgresTodoRepositoryOption {
+	opts := []postgres.PostgresTodoRepositoryOption{postgres.WithTodoLimits(todoLimits(cfg))}
+	if cfg.DBLogRowMismatches {
+		opts = append(opts, postgres.WithRowMismatchLogger(slog.Default()))
+	}
+	return opts
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository/sqlite"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+func TestNewTodoRepository_SelectsDriver(t *testing.T) {
+	tests := []struct {
+		name    string
+		driver  string
+		wantErr bool
+		assert  func(t *testing.T, repo any)
+	}{
+		{
+			name:   "memory",
+			driver: config.DBDriverMemory,
+			assert: func(t *testing.T, repo any) {
+				assert.IsType(t, &InMemoryTodoRepository{}, repo)
+			},
+		},
+		{
+			name:    "sqlite",
+			driver:  config.DBDriverSQLite,
+			wantErr: !sqliteSupported,
+			assert: func(t *testing.T, repo any) {
+				assert.IsType(t, &sqlite.SQLiteTodoRepository{}, repo)
+			},
+		},
+		{
+			name:    "unknown driver",
+			driver:  "oracle",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Default()
+			cfg.DBDriver = tt.driver
+			cfg.SQLitePath = ":memory:"
+
+			repo, err := NewTodoRepository(cfg)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, repo)
+				return
+			}
+			require.NoError(t, err)
+			tt.assert(t, repo)
+
+			// The returned repository is migrated and ready to use
+			todo := model.NewTodo("Factory", "", model.TodoPriorityLow)
+			require.NoError(t, repo.Save(context.Background(), todo))
+			found, err := repo.FindByID(context.Background(), todo.GetID())
+			require.NoError(t, err)
+			assert.Equal(t, "Factory", found.GetTitle())
+		})
+	}
+}
+
+func TestNewRepositories_MemoryDriver(t *testing.T) {
+	cfg := config.Default()
+	cfg.DBDriver = config.DBDriverMemory
+
+	repos, err := NewRepositories(cfg)
+
+	require.NoError(t, err)
+	assert.IsType(t, &InMemoryTodoRepository{}, repos.Todos)
+	assert.IsType(t, &InMemoryUserRepository{}, repos.Users)
+	assert.IsType(t, &InMemoryCategoryRepository{}, repos.Categories)
+	assert.Same(t, repos.Todos, repos.Transactions)
+}
+
+func TestNewRepositories_ConfiguredTodoLimits(t *testing.T) {
+	cfg := config.Default()
+	cfg.DBDriver = config.DBDriverMemory
+	cfg.MaxTitleLength = 300
+
+	repos, err := NewRepositories(cfg)
+	require.NoError(t, err)
+
+	long := model.NewTodo(strings.Repeat("a", 250), "", model.TodoPriorityLow)
+	assert.NoError(t, repos.Todos.Save(context.Background(), long))
+	tooLong := model.NewTodo(strings.Repeat("a", 301), "", model.TodoPriorityLow)
+	assert.ErrorContains(t, repos.Todos.Save(context.Background(), tooLong), "title cannot exceed 300 characters")
+}
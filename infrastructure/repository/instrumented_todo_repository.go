@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/metrics"
+)
+
+// Repository operation names used as metric labels
+const (
+	OperationSave         = "save"
+	OperationCreate       = "create"
+	OperationUpdate       = "update"
+	OperationUpdateAll    = "update_all"
+	OperationFindByID     = "find_by_id"
+	OperationFindByIDs    = "find_by_ids"
+	OperationFindAll      = "find_all"
+	OperationFindPage     = "find_paginated"
+	OperationFindByStatus = "find_by_status"
+	OperationFindFiltered = "find_filtered"
+	OperationFindByOwner  = "find_by_created_by"
+	OperationFindRandom   = "find_random"
+	OperationFindStale    = "find_stale"
+	OperationSearch       = "search"
+	OperationCount        = "count"
+	OperationDelete       = "delete"
+	OperationDeleteByIDs  = "delete_by_ids"
+
+	OperationCompletionTimeStats = "completion_time_stats"
+	OperationFindByPriority      = "find_ordered_by_priority"
+	OperationFindDeletedIDs      = "find_deleted_ids"
+	OperationLockDependencies    = "lock_dependencies"
+	OperationPing                = "ping"
+	OperationTransaction         = "transaction"
+)
+
+// InstrumentedTodoRepository decorates a port.TodoRepositoryPort, recording
+// the count and duration of every call labeled by operation and outcome
+type InstrumentedTodoRepository struct {
+	inner    port.TodoRepositoryPort
+	recorder metrics.Recorder
+}
+
+var _ port.TodoRepositoryPort = (*InstrumentedTodoRepository)(nil)
+
+// NewInstrumentedTodoRepository wraps a repository with metrics recording
+func NewInstrumentedTodoRepository(inner port.TodoRepositoryPort, recorder metrics.Recorder) *InstrumentedTodoRepository {
+	return &InstrumentedTodoRepository{inner: inner, recorder: recorder}
+}
+
+// record reports an operation that started at the given time
+func (r *InstrumentedTodoRepository) record(operation string, start time.Time, err error) {
+	recordOperation(r.recorder, operation, start, err)
+}
+
+// recordOperation reports an operation that started at the given time to recorder
+func recordOperation(recorder metrics.Recorder, operation string, start time.Time, err error) {
+	outcome := metrics.OutcomeSuccess
+	if err != nil {
+		outcome = metrics.OutcomeFailure
+	}
+	recorder.RecordOperation(operation, outcome, time.Since(start))
+}
+
+// InstrumentedTransactionManager decorates a port.TransactionManager, recording
+// each transaction and instrumenting the repository it hands to fn, so calls made
+// inside a transaction are counted like any other repository call
+type InstrumentedTransactionManager struct {
+	inner    port.TransactionManager
+	recorder metrics.Recorder
+}
+
+var _ port.TransactionManager = (*InstrumentedTransactionManager)(nil)
+
+// NewInstrumentedTransactionManager wraps a transaction manager with metrics recording
+func NewInstrumentedTransactionManager(inner port.TransactionManager, recorder metrics.Recorder) *InstrumentedTransactionManager {
+	return &InstrumentedTransactionManager{inner: inner, recorder: recorder}
+}
+
+// WithinTransaction calls fn with an instrumented transaction-scoped repository
+func (m *InstrumentedTransactionManager) WithinTransaction(ctx context.Context, fn func(repo port.TodoRepositoryPort) error) error {
+	start := time.Now()
+	err := m.inner.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		return fn(NewInstrumentedTodoRepository(repo, m.recorder))
+	})
+	recordOperation(m.recorder, OperationTransaction, start, err)
+	return err
+}
+
+// Save inserts or updates a Todo
+func (r *InstrumentedTodoRepository) Save(ctx context.Context, todo *model.Todo) error {
+	start := time.Now()
+	err := r.inner.Save(ctx, todo)
+	r.record(OperationSave, start, err)
+	return err
+}
+
+// Create inserts a new Todo
+func (r *InstrumentedTodoRepository) Create(ctx context.Context, todo *model.Todo) error {
+	start := time.Now()
+	err := r.inner.Create(ctx, todo)
+	r.record(OperationCreate, start, err)
+	return err
+}
+
+// Update overwrites an existing Todo
+func (r *InstrumentedTodoRepository) Update(ctx context.Context, todo *model.Todo) error {
+	start := time.Now()
+	err := r.inner.Update(ctx, todo)
+	r.record(OperationUpdate, start, err)
+	return err
+}
+
+// UpdateAll overwrites several existing Todos as one unit
+func (r *InstrumentedTodoRepository) UpdateAll(ctx context.Context, todos []*model.Todo) error {
+	start := time.Now()
+	err := r.inner.UpdateAll(ctx, todos)
+	r.record(OperationUpdateAll, start, err)
+	return err
+}
+
+// FindByID retrieves a Todo by ID
+func (r *InstrumentedTodoRepository) FindByID(ctx context.Context, id model.TodoID) (*model.Todo, error) {
+	start := time.Now()
+	todo, err := r.inner.FindByID(ctx, id)
+	r.record(OperationFindByID, start, err)
+	return todo, err
+}
+
+// FindByIDs retrieves several Todos by ID
+func (r *InstrumentedTodoRepository) FindByIDs(ctx context.Context, ids []model.TodoID) ([]*model.Todo, error) {
+	start := time.Now()
+	todos, err := r.inner.FindByIDs(ctx, ids)
+	r.record(OperationFindByIDs, start, err)
+	return todos, err
+}
+
+// FindAll retrieves all Todos
+func (r *InstrumentedTodoRepository) FindAll(ctx context.Context) ([]*model.Todo, error) {
+	start := time.Now()
+	todos, err := r.inner.FindAll(ctx)
+	r.record(OperationFindAll, start, err)
+	return todos, err
+}
+
+// FindPaginated retrieves one page of Todos and the total number of Todos
+func (r *InstrumentedTodoRepository) FindPaginated(ctx context.Context, limit, offset int) ([]*model.Todo, int, error) {
+	start := time.Now()
+	todos, total, err := r.inner.FindPaginated(ctx, limit, offset)
+	r.record(OperationFindPage, start, err)
+	return todos, total, err
+}
+
+// FindFiltered retrieves one page of the Todos matching the filter and the total number of matches
+func (r *InstrumentedTodoRepository) FindFiltered(ctx context.Context, filter model.TodoFilter, sort model.TodoSort, limit, offset int) ([]*model.Todo, int, error) {
+	start := time.Now()
+	todos, total, err := r.inner.FindFiltered(ctx, filter, sort, limit, offset)
+	r.record(OperationFindFiltered, start, err)
+	return todos, total, err
+}
+
+// FindByStatus retrieves all Todos in the given status
+func (r *InstrumentedTodoRepository) FindByStatus(ctx context.Context, status model.TodoStatus) ([]*model.Todo, error) {
+	start := time.Now()
+	todos, err := r.inner.FindByStatus(ctx, status)
+	r.record(OperationFindByStatus, start, err)
+	return todos, err
+}
+
+// FindByCreatedBy retrieves all Todos owned by the given user
+func (r *InstrumentedTodoRepository) FindByCreatedBy(ctx context.Context, userID model.UserID) ([]*model.Todo, error) {
+	start := time.Now()
+	todos, err := r.inner.FindByCreatedBy(ctx, userID)
+	r.record(OperationFindByOwner, start, err)
+	return todos, err
+}
+
+// FindRandom retrieves a random pending Todo
+func (r *InstrumentedTodoRepository) FindRandom(ctx context.Context) (*model.Todo, error) {
+	start := time.Now()
+	todo, err := r.inner.FindRandom(ctx)
+	r.record(OperationFindRandom, start, err)
+	return todo, err
+}
+
+// FindStale retrieves pending Todos not updated within olderThan
+func (r *InstrumentedTodoRepository) FindStale(ctx context.Context, olderThan time.Duration) ([]*model.Todo, error) {
+	start := time.Now()
+	todos, err := r.inner.FindStale(ctx, olderThan)
+	r.record(OperationFindStale, start, err)
+	return todos, err
+}
+
+// Search retrieves the Todos whose title or description contains term
+func (r *InstrumentedTodoRepository) Search(ctx context.Context, term string) ([]*model.Todo, error) {
+	start := time.Now()
+	todos, err := r.inner.Search(ctx, term)
+	r.record(OperationSearch, start, err)
+	return todos, err
+}
+
+// FindOrderedByPriority retrieves Todos from highest to lowest priority
+func (r *InstrumentedTodoRepository) FindOrderedByPriority(ctx context.Context, includeArchived bool) ([]*model.Todo, error) {
+	start := time.Now()
+	todos, err := r.inner.FindOrderedByPriority(ctx, includeArchived)
+	r.record(OperationFindByPriority, start, err)
+	return todos, err
+}
+
+// Count returns the number of Todos matching the filter
+func (r *InstrumentedTodoRepository) Count(ctx context.Context, filter model.TodoFilter) (int, error) {
+	start := time.Now()
+	count, err := r.inner.Count(ctx, filter)
+	r.record(OperationCount, start, err)
+	return count, err
+}
+
+// FindDeletedIDs lists the IDs of deleted Todos
+func (r *InstrumentedTodoRepository) FindDeletedIDs(ctx context.Context) ([]model.TodoID, error) {
+	start := time.Now()
+	ids, err := r.inner.FindDeletedIDs(ctx)
+	r.record(OperationFindDeletedIDs, start, err)
+	return ids, err
+}
+
+// Delete removes a Todo by ID
+func (r *InstrumentedTodoRepository) Delete(ctx context.Context, id model.TodoID) error {
+	start := time.Now()
+	err := r.inner.Delete(ctx, id)
+	r.record(OperationDelete, start, err)
+	return err
+}
+
+// DeleteByIDs removes several Todos and returns the IDs that were not present
+func (r *InstrumentedTodoRepository) DeleteByIDs(ctx context.Context, ids []model.TodoID) ([]model.TodoID, error) {
+	start := time.Now()
+	missing, err := r.inner.DeleteByIDs(ctx, ids)
+	r.record(OperationDeleteByIDs, start, err)
+	return missing, err
+}
+
+// CompletionTimeStats aggregates completion times per priority
+func (r *InstrumentedTodoRepository) CompletionTimeStats(ctx context.Context) ([]model.CompletionTimeStat, error) {
+	start := time.Now()
+	stats, err := r.inner.CompletionTimeStats(ctx)
+	r.record(OperationCompletionTimeStats, start, err)
+	return stats, err
+}
+
+// LockDependencies locks the dependency graph for the surrounding transaction
+func (r *InstrumentedTodoRepository) LockDependencies(ctx context.Context, ids ...model.TodoID) error {
+	start := time.Now()
+	err := r.inner.LockDependencies(ctx, ids...)
+	r.record(OperationLockDependencies, start, err)
+	return err
+}
+
+// Ping checks that the underlying store is reachable
+func (r *InstrumentedTodoRepository) Ping(ctx context.Context) error {
+	start := time.Now()
+	err := r.inner.Ping(ctx)
+	r.record(OperationPing, start, err)
+	return err
+}
@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/metrics"
+)
+
+type MockTodoRepository struct {
+	mock.Mock
+}
+
+func (m *MockTodoRepository) Save(ctx context.Context, todo *model.Todo) error {
+	args := m.Called(todo)
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) Create(ctx context.Context, todo *model.Todo) error {
+	args := m.Called(todo)
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) Update(ctx context.Context, todo *model.Todo) error {
+	args := m.Called(todo)
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) UpdateAll(ctx context.Context, todos []*model.Todo) error {
+	args := m.Called(todos)
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) FindByID(ctx context.Context, id model.TodoID) (*model.Todo, error) {
+	args := m.Called(id)
+	if todo, ok := args.Get(0).(*model.Todo); ok {
+		return todo, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindByIDs(ctx context.Context, ids []model.TodoID) ([]*model.Todo, error) {
+	args := m.Called(ids)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindAll(ctx context.Context) ([]*model.Todo, error) {
+	args := m.Called()
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindPaginated(ctx context.Context, limit, offset int) ([]*model.Todo, int, error) {
+	args := m.Called(limit, offset)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Int(1), args.Error(2)
+	}
+	return nil, args.Int(1), args.Error(2)
+}
+
+func (m *MockTodoRepository) FindFiltered(ctx context.Context, filter model.TodoFilter, sort model.TodoSort, limit, offset int) ([]*model.Todo, int, error) {
+	args := m.Called(filter, sort, limit, offset)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Int(1), args.Error(2)
+	}
+	return nil, args.Int(1), args.Error(2)
+}
+
+func (m *MockTodoRepository) FindByStatus(ctx context.Context, status model.TodoStatus) ([]*model.Todo, error) {
+	args := m.Called(status)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindByCreatedBy(ctx context.Context, userID model.UserID) ([]*model.Todo, error) {
+	args := m.Called(userID)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) Delete(ctx context.Context, id model.TodoID) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) DeleteByIDs(ctx context.Context, ids []model.TodoID) ([]model.TodoID, error) {
+	args := m.Called(ids)
+	if missing, ok := args.Get(0).([]model.TodoID); ok {
+		return missing, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) Ping(ctx context.Context) error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) LockDependencies(ctx context.Context, ids ...model.TodoID) error {
+	args := m.Called(ids)
+	return args.Error(0)
+}
+
+func (m *MockTodoRepository) CompletionTimeStats(ctx context.Context) ([]model.CompletionTimeStat, error) {
+	args := m.Called()
+	if stats, ok := args.Get(0).([]model.CompletionTimeStat); ok {
+		return stats, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindRandom(ctx context.Context) (*model.Todo, error) {
+	args := m.Called()
+	if todo, ok := args.Get(0).(*model.Todo); ok {
+		return todo, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindStale(ctx context.Context, olderThan time.Duration) ([]*model.Todo, error) {
+	args := m.Called(olderThan)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) Search(ctx context.Context, term string) ([]*model.Todo, error) {
+	args := m.Called(term)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindOrderedByPriority(ctx context.Context, includeArchived bool) ([]*model.Todo, error) {
+	args := m.Called(includeArchived)
+	if todos, ok := args.Get(0).([]*model.Todo); ok {
+		return todos, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) FindDeletedIDs(ctx context.Context) ([]model.TodoID, error) {
+	args := m.Called()
+	if ids, ok := args.Get(0).([]model.TodoID); ok {
+		return ids, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockTodoRepository) Count(ctx context.Context, filter model.TodoFilter) (int, error) {
+	args := m.Called(filter)
+	return args.Int(0), args.Error(1)
+}
+
+// repositoryCalls returns how many calls to operation with the given outcome registry counted
+func repositoryCalls(registry *metrics.Registry, operation, outcome string) float64 {
+	return registry.Counter(metrics.MetricRepositoryCalls,
+		metrics.Label{Name: "operation", Value: operation}, metrics.Label{Name: "outcome", Value: outcome})
+}
+
+func TestInstrumentedTodoRepository_SaveRecordsSuccess(t *testing.T) {
+	inner := new(MockTodoRepository)
+	registry := metrics.NewRegistry()
+	repo := NewInstrumentedTodoRepository(inner, registry)
+	todo := model.NewSimpleTodo("Instrumented")
+
+	inner.On("Save", todo).Return(nil)
+
+	assert.NoError(t, repo.Save(context.Background(), todo))
+	assert.Equal(t, 1.0, repositoryCalls(registry, OperationSave, metrics.OutcomeSuccess))
+	assert.Equal(t, 0.0, repositoryCalls(registry, OperationSave, metrics.OutcomeFailure))
+	assert.Equal(t, uint64(1), registry.HistogramCount(metrics.MetricRepositoryDuration,
+		metrics.Label{Name: "operation", Value: OperationSave}, metrics.Label{Name: "outcome", Value: metrics.OutcomeSuccess}))
+	inner.AssertExpectations(t)
+}
+
+func TestInstrumentedTodoRepository_FindByIDRecordsFailure(t *testing.T) {
+	inner := new(MockTodoRepository)
+	registry := metrics.NewRegistry()
+	repo := NewInstrumentedTodoRepository(inner, registry)
+
+	inner.On("FindByID", model.TodoID("missing")).Return(nil, errors.New("not found"))
+
+	_, err := repo.FindByID(context.Background(), "missing")
+	assert.Error(t, err)
+	assert.Equal(t, 1.0, repositoryCalls(registry, OperationFindByID, metrics.OutcomeFailure))
+	assert.Equal(t, 0.0, repositoryCalls(registry, OperationFindByID, metrics.OutcomeSuccess))
+	inner.AssertExpectations(t)
+}
+
+func TestInstrumentedTransactionManager_RecordsCallsInsideTransaction(t *testing.T) {
+	registry := metrics.NewRegistry()
+	inner := NewInMemoryTodoRepository()
+	transactions := NewInstrumentedTransactionManager(inner, registry)
+	todo := model.NewSimpleTodo("Bulk")
+
+	err := transactions.WithinTransaction(context.Background(), func(repo port.TodoRepositoryPort) error {
+		if err := repo.Create(context.Background(), todo); err != nil {
+			return err
+		}
+		_, err := repo.FindByID(context.Background(), todo.GetID())
+		return err
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, repositoryCalls(registry, OperationTransaction, metrics.OutcomeSuccess))
+	assert.Equal(t, 1.0, repositoryCalls(registry, OperationCreate, metrics.OutcomeSuccess))
+	assert.Equal(t, 1.0, repositoryCalls(registry, OperationFindByID, metrics.OutcomeSuccess))
+
+	err = transactions.WithinTransaction(context.Background(), func(repo port.TodoRepositoryPort) error {
+		return repo.Update(context.Background(), model.NewSimpleTodo("Missing"))
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1.0, repositoryCalls(registry, OperationTransaction, metrics.OutcomeFailure))
+	assert.Equal(t, 1.0, repositoryCalls(registry, OperationUpdate, metrics.OutcomeFailure))
+}
@@ -0,0 +1,18 @@
+package postgres
+
+import "time"
+
+type CategoryRecord struct {
+	ID          string `gorm:"primaryKey"`
+	Name        string
+	Description string
+	Color       string
+	CreatedBy   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time `gorm:"autoUpdateTime:false"` // owned by the domain, not GORM
+	IsDefault   bool
+}
+
+func (CategoryRecord) TableName() string {
+	return "categories"
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// PostgresCategoryRepository implements port.CategoryRepositoryPort using PostgreSQL and GORM
+type PostgresCategoryRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresCategoryRepository creates a new PostgresCategoryRepository
+func NewPostgresCategoryRepository(db *gorm.DB) *PostgresCategoryRepository {
+	return &PostgresCategoryRepository{db: db}
+}
+
+var _ port.CategoryRepositoryPort = (*PostgresCategoryRepository)(nil)
+
+// Save inserts or updates a Category in the database
+func (r *PostgresCategoryRepository) Save(category *model.Category) error {
+	return r.db.Save(fromCategoryModel(category)).Error
+}
+
+// FindByID retrieves a Category by ID
+func (r *PostgresCategoryRepository) FindByID(id model.CategoryID) (*model.Category, error) {
+	var record CategoryRecord
+	result := r.db.Where("id = ?", id).First(&record)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("category with id %s not found", id)
+		}
+		return nil, result.Error
+	}
+	return toCategoryModel(&record), nil
+}
+
+// FindAll retrieves all Categories ordered by creation time
+func (r *PostgresCategoryRepository) FindAll() ([]*model.Category, error) {
+	var records []CategoryRecord
+	result := r.db.Order(defaultOrder).Find(&records)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	categories := make([]*model.Category, len(records))
+	for i := range records {
+		categories[i] = toCategoryModel(&records[i])
+	}
+	return categories, nil
+}
+
+// Delete removes a Category by ID
+func (r *PostgresCategoryRepository) Delete(id model.CategoryID) error {
+	result := r.db.Delete(&CategoryRecord{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("category with id %s not found", id)
+	}
+	return nil
+}
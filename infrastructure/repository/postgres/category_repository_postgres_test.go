@@ -0,0 +1,81 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository/postgres"
+	"github.com/mr3iscuit/ddd-golang/pkg/testsupport"
+)
+
+type PostgresCategoryRepoTestSuite struct {
+	suite.Suite
+	db      *gorm.DB
+	cleanup func()
+	repo    *postgres.PostgresCategoryRepository
+}
+
+func (s *PostgresCategoryRepoTestSuite) SetupSuite() {
+	s.db, s.cleanup = testsupport.NewTestDB(s.T())
+	s.repo = postgres.NewPostgresCategoryRepository(s.db)
+}
+
+func (s *PostgresCategoryRepoTestSuite) TearDownSuite() {
+	s.cleanup()
+}
+
+func (s *PostgresCategoryRepoTestSuite) TearDownTest() {
+	testsupport.ResetDB(s.T(), s.db)
+}
+
+func (s *PostgresCategoryRepoTestSuite) TestSaveAndFindByID() {
+	category := model.NewCategory("Work", "Office tasks", model.CategoryColorBlue, "user-1")
+	s.NoError(s.repo.Save(category))
+
+	found, err := s.repo.FindByID(category.GetID())
+	s.NoError(err)
+	s.Equal(category.GetName(), found.GetName())
+	s.Equal(category.GetDescription(), found.GetDescription())
+	s.Equal(category.GetColor(), found.GetColor())
+	s.Equal(category.GetCreatedBy(), found.GetCreatedBy())
+	s.False(found.IsDefault())
+	s.WithinDuration(category.GetCreatedAt(), found.GetCreatedAt(), time.Second)
+}
+
+func (s *PostgresCategoryRepoTestSuite) TestSaveUpdatesExistingCategory() {
+	category := model.NewDefaultCategory("General", model.CategoryColorGray)
+	s.NoError(s.repo.Save(category))
+
+	s.NoError(category.UpdateName("Everything"))
+	s.NoError(s.repo.Save(category))
+
+	found, err := s.repo.FindByID(category.GetID())
+	s.NoError(err)
+	s.Equal("Everything", found.GetName())
+	s.True(found.IsDefault())
+}
+
+func (s *PostgresCategoryRepoTestSuite) TestFindAllAndDelete() {
+	work := model.NewCategory("Work", "", model.CategoryColorBlue, "")
+	home := model.NewCategory("Home", "", model.CategoryColorGreen, "")
+	s.NoError(s.repo.Save(work))
+	s.NoError(s.repo.Save(home))
+
+	categories, err := s.repo.FindAll()
+	s.NoError(err)
+	s.Len(categories, 2)
+
+	s.NoError(s.repo.Delete(work.GetID()))
+	s.Error(s.repo.Delete(work.GetID()))
+
+	_, err = s.repo.FindByID(work.GetID())
+	s.Error(err)
+}
+
+func TestPostgresCategoryRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(PostgresCategoryRepoTestSuite))
+}
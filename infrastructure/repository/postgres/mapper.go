@@ -1,6 +1,10 @@
 package postgres
 
-import "github.com/mr3iscuit/ddd-golang/domain/model"
+import (
+	"github.com/lib/pq"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
 
 func fromModel(todo *model.Todo) *TodoRecord {
 	return &TodoRecord{
@@ -12,11 +16,21 @@ func fromModel(todo *model.Todo) *TodoRecord {
 		CreatedAt:   todo.GetCreatedAt(),
 		UpdatedAt:   todo.GetUpdatedAt(),
 		CompletedAt: todo.GetCompletedAt(),
+		CreatedBy:   string(todo.GetCreatedBy()),
+		CategoryID:  string(todo.GetCategoryID()),
+		DueDate:     todo.GetDueDate(),
+		Tags:        pq.StringArray(todo.GetTags()),
+		Source:      string(todo.GetSource()),
 	}
 }
 
+// ToModel converts the record to a domain Todo
+func (r *TodoRecord) ToModel() *model.Todo {
+	return toModel(r)
+}
+
 func toModel(r *TodoRecord) *model.Todo {
-	return model.NewTodoFromData(
+	todo := model.NewTodoFromData(
 		model.TodoID(r.ID),
 		r.Title,
 		r.Description,
@@ -25,5 +39,84 @@ func toModel(r *TodoRecord) *model.Todo {
 		r.CreatedAt,
 		r.UpdatedAt,
 		r.CompletedAt,
+		model.UserID(r.CreatedBy),
+		model.CategoryID(r.CategoryID),
+		r.DueDate,
+		[]string(r.Tags),
+		model.TodoSource(r.Source),
+	)
+	if len(r.Dependencies) > 0 {
+		dependsOn := make([]model.TodoID, len(r.Dependencies))
+		for i, dependency := range r.Dependencies {
+			dependsOn[i] = model.TodoID(dependency.DependsOnID)
+		}
+		todo.RestoreDependencies(dependsOn)
+	}
+	return todo
+}
+
+// fromDependencies builds the join table rows recording what todo depends on
+func fromDependencies(todo *model.Todo) []TodoDependencyRecord {
+	dependsOn := todo.GetDependsOn()
+	records := make([]TodoDependencyRecord, len(dependsOn))
+	for i, id := range dependsOn {
+		records[i] = TodoDependencyRecord{TodoID: string(todo.GetID()), DependsOnID: string(id)}
+	}
+	return records
+}
+
+func fromUserModel(user *model.User) *UserRecord {
+	return &UserRecord{
+		ID:          string(user.GetID()),
+		Email:       user.GetEmail(),
+		Username:    user.GetUsername(),
+		FirstName:   user.GetFirstName(),
+		LastName:    user.GetLastName(),
+		Role:        string(user.GetRole()),
+		Status:      string(user.GetStatus()),
+		CreatedAt:   user.GetCreatedAt(),
+		UpdatedAt:   user.GetUpdatedAt(),
+		LastLoginAt: user.GetLastLoginAt(),
+	}
+}
+
+func toUserModel(r *UserRecord) *model.User {
+	return model.NewUserFromData(
+		model.UserID(r.ID),
+		r.Email,
+		r.Username,
+		r.FirstName,
+		r.LastName,
+		model.UserRole(r.Role),
+		model.UserStatus(r.Status),
+		r.CreatedAt,
+		r.UpdatedAt,
+		r.LastLoginAt,
+	)
+}
+
+func fromCategoryModel(category *model.Category) *CategoryRecord {
+	return &CategoryRecord{
+		ID:          string(category.GetID()),
+		Name:        category.GetName(),
+		Description: category.GetDescription(),
+		Color:       string(category.GetColor()),
+		CreatedBy:   string(category.GetCreatedBy()),
+		CreatedAt:   category.GetCreatedAt(),
+		UpdatedAt:   category.GetUpdatedAt(),
+		IsDefault:   category.IsDefault(),
+	}
+}
+
+func toCategoryModel(r *CategoryRecord) *model.Category {
+	return model.NewCategoryFromData(
+		model.CategoryID(r.ID),
+		r.Name,
+		r.Description,
+		model.CategoryColor(r.Color),
+		model.UserID(r.CreatedBy),
+		r.CreatedAt,
+		r.UpdatedAt,
+		r.IsDefault,
 	)
 }
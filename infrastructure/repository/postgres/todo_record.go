@@ -3,6 +3,7 @@ package postgres
 import (
 	"time"
 
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
@@ -13,11 +14,28 @@ type TodoRecord struct {
 	Priority    string
 	Status      string
 	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	UpdatedAt   time.Time `gorm:"autoUpdateTime:false"` // owned by the domain, not GORM
 	CompletedAt *time.Time
+	CreatedBy   string         `gorm:"index"`
+	CategoryID  string         `gorm:"index"`
+	DueDate     *time.Time     `gorm:"index"`
+	Tags        pq.StringArray `gorm:"type:text[];not null;default:'{}'"`
+	Source      string         `gorm:"index"`
 	DeletedAt   gorm.DeletedAt `gorm:"index"` // optional for soft deletes
+	// Dependencies is only read through PreloadDependencies; writes go through replaceDependencies
+	Dependencies []TodoDependencyRecord `gorm:"foreignKey:TodoID;constraint:OnDelete:CASCADE"`
 }
 
 func (TodoRecord) TableName() string {
 	return "todos"
 }
+
+// TodoDependencyRecord is one row of the todo_dependencies join table
+type TodoDependencyRecord struct {
+	TodoID      string `gorm:"primaryKey"`
+	DependsOnID string `gorm:"primaryKey;index"`
+}
+
+func (TodoDependencyRecord) TableName() string {
+	return "todo_dependencies"
+}
@@ -1,52 +1,249 @@
 package postgres
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
+	"time"
 
 	_ "github.com/lib/pq"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/mr3iscuit/ddd-golang/application/port"
 	"github.com/mr3iscuit/ddd-golang/domain/model"
 )
 
+// defaultOrder sorts by creation time with the ID as a final tiebreaker so the order is total and stable
+const defaultOrder = "created_at ASC, id ASC"
+
 // PostgresTodoRepository implements port.TodoRepositoryPort using PostgreSQL and GORM
 type PostgresTodoRepository struct {
 	db *gorm.DB
+	// mismatchLogger, when set, is warned about writes affecting an unexpected number of rows
+	mismatchLogger *slog.Logger
+	// limits are the length limits todos are validated against before every write
+	limits model.TodoLimits
+}
+
+// PostgresTodoRepositoryOption configures a PostgresTodoRepository
+type PostgresTodoRepositoryOption func(*PostgresTodoRepository)
+
+// WithRowMismatchLogger logs a warning to logger whenever a write affects a
+// different number of rows than expected
+func WithRowMismatchLogger(logger *slog.Logger) PostgresTodoRepositoryOption {
+	return func(r *PostgresTodoRepository) {
+		r.mismatchLogger = logger
+	}
+}
+
+// WithTodoLimits validates written todos against limits instead of model.DefaultTodoLimits
+func WithTodoLimits(limits model.TodoLimits) PostgresTodoRepositoryOption {
+	return func(r *PostgresTodoRepository) {
+		r.limits = limits
+	}
 }
 
 // NewPostgresTodoRepository creates a new PostgresTodoRepository
-func NewPostgresTodoRepository(db *gorm.DB) *PostgresTodoRepository {
-	return &PostgresTodoRepository{db: db}
+func NewPostgresTodoRepository(db *gorm.DB, opts ...PostgresTodoRepositoryOption) *PostgresTodoRepository {
+	r := &PostgresTodoRepository{db: db, limits: model.DefaultTodoLimits()}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// checkRowsAffected warns when a write affected a different number of rows than expected
+func (r *PostgresTodoRepository) checkRowsAffected(operation string, expected, affected int64, attrs ...any) {
+	if r.mismatchLogger == nil || expected == affected {
+		return
+	}
+	attrs = append([]any{"operation", operation, "expected_rows", expected, "affected_rows", affected}, attrs...)
+	r.mismatchLogger.Warn("unexpected number of affected rows", attrs...)
+}
+
+// errTodoNotFound reports a write that matched no todo with the given ID
+func errTodoNotFound(id model.TodoID) error {
+	return fmt.Errorf("todo with id %s not found: %w", id, model.ErrTodoNotFound)
 }
 
 var _ port.TodoRepositoryPort = (*PostgresTodoRepository)(nil)
+var _ port.TransactionManager = (*PostgresTodoRepository)(nil)
 
 // Save inserts or updates a Todo in the database
-func (r *PostgresTodoRepository) Save(todo *model.Todo) error {
+func (r *PostgresTodoRepository) Save(ctx context.Context, todo *model.Todo) error {
+	if err := todo.Validate(r.limits); err != nil {
+		return fmt.Errorf("invalid todo %s: %w", todo.GetID(), err)
+	}
+
+	// Save upserts, so exactly one row is written whether or not the todo existed
 	record := fromModel(todo)
-	result := r.db.Save(record)
-	return result.Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Save(record)
+		if result.Error != nil {
+			return result.Error
+		}
+		r.checkRowsAffected("save", 1, result.RowsAffected, "todo_id", todo.GetID())
+		return replaceDependencies(tx, todo)
+	})
+}
+
+// Create inserts a new Todo and fails if one with the same ID exists
+func (r *PostgresTodoRepository) Create(ctx context.Context, todo *model.Todo) error {
+	if err := todo.Validate(r.limits); err != nil {
+		return fmt.Errorf("invalid todo %s: %w", todo.GetID(), err)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(fromModel(todo)).Error; err != nil {
+			return err
+		}
+		return replaceDependencies(tx, todo)
+	})
+}
+
+// Update overwrites an existing Todo and fails if none has its ID
+func (r *PostgresTodoRepository) Update(ctx context.Context, todo *model.Todo) error {
+	if err := todo.Validate(r.limits); err != nil {
+		return fmt.Errorf("invalid todo %s: %w", todo.GetID(), err)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Select("*") writes zero values too, so cleared fields are persisted
+		result := tx.Model(&TodoRecord{ID: string(todo.GetID())}).Select("*").Updates(fromModel(todo))
+		if result.Error != nil {
+			return result.Error
+		}
+		r.checkRowsAffected("update", 1, result.RowsAffected, "todo_id", todo.GetID())
+		if result.RowsAffected == 0 {
+			return errTodoNotFound(todo.GetID())
+		}
+		return replaceDependencies(tx, todo)
+	})
+}
+
+// UpdateAll overwrites several existing Todos in one transaction, rolling
+// back every write if any Todo is missing or invalid
+func (r *PostgresTodoRepository) UpdateAll(ctx context.Context, todos []*model.Todo) error {
+	return r.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		for _, todo := range todos {
+			if err := repo.Update(ctx, todo); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WithinTransaction calls fn with a repository bound to a database transaction,
+// committed when fn returns nil and rolled back otherwise
+func (r *PostgresTodoRepository) WithinTransaction(ctx context.Context, fn func(repo port.TodoRepositoryPort) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(r.WithDB(tx))
+	})
+}
+
+// WithDB returns a copy of the repository issuing its queries on db, such as a transaction
+func (r *PostgresTodoRepository) WithDB(db *gorm.DB) *PostgresTodoRepository {
+	return &PostgresTodoRepository{db: db, mismatchLogger: r.mismatchLogger, limits: r.limits}
+}
+
+// replaceDependencies rewrites the join table rows of todo to match its dependencies
+func replaceDependencies(tx *gorm.DB, todo *model.Todo) error {
+	if err := tx.Where("todo_id = ?", todo.GetID()).Delete(&TodoDependencyRecord{}).Error; err != nil {
+		return err
+	}
+	records := fromDependencies(todo)
+	if len(records) == 0 {
+		return nil
+	}
+	return tx.Create(&records).Error
+}
+
+// PreloadDependencies loads the dependencies of every todo a query returns, in ID
+// order. GORM repositories on other databases share it.
+func PreloadDependencies(query *gorm.DB) *gorm.DB {
+	return query.Preload("Dependencies", func(db *gorm.DB) *gorm.DB {
+		return db.Order("depends_on_id ASC")
+	})
 }
 
 // FindByID retrieves a Todo by ID
-func (r *PostgresTodoRepository) FindByID(id model.TodoID) (*model.Todo, error) {
+func (r *PostgresTodoRepository) FindByID(ctx context.Context, id model.TodoID) (*model.Todo, error) {
 	var record TodoRecord
-	result := r.db.Where("id = ?", id).First(&record)
+	result := PreloadDependencies(r.db.WithContext(ctx)).Where("id = ?", id).First(&record)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("todo with id %s not found", id)
+			return nil, fmt.Errorf("todo with id %s not found: %w", id, model.ErrTodoNotFound)
 		}
 		return nil, result.Error
 	}
 	return toModel(&record), nil
 }
 
-// FindAll retrieves all Todos
-func (r *PostgresTodoRepository) FindAll() ([]*model.Todo, error) {
+// FindByIDs retrieves the Todos with the given IDs ordered by creation time, skipping missing IDs
+func (r *PostgresTodoRepository) FindByIDs(ctx context.Context, ids []model.TodoID) ([]*model.Todo, error) {
+	if len(ids) == 0 {
+		return []*model.Todo{}, nil
+	}
+	var records []TodoRecord
+	result := PreloadDependencies(r.db.WithContext(ctx)).Where("id IN ?", ids).Order(defaultOrder).Find(&records)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	todos := make([]*model.Todo, len(records))
+	for i := range records {
+		todos[i] = toModel(&records[i])
+	}
+	return todos, nil
+}
+
+// FindAll retrieves all Todos ordered by creation time
+func (r *PostgresTodoRepository) FindAll(ctx context.Context) ([]*model.Todo, error) {
+	var records []TodoRecord
+	result := PreloadDependencies(r.db.WithContext(ctx)).Order(defaultOrder).Find(&records)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	todos := make([]*model.Todo, len(records))
+	for i := range records {
+		todos[i] = toModel(&records[i])
+	}
+	return todos, nil
+}
+
+// FindPaginated retrieves one page of Todos ordered by creation time together
+// with the total number of Todos. A non-positive limit returns the rest of the set.
+func (r *PostgresTodoRepository) FindPaginated(ctx context.Context, limit, offset int) ([]*model.Todo, int, error) {
+	var total int64
+	if err := r.db.WithContext(ctx).Model(&TodoRecord{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query := PreloadDependencies(r.db.WithContext(ctx)).Order(defaultOrder).Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var records []TodoRecord
+	if err := query.Find(&records).Error; err != nil {
+		return nil, 0, err
+	}
+
+	todos := make([]*model.Todo, len(records))
+	for i := range records {
+		todos[i] = toModel(&records[i])
+	}
+	return todos, int(total), nil
+}
+
+// FindByStatus retrieves all Todos in the given status
+func (r *PostgresTodoRepository) FindByStatus(ctx context.Context, status model.TodoStatus) ([]*model.Todo, error) {
 	var records []TodoRecord
-	result := r.db.Find(&records)
+	result := PreloadDependencies(r.db.WithContext(ctx)).Where("status = ?", status).Order(defaultOrder).Find(&records)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -58,14 +255,312 @@ func (r *PostgresTodoRepository) FindAll() ([]*model.Todo, error) {
 	return todos, nil
 }
 
+// FindByCreatedBy retrieves all Todos owned by the given user
+func (r *PostgresTodoRepository) FindByCreatedBy(ctx context.Context, userID model.UserID) ([]*model.Todo, error) {
+	var records []TodoRecord
+	result := PreloadDependencies(r.db.WithContext(ctx)).Where("created_by = ?", userID).Order(defaultOrder).Find(&records)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	todos := make([]*model.Todo, len(records))
+	for i := range records {
+		todos[i] = toModel(&records[i])
+	}
+	return todos, nil
+}
+
+// FindRandom retrieves a random pending Todo
+func (r *PostgresTodoRepository) FindRandom(ctx context.Context) (*model.Todo, error) {
+	var record TodoRecord
+	result := PreloadDependencies(r.db.WithContext(ctx)).Where("status = ?", model.TodoStatusPending).Order("random()").Limit(1).Take(&record)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("no pending todos found: %w", model.ErrTodoNotFound)
+		}
+		return nil, result.Error
+	}
+	return toModel(&record), nil
+}
+
+// FindStale retrieves pending Todos not updated within olderThan
+func (r *PostgresTodoRepository) FindStale(ctx context.Context, olderThan time.Duration) ([]*model.Todo, error) {
+	var records []TodoRecord
+	cutoff := time.Now().Add(-olderThan)
+	result := PreloadDependencies(r.db.WithContext(ctx)).Where("status = ? AND updated_at < ?", model.TodoStatusPending, cutoff).Order(defaultOrder).Find(&records)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	todos := make([]*model.Todo, len(records))
+	for i := range records {
+		todos[i] = toModel(&records[i])
+	}
+	return todos, nil
+}
+
+// ContainsPattern builds a LIKE pattern matching values that contain term
+// literally: its %, _ and \ are escaped for use with ESCAPE '\'. GORM
+// repositories on other databases share it.
+func ContainsPattern(term string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(term) + "%"
+}
+
+// Search retrieves the Todos whose title or description contains term, ignoring case
+func (r *PostgresTodoRepository) Search(ctx context.Context, term string) ([]*model.Todo, error) {
+	var records []TodoRecord
+	pattern := ContainsPattern(term)
+	result := PreloadDependencies(r.db.WithContext(ctx)).
+		Where(`title ILIKE ? ESCAPE '\' OR description ILIKE ? ESCAPE '\'`, pattern, pattern).
+		Order(defaultOrder).
+		Find(&records)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	todos := make([]*model.Todo, len(records))
+	for i := range records {
+		todos[i] = toModel(&records[i])
+	}
+	return todos, nil
+}
+
+// FindOrderedByPriority retrieves Todos from highest to lowest priority, optionally including archived ones
+func (r *PostgresTodoRepository) FindOrderedByPriority(ctx context.Context, includeArchived bool) ([]*model.Todo, error) {
+	order, err := OrderClause(model.TodoSort{Field: model.SortByPriority, Descending: true})
+	if err != nil {
+		return nil, err
+	}
+
+	query := PreloadDependencies(r.db.WithContext(ctx)).Order(order)
+	if !includeArchived {
+		query = query.Where("status <> ?", model.TodoStatusArchived)
+	}
+	var records []TodoRecord
+	if err := query.Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	todos := make([]*model.Todo, len(records))
+	for i := range records {
+		todos[i] = toModel(&records[i])
+	}
+	return todos, nil
+}
+
+// applyFilter narrows a query to the Todos matching the filter
+func applyFilter(query *gorm.DB, filter model.TodoFilter) *gorm.DB {
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Priority != "" {
+		query = query.Where("priority = ?", filter.Priority)
+	}
+	if filter.OverdueAt != nil {
+		query = query.Where("status = ? AND due_date IS NOT NULL AND due_date < ?", model.TodoStatusPending, *filter.OverdueAt)
+	}
+	if filter.Tag != "" {
+		query = query.Where("? = ANY(tags)", filter.Tag)
+	}
+	if filter.Source != "" {
+		query = query.Where("source = ?", filter.Source)
+	}
+	if filter.CreatedBy != "" {
+		query = query.Where("created_by = ?", filter.CreatedBy)
+	}
+	if filter.Category != "" {
+		query = query.Where("category_id = ?", filter.Category)
+	}
+	if filter.Search != "" {
+		pattern := ContainsPattern(filter.Search)
+		query = query.Where(`(title ILIKE ? ESCAPE '\' OR description ILIKE ? ESCAPE '\')`, pattern, pattern)
+	}
+	return query
+}
+
+// sortColumns maps each sort field to a fixed SQL expression, so ORDER BY
+// clauses are never built from caller-supplied text
+var sortColumns = map[model.TodoSortField]string{
+	"":                    "created_at",
+	model.SortByCreatedAt: "created_at",
+	model.SortByUpdatedAt: "updated_at",
+	model.SortByTitle:     "title",
+	model.SortByPriority:  "CASE priority WHEN 'low' THEN 1 WHEN 'medium' THEN 2 WHEN 'high' THEN 3 ELSE 0 END",
+}
+
+// OrderClause builds the ORDER BY clause for a sort, breaking ties like defaultOrder.
+// The expressions are portable, so GORM repositories on other databases share it.
+func OrderClause(sort model.TodoSort) (string, error) {
+	column, ok := sortColumns[sort.Field]
+	if !ok {
+		return "", fmt.Errorf("unsupported sort field %q", sort.Field)
+	}
+	direction := "ASC"
+	if sort.Descending {
+		direction = "DESC"
+	}
+	return fmt.Sprintf("%s %s, %s", column, direction, defaultOrder), nil
+}
+
+// FindFiltered retrieves one page of the Todos matching the filter in the given order,
+// together with the total number of matches
+func (r *PostgresTodoRepository) FindFiltered(ctx context.Context, filter model.TodoFilter, sort model.TodoSort, limit, offset int) ([]*model.Todo, int, error) {
+	order, err := OrderClause(sort)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := applyFilter(r.db.WithContext(ctx).Model(&TodoRecord{}), filter).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query := applyFilter(PreloadDependencies(r.db.WithContext(ctx)), filter).Order(order).Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var records []TodoRecord
+	if err := query.Find(&records).Error; err != nil {
+		return nil, 0, err
+	}
+
+	todos := make([]*model.Todo, len(records))
+	for i := range records {
+		todos[i] = toModel(&records[i])
+	}
+	return todos, int(total), nil
+}
+
+// Count returns the number of Todos matching the filter
+func (r *PostgresTodoRepository) Count(ctx context.Context, filter model.TodoFilter) (int, error) {
+	var count int64
+	if err := applyFilter(r.db.WithContext(ctx).Model(&TodoRecord{}), filter).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// FindDeletedIDs lists the IDs of soft-deleted Todos, oldest deletion first
+func (r *PostgresTodoRepository) FindDeletedIDs(ctx context.Context) ([]model.TodoID, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Unscoped().Model(&TodoRecord{}).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at ASC, id ASC").
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	todoIDs := make([]model.TodoID, len(ids))
+	for i, id := range ids {
+		todoIDs[i] = model.TodoID(id)
+	}
+	return todoIDs, nil
+}
+
 // Delete removes a Todo by ID
-func (r *PostgresTodoRepository) Delete(id model.TodoID) error {
-	result := r.db.Delete(&TodoRecord{}, "id = ?", id)
+func (r *PostgresTodoRepository) Delete(ctx context.Context, id model.TodoID) error {
+	result := r.db.WithContext(ctx).Delete(&TodoRecord{}, "id = ?", id)
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("todo with id %s not found", id)
+		return errTodoNotFound(id)
 	}
 	return nil
 }
+
+// DeleteByIDs removes all Todos with the given IDs in a single statement
+// and returns, once each, the IDs that were not present. The statement
+// returns the IDs it deleted, so the report matches what was deleted.
+func (r *PostgresTodoRepository) DeleteByIDs(ctx context.Context, ids []model.TodoID) ([]model.TodoID, error) {
+	ids = uniqueIDs(ids)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var deleted []TodoRecord
+	result := r.db.WithContext(ctx).Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("id IN ?", ids).Delete(&deleted)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	found := make(map[model.TodoID]bool, len(deleted))
+	for _, record := range deleted {
+		found[model.TodoID(record.ID)] = true
+	}
+	var missing []model.TodoID
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
+// uniqueIDs returns ids without repetitions, keeping the first occurrence of each
+func uniqueIDs(ids []model.TodoID) []model.TodoID {
+	seen := make(map[model.TodoID]bool, len(ids))
+	unique := make([]model.TodoID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// dependencyLockKey identifies the transaction-scoped advisory lock taken while
+// the dependency graph is checked and changed
+const dependencyLockKey = 7166017
+
+// LockDependencies takes the dependency advisory lock and locks the rows of the
+// given Todos until the surrounding transaction ends. Row locks alone are not
+// enough: edits to disjoint todos can still close a cycle together, so every
+// dependency change also waits for the advisory lock.
+func (r *PostgresTodoRepository) LockDependencies(ctx context.Context, ids ...model.TodoID) error {
+	if err := r.db.WithContext(ctx).Exec("SELECT pg_advisory_xact_lock(?)", dependencyLockKey).Error; err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	var locked []string
+	return r.db.WithContext(ctx).Model(&TodoRecord{}).Where("id IN ?", ids).Order("id").
+		Clauses(clause.Locking{Strength: "UPDATE"}).Pluck("id", &locked).Error
+}
+
+// Ping checks that the database accepts queries
+func (r *PostgresTodoRepository) Ping(ctx context.Context) error {
+	return r.db.WithContext(ctx).Exec("SELECT 1").Error
+}
+
+// CompletionTimeStats aggregates, per priority, the average time from creation
+// to completion over todos that have a completion time
+func (r *PostgresTodoRepository) CompletionTimeStats(ctx context.Context) ([]model.CompletionTimeStat, error) {
+	var rows []struct {
+		Priority       string
+		Completed      int
+		AverageSeconds float64
+	}
+	result := r.db.WithContext(ctx).Model(&TodoRecord{}).
+		Select("priority, COUNT(*) AS completed, AVG(EXTRACT(EPOCH FROM (completed_at - created_at))) AS average_seconds").
+		Where("completed_at IS NOT NULL").
+		Group("priority").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	stats := make([]model.CompletionTimeStat, len(rows))
+	for i, row := range rows {
+		stats[i] = model.CompletionTimeStat{
+			Priority:        model.TodoPriority(row.Priority),
+			Completed:       row.Completed,
+			AverageDuration: time.Duration(row.AverageSeconds * float64(time.Second)),
+		}
+	}
+	return stats, nil
+}
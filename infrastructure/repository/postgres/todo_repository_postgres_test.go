@@ -1,51 +1,49 @@
-package postgres
+package postgres_test
 
 import (
-	"os"
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
-	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	"github.com/mr3iscuit/ddd-golang/application/port"
 	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository/postgres"
+	"github.com/mr3iscuit/ddd-golang/pkg/testsupport"
 )
 
 type PostgresRepoTestSuite struct {
 	suite.Suite
-	db   *gorm.DB
-	repo *PostgresTodoRepository
+	db      *gorm.DB
+	cleanup func()
+	repo    *postgres.PostgresTodoRepository
 }
 
 func (s *PostgresRepoTestSuite) SetupSuite() {
-	dsn := os.Getenv("TEST_POSTGRES_DSN")
-	if dsn == "" {
-		dsn = "host=localhost user=todo_user password=todo_password dbname=todo_db port=5432 sslmode=disable"
-	}
-
-	var err error
-	s.db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{})
-	s.Require().NoError(err)
-
-	// Auto-migrate the schema
-	err = s.db.AutoMigrate(&TodoRecord{})
-	s.Require().NoError(err)
+	s.db, s.cleanup = testsupport.NewTestDB(s.T())
+	s.repo = postgres.NewPostgresTodoRepository(s.db)
+}
 
-	s.repo = NewPostgresTodoRepository(s.db)
+func (s *PostgresRepoTestSuite) TearDownSuite() {
+	s.cleanup()
 }
 
 func (s *PostgresRepoTestSuite) TearDownTest() {
 	// Clear all rows after each test
-	s.db.Exec("DELETE FROM todos")
+	testsupport.ResetDB(s.T(), s.db)
 }
 
 func (s *PostgresRepoTestSuite) TestSaveAndFindByID() {
 	todo := model.NewTodo("Test Title", "Test Description", model.TodoPriorityHigh)
-	err := s.repo.Save(todo)
+	err := s.repo.Save(context.Background(), todo)
 	s.NoError(err)
 
-	found, err := s.repo.FindByID(todo.GetID())
+	found, err := s.repo.FindByID(context.Background(), todo.GetID())
 	s.NoError(err)
 	s.Equal(todo.GetID(), found.GetID())
 	s.Equal(todo.GetTitle(), found.GetTitle())
@@ -56,14 +54,214 @@ func (s *PostgresRepoTestSuite) TestSaveAndFindByID() {
 	s.WithinDuration(todo.GetUpdatedAt(), found.GetUpdatedAt(), time.Second)
 }
 
+func (s *PostgresRepoTestSuite) TestSaveAndFindCategoryID() {
+	todo := model.NewSimpleTodo("Filed")
+	s.NoError(todo.AssignCategory("cat-1"))
+	s.NoError(s.repo.Save(context.Background(), todo))
+
+	found, err := s.repo.FindByID(context.Background(), todo.GetID())
+	s.NoError(err)
+	s.Equal(model.CategoryID("cat-1"), found.GetCategoryID())
+}
+
+func (s *PostgresRepoTestSuite) TestSaveAndFilterByTag() {
+	tagged := model.NewSimpleTodo("Tagged")
+	s.NoError(tagged.AddTag("work"))
+	s.NoError(tagged.AddTag("urgent"))
+	s.NoError(s.repo.Save(context.Background(), tagged))
+	s.NoError(s.repo.Save(context.Background(), model.NewSimpleTodo("Untagged")))
+
+	found, err := s.repo.FindByID(context.Background(), tagged.GetID())
+	s.NoError(err)
+	s.Equal([]string{"work", "urgent"}, found.GetTags())
+
+	todos, total, err := s.repo.FindFiltered(context.Background(), model.TodoFilter{Tag: "work"}, model.TodoSort{}, 0, 0)
+	s.NoError(err)
+	s.Equal(1, total)
+	s.Equal(tagged.GetID(), todos[0].GetID())
+}
+
+func (s *PostgresRepoTestSuite) TestWithinTransactionRollsBackOnError() {
+	ctx := context.Background()
+	todo := model.NewSimpleTodo("Title")
+	s.NoError(s.repo.Save(ctx, todo))
+
+	err := s.repo.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		s.NoError(todo.MarkAsCompleted())
+		s.NoError(repo.Update(ctx, todo))
+		s.NoError(repo.Create(ctx, model.NewSimpleTodo("Created")))
+		return errors.New("abort")
+	})
+	s.EqualError(err, "abort")
+
+	todos, err := s.repo.FindAll(ctx)
+	s.NoError(err)
+	s.Require().Len(todos, 1)
+	s.Equal(model.TodoStatusPending, todos[0].GetStatus())
+
+	s.NoError(s.repo.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		return repo.Update(ctx, todo)
+	}))
+	found, err := s.repo.FindByID(ctx, todo.GetID())
+	s.NoError(err)
+	s.Equal(model.TodoStatusCompleted, found.GetStatus())
+}
+
+func (s *PostgresRepoTestSuite) TestFindByIDs() {
+	ctx := context.Background()
+	design, build := model.NewSimpleTodo("Design"), model.NewSimpleTodo("Build")
+	s.NoError(build.AddDependency(design))
+	s.NoError(s.repo.Save(ctx, design))
+	s.NoError(s.repo.Save(ctx, build))
+	s.NoError(s.repo.Save(ctx, model.NewSimpleTodo("Other")))
+
+	todos, err := s.repo.FindByIDs(ctx, []model.TodoID{build.GetID(), "missing", design.GetID()})
+	s.NoError(err)
+	s.Require().Len(todos, 2)
+	s.Equal(design.GetID(), todos[0].GetID())
+	s.Equal(build.GetID(), todos[1].GetID())
+	s.Equal([]model.TodoID{design.GetID()}, todos[1].GetDependsOn())
+
+	todos, err = s.repo.FindByIDs(ctx, nil)
+	s.NoError(err)
+	s.Empty(todos)
+}
+
+func (s *PostgresRepoTestSuite) TestLockDependenciesBlocksOtherTransactions() {
+	ctx := context.Background()
+	design, build := model.NewSimpleTodo("Design"), model.NewSimpleTodo("Build")
+	s.NoError(s.repo.Save(ctx, design))
+	s.NoError(s.repo.Save(ctx, build))
+
+	s.NoError(s.repo.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		s.NoError(repo.LockDependencies(ctx, build.GetID(), design.GetID()))
+
+		// Another dependency change, even on unrelated todos, waits for this transaction
+		waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		s.Error(s.repo.WithinTransaction(waitCtx, func(other port.TodoRepositoryPort) error {
+			return other.LockDependencies(waitCtx, model.NewSimpleTodo("Unrelated").GetID())
+		}))
+		return nil
+	}))
+
+	s.NoError(s.repo.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		return repo.LockDependencies(ctx, build.GetID())
+	}))
+}
+
+func (s *PostgresRepoTestSuite) TestSaveAndFindDependencies() {
+	design, review, build := model.NewSimpleTodo("Design"), model.NewSimpleTodo("Review"), model.NewSimpleTodo("Build")
+	s.NoError(build.AddDependency(design))
+	s.NoError(build.AddDependency(review))
+	for _, todo := range []*model.Todo{design, review, build} {
+		s.NoError(s.repo.Save(context.Background(), todo))
+	}
+
+	found, err := s.repo.FindByID(context.Background(), build.GetID())
+	s.NoError(err)
+	s.ElementsMatch([]model.TodoID{design.GetID(), review.GetID()}, found.GetDependsOn())
+
+	// Removed dependencies are deleted from the join table
+	s.NoError(found.RemoveDependency(design.GetID()))
+	s.NoError(s.repo.Update(context.Background(), found))
+
+	todos, err := s.repo.FindAll(context.Background())
+	s.NoError(err)
+	s.Require().Len(todos, 3)
+	for _, todo := range todos {
+		if todo.GetID() == build.GetID() {
+			s.Equal([]model.TodoID{review.GetID()}, todo.GetDependsOn())
+		} else {
+			s.Empty(todo.GetDependsOn())
+		}
+	}
+}
+
+func (s *PostgresRepoTestSuite) TestSaveAndFilterBySource() {
+	imported := model.NewSimpleTodo("Imported")
+	s.NoError(imported.SetSource(model.TodoSourceImport))
+	s.NoError(s.repo.Save(context.Background(), imported))
+	s.NoError(s.repo.Save(context.Background(), model.NewSimpleTodo("Unknown origin")))
+
+	found, err := s.repo.FindByID(context.Background(), imported.GetID())
+	s.NoError(err)
+	s.Equal(model.TodoSourceImport, found.GetSource())
+
+	count, err := s.repo.Count(context.Background(), model.TodoFilter{Source: model.TodoSourceImport})
+	s.NoError(err)
+	s.Equal(1, count)
+}
+
+func (s *PostgresRepoTestSuite) TestFindFilteredOverdue() {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	yesterday := now.Add(-24 * time.Hour)
+	tomorrow := now.Add(24 * time.Hour)
+	for _, todo := range []*model.Todo{
+		model.NewTodoFromData("late", "Late", "", model.TodoStatusPending, model.TodoPriorityLow, yesterday, yesterday, nil, "", "", &yesterday, nil, ""),
+		model.NewTodoFromData("soon", "Soon", "", model.TodoStatusPending, model.TodoPriorityLow, yesterday, yesterday, nil, "", "", &tomorrow, nil, ""),
+		model.NewTodoFromData("done", "Done", "", model.TodoStatusCompleted, model.TodoPriorityLow, yesterday, now, &now, "", "", &yesterday, nil, ""),
+	} {
+		s.NoError(s.repo.Save(context.Background(), todo))
+	}
+
+	todos, total, err := s.repo.FindFiltered(context.Background(), model.TodoFilter{OverdueAt: &now}, model.TodoSort{}, 0, 0)
+	s.NoError(err)
+	s.Equal(1, total)
+	s.Equal(model.TodoID("late"), todos[0].GetID())
+	s.WithinDuration(yesterday, *todos[0].GetDueDate(), time.Second)
+}
+
+func (s *PostgresRepoTestSuite) TestCreateRejectsExistingID() {
+	todo := model.NewSimpleTodo("Original")
+	s.NoError(s.repo.Create(context.Background(), todo))
+	s.Error(s.repo.Create(context.Background(), todo))
+}
+
+func (s *PostgresRepoTestSuite) TestUpdateRejectsMissingID() {
+	todo := model.NewSimpleTodo("Missing")
+	s.ErrorIs(s.repo.Update(context.Background(), todo), model.ErrTodoNotFound)
+
+	s.NoError(s.repo.Create(context.Background(), todo))
+	_, err := todo.UpdateTitle("Renamed", model.DefaultTodoLimits())
+	s.NoError(err)
+	s.NoError(s.repo.Update(context.Background(), todo))
+
+	found, err := s.repo.FindByID(context.Background(), todo.GetID())
+	s.NoError(err)
+	s.Equal("Renamed", found.GetTitle())
+}
+
+func (s *PostgresRepoTestSuite) TestUpdateMissingIDLogsRowMismatch() {
+	var logs bytes.Buffer
+	repo := postgres.NewPostgresTodoRepository(s.db, postgres.WithRowMismatchLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+	todo := model.NewSimpleTodo("Missing")
+
+	s.ErrorIs(repo.Update(context.Background(), todo), model.ErrTodoNotFound)
+	s.Contains(logs.String(), "unexpected number of affected rows")
+	s.Contains(logs.String(), "operation=update")
+	s.Contains(logs.String(), "affected_rows=0")
+}
+
+func (s *PostgresRepoTestSuite) TestSaveRejectsInvalidTodo() {
+	now := time.Now()
+	corrupt := model.NewTodoFromData("corrupt", "Done", "", model.TodoStatusCompleted, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, "")
+
+	err := s.repo.Save(context.Background(), corrupt)
+	s.ErrorContains(err, "completed todo must have a completion time")
+
+	_, err = s.repo.FindByID(context.Background(), "corrupt")
+	s.Error(err)
+}
+
 func (s *PostgresRepoTestSuite) TestFindAll() {
 	t1 := model.NewTodo("First", "Desc1", model.TodoPriorityLow)
 	t2 := model.NewTodo("Second", "Desc2", model.TodoPriorityMedium)
 
-	s.NoError(s.repo.Save(t1))
-	s.NoError(s.repo.Save(t2))
+	s.NoError(s.repo.Save(context.Background(), t1))
+	s.NoError(s.repo.Save(context.Background(), t2))
 
-	all, err := s.repo.FindAll()
+	all, err := s.repo.FindAll(context.Background())
 	s.NoError(err)
 	s.Len(all, 2)
 
@@ -75,26 +273,260 @@ func (s *PostgresRepoTestSuite) TestFindAll() {
 	s.Contains(ids, t2.GetID())
 }
 
+func (s *PostgresRepoTestSuite) TestFindAllBreaksTiesByID() {
+	now := time.Now()
+	for _, id := range []model.TodoID{"d", "b", "e", "a", "c"} {
+		s.NoError(s.repo.Save(context.Background(), model.NewTodoFromData(id, "Same time", "", model.TodoStatusPending, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, "")))
+	}
+
+	firstFetch, err := s.repo.FindAll(context.Background())
+	s.NoError(err)
+	secondFetch, err := s.repo.FindAll(context.Background())
+	s.NoError(err)
+
+	expected := []model.TodoID{"a", "b", "c", "d", "e"}
+	for i, id := range expected {
+		s.Equal(id, firstFetch[i].GetID())
+		s.Equal(id, secondFetch[i].GetID())
+	}
+}
+
+func (s *PostgresRepoTestSuite) TestFindPaginated() {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	for i, id := range []model.TodoID{"a", "b", "c"} {
+		created := now.Add(time.Duration(i) * time.Minute)
+		s.NoError(s.repo.Save(context.Background(), model.NewTodoFromData(id, "Todo "+string(id), "", model.TodoStatusPending, model.TodoPriorityLow, created, created, nil, "", "", nil, nil, "")))
+	}
+
+	page, total, err := s.repo.FindPaginated(context.Background(), 2, 1)
+	s.NoError(err)
+	s.Equal(3, total)
+	s.Require().Len(page, 2)
+	s.Equal(model.TodoID("b"), page[0].GetID())
+	s.Equal(model.TodoID("c"), page[1].GetID())
+
+	page, total, err = s.repo.FindPaginated(context.Background(), 0, 0)
+	s.NoError(err)
+	s.Equal(3, total)
+	s.Len(page, 3)
+}
+
+func (s *PostgresRepoTestSuite) TestFindFiltered() {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	for _, todo := range []*model.Todo{
+		model.NewTodoFromData("a", "Banana", "", model.TodoStatusPending, model.TodoPriorityHigh, now, now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("b", "Apple", "", model.TodoStatusPending, model.TodoPriorityLow, now.Add(time.Minute), now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("c", "Cherry", "", model.TodoStatusPending, model.TodoPriorityMedium, now.Add(2*time.Minute), now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("d", "Date", "", model.TodoStatusPending, model.TodoPriorityHigh, now.Add(3*time.Minute), now, nil, "", "", nil, nil, ""),
+	} {
+		s.NoError(s.repo.Save(context.Background(), todo))
+	}
+
+	page, total, err := s.repo.FindFiltered(context.Background(), model.TodoFilter{}, model.TodoSort{Field: model.SortByPriority, Descending: true}, 0, 0)
+	s.NoError(err)
+	s.Equal(4, total)
+	s.Require().Len(page, 4)
+	s.Equal([]model.TodoID{"a", "d", "c", "b"}, []model.TodoID{page[0].GetID(), page[1].GetID(), page[2].GetID(), page[3].GetID()})
+
+	page, total, err = s.repo.FindFiltered(context.Background(), model.TodoFilter{Priority: model.TodoPriorityHigh}, model.TodoSort{Field: model.SortByTitle}, 1, 1)
+	s.NoError(err)
+	s.Equal(2, total)
+	s.Require().Len(page, 1)
+	s.Equal(model.TodoID("d"), page[0].GetID())
+}
+
+func (s *PostgresRepoTestSuite) TestFindByStatus() {
+	pending := model.NewTodo("Pending", "", model.TodoPriorityLow)
+	done := model.NewTodo("Done", "", model.TodoPriorityLow)
+	s.NoError(done.MarkAsCompleted())
+	s.NoError(s.repo.Save(context.Background(), pending))
+	s.NoError(s.repo.Save(context.Background(), done))
+
+	found, err := s.repo.FindByStatus(context.Background(), model.TodoStatusCompleted)
+	s.NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(done.GetID(), found[0].GetID())
+	s.Equal(model.TodoStatusCompleted, found[0].GetStatus())
+}
+
+func (s *PostgresRepoTestSuite) TestFindByCreatedBy() {
+	mine := model.NewTodo("Mine", "", model.TodoPriorityLow)
+	s.NoError(mine.AssignCreator("user-1"))
+	theirs := model.NewTodo("Theirs", "", model.TodoPriorityLow)
+	s.NoError(theirs.AssignCreator("user-2"))
+	s.NoError(s.repo.Save(context.Background(), mine))
+	s.NoError(s.repo.Save(context.Background(), theirs))
+
+	found, err := s.repo.FindByCreatedBy(context.Background(), "user-1")
+	s.NoError(err)
+	s.Len(found, 1)
+	s.Equal(mine.GetID(), found[0].GetID())
+	s.Equal(model.UserID("user-1"), found[0].GetCreatedBy())
+}
+
+func (s *PostgresRepoTestSuite) TestFindRandom() {
+	_, err := s.repo.FindRandom(context.Background())
+	s.ErrorIs(err, model.ErrTodoNotFound)
+
+	pending := model.NewTodo("Pending", "", model.TodoPriorityLow)
+	done := model.NewTodo("Done", "", model.TodoPriorityLow)
+	s.NoError(done.MarkAsCompleted())
+	s.NoError(s.repo.Save(context.Background(), pending))
+	s.NoError(s.repo.Save(context.Background(), done))
+
+	found, err := s.repo.FindRandom(context.Background())
+	s.NoError(err)
+	s.Equal(pending.GetID(), found.GetID())
+}
+
+func (s *PostgresRepoTestSuite) TestFindStale() {
+	longAgo := time.Now().Add(-60 * 24 * time.Hour)
+	recently := time.Now().Add(-time.Hour)
+	for _, todo := range []*model.Todo{
+		model.NewTodoFromData("stale", "Forgotten", "", model.TodoStatusPending, model.TodoPriorityLow, longAgo, longAgo, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("fresh", "Recent", "", model.TodoStatusPending, model.TodoPriorityLow, longAgo, recently, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("done", "Done long ago", "", model.TodoStatusCompleted, model.TodoPriorityLow, longAgo, longAgo, &longAgo, "", "", nil, nil, ""),
+	} {
+		s.NoError(s.repo.Save(context.Background(), todo))
+	}
+
+	stale, err := s.repo.FindStale(context.Background(), 30*24*time.Hour)
+	s.NoError(err)
+	s.Len(stale, 1)
+	s.Equal(model.TodoID("stale"), stale[0].GetID())
+}
+
+func (s *PostgresRepoTestSuite) TestSearch() {
+	report := model.NewTodo("Quarterly REPORT", "", model.TodoPriorityLow)
+	discount := model.NewTodo("Discount", "100% off", model.TodoPriorityLow)
+	for _, todo := range []*model.Todo{report, discount, model.NewSimpleTodo("Groceries")} {
+		s.NoError(s.repo.Save(context.Background(), todo))
+	}
+
+	found, err := s.repo.Search(context.Background(), "report")
+	s.NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(report.GetID(), found[0].GetID())
+
+	// LIKE wildcards in the term match literally
+	found, err = s.repo.Search(context.Background(), "0%")
+	s.NoError(err)
+	s.Require().Len(found, 1)
+	s.Equal(discount.GetID(), found[0].GetID())
+
+	found, err = s.repo.Search(context.Background(), "_")
+	s.NoError(err)
+	s.Empty(found)
+}
+
+func (s *PostgresRepoTestSuite) TestCount() {
+	done := model.NewTodo("Write report", "quarterly numbers", model.TodoPriorityHigh)
+	s.NoError(done.MarkAsCompleted())
+	for _, todo := range []*model.Todo{
+		model.NewTodo("Buy milk", "", model.TodoPriorityLow),
+		model.NewTodo("Call bank", "about the REPORT", model.TodoPriorityHigh),
+		model.NewTodo("Plan trip", "", model.TodoPriorityHigh),
+		done,
+	} {
+		s.NoError(s.repo.Save(context.Background(), todo))
+	}
+
+	count, err := s.repo.Count(context.Background(), model.TodoFilter{})
+	s.NoError(err)
+	s.Equal(4, count)
+
+	count, err = s.repo.Count(context.Background(), model.TodoFilter{Status: model.TodoStatusPending, Priority: model.TodoPriorityHigh})
+	s.NoError(err)
+	s.Equal(2, count)
+
+	count, err = s.repo.Count(context.Background(), model.TodoFilter{Search: "report", Status: model.TodoStatusCompleted})
+	s.NoError(err)
+	s.Equal(1, count)
+}
+
 func (s *PostgresRepoTestSuite) TestDelete() {
 	todo := model.NewTodo("To be deleted", "", model.TodoPriorityLow)
-	s.NoError(s.repo.Save(todo))
+	s.NoError(s.repo.Save(context.Background(), todo))
 
-	err := s.repo.Delete(todo.GetID())
+	err := s.repo.Delete(context.Background(), todo.GetID())
 	s.NoError(err)
 
-	_, err = s.repo.FindByID(todo.GetID())
+	_, err = s.repo.FindByID(context.Background(), todo.GetID())
 	s.Error(err)
 	s.Contains(err.Error(), "not found")
 }
 
+func (s *PostgresRepoTestSuite) TestFindDeletedIDs() {
+	kept := model.NewTodo("Kept", "", model.TodoPriorityLow)
+	gone := model.NewTodo("Gone", "", model.TodoPriorityLow)
+	s.NoError(s.repo.Save(context.Background(), kept))
+	s.NoError(s.repo.Save(context.Background(), gone))
+	s.NoError(s.repo.Delete(context.Background(), gone.GetID()))
+
+	deleted, err := s.repo.FindDeletedIDs(context.Background())
+	s.NoError(err)
+	s.Equal([]model.TodoID{gone.GetID()}, deleted)
+}
+
+func (s *PostgresRepoTestSuite) TestDeleteByIDs() {
+	t1 := model.NewTodo("First", "", model.TodoPriorityLow)
+	t2 := model.NewTodo("Second", "", model.TodoPriorityLow)
+	s.NoError(s.repo.Save(context.Background(), t1))
+	s.NoError(s.repo.Save(context.Background(), t2))
+
+	missing, err := s.repo.DeleteByIDs(context.Background(), []model.TodoID{t1.GetID(), "absent", t2.GetID()})
+	s.NoError(err)
+	s.Equal([]model.TodoID{"absent"}, missing)
+
+	all, err := s.repo.FindAll(context.Background())
+	s.NoError(err)
+	s.Empty(all)
+}
+
+func (s *PostgresRepoTestSuite) TestDeleteByIDsReportsDuplicatesOnce() {
+	todo := model.NewTodo("First", "", model.TodoPriorityLow)
+	s.NoError(s.repo.Save(context.Background(), todo))
+
+	missing, err := s.repo.DeleteByIDs(context.Background(), []model.TodoID{"absent", todo.GetID(), "absent", todo.GetID()})
+	s.NoError(err)
+	s.Equal([]model.TodoID{"absent"}, missing)
+}
+
+func (s *PostgresRepoTestSuite) TestCompletionTimeStats() {
+	created := time.Now().Add(-24 * time.Hour)
+	oneHour := created.Add(time.Hour)
+	threeHours := created.Add(3 * time.Hour)
+	for _, todo := range []*model.Todo{
+		model.NewTodoFromData("h1", "High 1", "", model.TodoStatusCompleted, model.TodoPriorityHigh, created, oneHour, &oneHour, "", "", nil, nil, ""),
+		model.NewTodoFromData("h2", "High 2", "", model.TodoStatusCompleted, model.TodoPriorityHigh, created, threeHours, &threeHours, "", "", nil, nil, ""),
+		model.NewTodoFromData("l1", "Low 1", "", model.TodoStatusCompleted, model.TodoPriorityLow, created, oneHour, &oneHour, "", "", nil, nil, ""),
+		model.NewTodoFromData("p1", "Pending", "", model.TodoStatusPending, model.TodoPriorityHigh, created, created, nil, "", "", nil, nil, ""),
+	} {
+		s.NoError(s.repo.Save(context.Background(), todo))
+	}
+
+	stats, err := s.repo.CompletionTimeStats(context.Background())
+	s.NoError(err)
+
+	byPriority := make(map[model.TodoPriority]model.CompletionTimeStat)
+	for _, stat := range stats {
+		byPriority[stat.Priority] = stat
+	}
+	s.Len(byPriority, 2)
+	s.Equal(2, byPriority[model.TodoPriorityHigh].Completed)
+	s.InDelta(2*time.Hour, byPriority[model.TodoPriorityHigh].AverageDuration, float64(time.Millisecond))
+	s.Equal(1, byPriority[model.TodoPriorityLow].Completed)
+	s.InDelta(time.Hour, byPriority[model.TodoPriorityLow].AverageDuration, float64(time.Millisecond))
+}
+
 func (s *PostgresRepoTestSuite) TestMarkAsCompleted() {
 	todo := model.NewTodo("Complete Me", "", model.TodoPriorityMedium)
-	s.NoError(s.repo.Save(todo))
+	s.NoError(s.repo.Save(context.Background(), todo))
 
 	s.NoError(todo.MarkAsCompleted())
-	s.NoError(s.repo.Save(todo))
+	s.NoError(s.repo.Save(context.Background(), todo))
 
-	found, err := s.repo.FindByID(todo.GetID())
+	found, err := s.repo.FindByID(context.Background(), todo.GetID())
 	s.NoError(err)
 	s.Equal(model.TodoStatusCompleted, found.GetStatus())
 	s.NotNil(found.GetCompletedAt())
@@ -103,16 +535,20 @@ func (s *PostgresRepoTestSuite) TestMarkAsCompleted() {
 
 func (s *PostgresRepoTestSuite) TestArchiveTodo() {
 	todo := model.NewTodo("Archive Me", "", model.TodoPriorityHigh)
-	s.NoError(s.repo.Save(todo))
+	s.NoError(s.repo.Save(context.Background(), todo))
 
 	s.NoError(todo.ArchiveTodo())
-	s.NoError(s.repo.Save(todo))
+	s.NoError(s.repo.Save(context.Background(), todo))
 
-	found, err := s.repo.FindByID(todo.GetID())
+	found, err := s.repo.FindByID(context.Background(), todo.GetID())
 	s.NoError(err)
 	s.Equal(model.TodoStatusArchived, found.GetStatus())
 }
 
+func (s *PostgresRepoTestSuite) TestPing() {
+	s.NoError(s.repo.Ping(context.Background()))
+}
+
 func TestPostgresRepoTestSuite(t *testing.T) {
 	suite.Run(t, new(PostgresRepoTestSuite))
 }
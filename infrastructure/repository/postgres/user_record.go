@@ -0,0 +1,20 @@
+package postgres
+
+import "time"
+
+type UserRecord struct {
+	ID          string `gorm:"primaryKey"`
+	Email       string `gorm:"uniqueIndex"`
+	Username    string
+	FirstName   string
+	LastName    string
+	Role        string
+	Status      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time `gorm:"autoUpdateTime:false"` // owned by the domain, not GORM
+	LastLoginAt *time.Time
+}
+
+func (UserRecord) TableName() string {
+	return "users"
+}
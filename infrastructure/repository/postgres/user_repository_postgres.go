@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// PostgresUserRepository implements port.UserRepositoryPort using PostgreSQL and GORM
+type PostgresUserRepository struct {
+	db *gorm.DB
+}
+
+// NewPostgresUserRepository creates a new PostgresUserRepository
+func NewPostgresUserRepository(db *gorm.DB) *PostgresUserRepository {
+	return &PostgresUserRepository{db: db}
+}
+
+var _ port.UserRepositoryPort = (*PostgresUserRepository)(nil)
+
+// Save inserts or updates a User in the database
+func (r *PostgresUserRepository) Save(user *model.User) error {
+	return r.db.Save(fromUserModel(user)).Error
+}
+
+// FindByID retrieves a User by ID
+func (r *PostgresUserRepository) FindByID(id model.UserID) (*model.User, error) {
+	var record UserRecord
+	result := r.db.Where("id = ?", id).First(&record)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("user with id %s not found", id)
+		}
+		return nil, result.Error
+	}
+	return toUserModel(&record), nil
+}
+
+// FindByEmail retrieves the User with the given email, compared case-insensitively,
+// or nil if there is none
+func (r *PostgresUserRepository) FindByEmail(email string) (*model.User, error) {
+	var record UserRecord
+	result := r.db.Where("LOWER(email) = LOWER(?)", email).First(&record)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+	return toUserModel(&record), nil
+}
+
+// FindAll retrieves all Users ordered by creation time
+func (r *PostgresUserRepository) FindAll() ([]*model.User, error) {
+	var records []UserRecord
+	result := r.db.Order(defaultOrder).Find(&records)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	users := make([]*model.User, len(records))
+	for i := range records {
+		users[i] = toUserModel(&records[i])
+	}
+	return users, nil
+}
+
+// Delete removes a User by ID
+func (r *PostgresUserRepository) Delete(id model.UserID) error {
+	result := r.db.Delete(&UserRecord{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("user with id %s not found", id)
+	}
+	return nil
+}
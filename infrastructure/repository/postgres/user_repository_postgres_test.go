@@ -0,0 +1,94 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository/postgres"
+	"github.com/mr3iscuit/ddd-golang/pkg/testsupport"
+)
+
+type PostgresUserRepoTestSuite struct {
+	suite.Suite
+	db      *gorm.DB
+	cleanup func()
+	repo    *postgres.PostgresUserRepository
+}
+
+func (s *PostgresUserRepoTestSuite) SetupSuite() {
+	s.db, s.cleanup = testsupport.NewTestDB(s.T())
+	s.repo = postgres.NewPostgresUserRepository(s.db)
+}
+
+func (s *PostgresUserRepoTestSuite) TearDownSuite() {
+	s.cleanup()
+}
+
+func (s *PostgresUserRepoTestSuite) TearDownTest() {
+	testsupport.ResetDB(s.T(), s.db)
+}
+
+func (s *PostgresUserRepoTestSuite) TestSaveAndFindByID() {
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	s.NoError(s.repo.Save(user))
+
+	found, err := s.repo.FindByID(user.GetID())
+	s.NoError(err)
+	s.Equal(user.GetEmail(), found.GetEmail())
+	s.Equal(user.GetUsername(), found.GetUsername())
+	s.Equal(user.GetFullName(), found.GetFullName())
+	s.Equal(user.GetRole(), found.GetRole())
+	s.Equal(user.GetStatus(), found.GetStatus())
+	s.WithinDuration(user.GetCreatedAt(), found.GetCreatedAt(), time.Second)
+}
+
+func (s *PostgresUserRepoTestSuite) TestSaveUpdatesExistingUser() {
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	s.NoError(s.repo.Save(user))
+
+	s.NoError(user.PromoteToAdmin())
+	s.NoError(s.repo.Save(user))
+
+	found, err := s.repo.FindByID(user.GetID())
+	s.NoError(err)
+	s.True(found.IsAdmin())
+}
+
+func (s *PostgresUserRepoTestSuite) TestFindByEmail() {
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	s.NoError(s.repo.Save(user))
+
+	found, err := s.repo.FindByEmail("ADA@example.com")
+	s.NoError(err)
+	s.Require().NotNil(found)
+	s.Equal(user.GetID(), found.GetID())
+
+	missing, err := s.repo.FindByEmail("grace@example.com")
+	s.NoError(err)
+	s.Nil(missing)
+}
+
+func (s *PostgresUserRepoTestSuite) TestFindAllAndDelete() {
+	ada := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	grace := model.NewUser("grace@example.com", "grace", "Grace", "Hopper")
+	s.NoError(s.repo.Save(ada))
+	s.NoError(s.repo.Save(grace))
+
+	users, err := s.repo.FindAll()
+	s.NoError(err)
+	s.Len(users, 2)
+
+	s.NoError(s.repo.Delete(ada.GetID()))
+	s.Error(s.repo.Delete(ada.GetID()))
+
+	_, err = s.repo.FindByID(ada.GetID())
+	s.Error(err)
+}
+
+func TestPostgresUserRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(PostgresUserRepoTestSuite))
+}
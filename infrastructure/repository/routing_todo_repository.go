@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// RoutingTodoRepository decorates a primary port.TodoRepositoryPort, sending
+// writes to the primary and reads to a read replica. Reads made under
+// port.ReadConsistencyStrong, or within the read-after-write window of the
+// last write, go to the primary so they cannot miss recent writes.
+type RoutingTodoRepository struct {
+	primary port.TodoRepositoryPort
+	replica port.TodoRepositoryPort
+	// readAfterWriteWindow is how long after a write every read goes to the primary
+	readAfterWriteWindow time.Duration
+	// lastWrite holds the Unix nanoseconds of the most recent write
+	lastWrite atomic.Int64
+	now       func() time.Time
+}
+
+var _ port.TodoRepositoryPort = (*RoutingTodoRepository)(nil)
+
+// RoutingOption configures a RoutingTodoRepository
+type RoutingOption func(*RoutingTodoRepository)
+
+// WithReadAfterWriteWindow sends all reads to the primary for the given time after any write,
+// tolerating that much replica lag; zero disables the window
+func WithReadAfterWriteWindow(window time.Duration) RoutingOption {
+	return func(r *RoutingTodoRepository) {
+		r.readAfterWriteWindow = window
+	}
+}
+
+// NewRoutingTodoRepository routes reads to replica and writes to primary.
+// A nil replica sends reads to the primary as well.
+func NewRoutingTodoRepository(primary, replica port.TodoRepositoryPort, opts ...RoutingOption) *RoutingTodoRepository {
+	if replica == nil {
+		replica = primary
+	}
+	r := &RoutingTodoRepository{primary: primary, replica: replica, now: time.Now}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// reader returns the repository serving reads for ctx
+func (r *RoutingTodoRepository) reader(ctx context.Context) port.TodoRepositoryPort {
+	if port.ReadConsistencyFromContext(ctx) == port.ReadConsistencyStrong {
+		return r.primary
+	}
+	if r.readAfterWriteWindow > 0 {
+		if last := r.lastWrite.Load(); last != 0 && r.now().Sub(time.Unix(0, last)) < r.readAfterWriteWindow {
+			return r.primary
+		}
+	}
+	return r.replica
+}
+
+// wrote records a write so reads in the following window go to the primary
+func (r *RoutingTodoRepository) wrote() {
+	r.lastWrite.Store(r.now().UnixNano())
+}
+
+// Save inserts or updates a Todo on the primary
+func (r *RoutingTodoRepository) Save(ctx context.Context, todo *model.Todo) error {
+	defer r.wrote()
+	return r.primary.Save(ctx, todo)
+}
+
+// Create inserts a new Todo on the primary
+func (r *RoutingTodoRepository) Create(ctx context.Context, todo *model.Todo) error {
+	defer r.wrote()
+	return r.primary.Create(ctx, todo)
+}
+
+// Update overwrites an existing Todo on the primary
+func (r *RoutingTodoRepository) Update(ctx context.Context, todo *model.Todo) error {
+	defer r.wrote()
+	return r.primary.Update(ctx, todo)
+}
+
+// UpdateAll overwrites several existing Todos on the primary as one unit
+func (r *RoutingTodoRepository) UpdateAll(ctx context.Context, todos []*model.Todo) error {
+	defer r.wrote()
+	return r.primary.UpdateAll(ctx, todos)
+}
+
+// FindByID retrieves a Todo by ID
+func (r *RoutingTodoRepository) FindByID(ctx context.Context, id model.TodoID) (*model.Todo, error) {
+	return r.reader(ctx).FindByID(ctx, id)
+}
+
+// FindByIDs retrieves several Todos by ID
+func (r *RoutingTodoRepository) FindByIDs(ctx context.Context, ids []model.TodoID) ([]*model.Todo, error) {
+	return r.reader(ctx).FindByIDs(ctx, ids)
+}
+
+// FindAll retrieves all Todos
+func (r *RoutingTodoRepository) FindAll(ctx context.Context) ([]*model.Todo, error) {
+	return r.reader(ctx).FindAll(ctx)
+}
+
+// FindPaginated retrieves one page of Todos and the total number of Todos
+func (r *RoutingTodoRepository) FindPaginated(ctx context.Context, limit, offset int) ([]*model.Todo, int, error) {
+	return r.reader(ctx).FindPaginated(ctx, limit, offset)
+}
+
+// FindFiltered retrieves one page of the Todos matching the filter and the total number of matches
+func (r *RoutingTodoRepository) FindFiltered(ctx context.Context, filter model.TodoFilter, sort model.TodoSort, limit, offset int) ([]*model.Todo, int, error) {
+	return r.reader(ctx).FindFiltered(ctx, filter, sort, limit, offset)
+}
+
+// FindByStatus retrieves all Todos in the given status
+func (r *RoutingTodoRepository) FindByStatus(ctx context.Context, status model.TodoStatus) ([]*model.Todo, error) {
+	return r.reader(ctx).FindByStatus(ctx, status)
+}
+
+// FindByCreatedBy retrieves all Todos owned by the given user
+func (r *RoutingTodoRepository) FindByCreatedBy(ctx context.Context, userID model.UserID) ([]*model.Todo, error) {
+	return r.reader(ctx).FindByCreatedBy(ctx, userID)
+}
+
+// FindRandom retrieves a random pending Todo
+func (r *RoutingTodoRepository) FindRandom(ctx context.Context) (*model.Todo, error) {
+	return r.reader(ctx).FindRandom(ctx)
+}
+
+// FindStale retrieves pending Todos not updated within olderThan
+func (r *RoutingTodoRepository) FindStale(ctx context.Context, olderThan time.Duration) ([]*model.Todo, error) {
+	return r.reader(ctx).FindStale(ctx, olderThan)
+}
+
+// Search retrieves the Todos whose title or description contains term
+func (r *RoutingTodoRepository) Search(ctx context.Context, term string) ([]*model.Todo, error) {
+	return r.reader(ctx).Search(ctx, term)
+}
+
+// FindOrderedByPriority retrieves Todos from highest to lowest priority
+func (r *RoutingTodoRepository) FindOrderedByPriority(ctx context.Context, includeArchived bool) ([]*model.Todo, error) {
+	return r.reader(ctx).FindOrderedByPriority(ctx, includeArchived)
+}
+
+// Count returns the number of Todos matching the filter
+func (r *RoutingTodoRepository) Count(ctx context.Context, filter model.TodoFilter) (int, error) {
+	return r.reader(ctx).Count(ctx, filter)
+}
+
+// FindDeletedIDs lists the IDs of deleted Todos
+func (r *RoutingTodoRepository) FindDeletedIDs(ctx context.Context) ([]model.TodoID, error) {
+	return r.reader(ctx).FindDeletedIDs(ctx)
+}
+
+// Delete removes a Todo by ID on the primary
+func (r *RoutingTodoRepository) Delete(ctx context.Context, id model.TodoID) error {
+	defer r.wrote()
+	return r.primary.Delete(ctx, id)
+}
+
+// DeleteByIDs removes several Todos on the primary and returns the IDs that were not present
+func (r *RoutingTodoRepository) DeleteByIDs(ctx context.Context, ids []model.TodoID) ([]model.TodoID, error) {
+	defer r.wrote()
+	return r.primary.DeleteByIDs(ctx, ids)
+}
+
+// CompletionTimeStats aggregates completion times per priority
+func (r *RoutingTodoRepository) CompletionTimeStats(ctx context.Context) ([]model.CompletionTimeStat, error) {
+	return r.reader(ctx).CompletionTimeStats(ctx)
+}
+
+// LockDependencies locks on the primary, where the dependency change is written
+func (r *RoutingTodoRepository) LockDependencies(ctx context.Context, ids ...model.TodoID) error {
+	return r.primary.LockDependencies(ctx, ids...)
+}
+
+// Ping checks the primary, without which no write can succeed
+func (r *RoutingTodoRepository) Ping(ctx context.Context) error {
+	return r.primary.Ping(ctx)
+}
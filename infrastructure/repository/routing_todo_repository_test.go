@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+func TestRoutingTodoRepository_ReadsHitReplica(t *testing.T) {
+	ctx := context.Background()
+	primary, replica := new(MockTodoRepository), new(MockTodoRepository)
+	repo := NewRoutingTodoRepository(primary, replica)
+	todo := model.NewTodo("Replica", "", model.TodoPriorityLow)
+	replica.On("FindByID", todo.GetID()).Return(todo, nil)
+	replica.On("FindAll").Return([]*model.Todo{todo}, nil)
+
+	found, err := repo.FindByID(ctx, todo.GetID())
+	assert.NoError(t, err)
+	assert.Same(t, todo, found)
+	all, err := repo.FindAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, all, 1)
+
+	replica.AssertExpectations(t)
+	primary.AssertNotCalled(t, "FindByID", mock.Anything)
+	primary.AssertNotCalled(t, "FindAll")
+}
+
+func TestRoutingTodoRepository_WritesHitPrimary(t *testing.T) {
+	ctx := context.Background()
+	primary, replica := new(MockTodoRepository), new(MockTodoRepository)
+	repo := NewRoutingTodoRepository(primary, replica)
+	todo := model.NewTodo("Primary", "", model.TodoPriorityLow)
+	primary.On("Save", todo).Return(nil)
+	primary.On("Delete", todo.GetID()).Return(nil)
+
+	assert.NoError(t, repo.Save(ctx, todo))
+	assert.NoError(t, repo.Delete(ctx, todo.GetID()))
+
+	primary.AssertExpectations(t)
+	replica.AssertNotCalled(t, "Save", mock.Anything)
+	replica.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestRoutingTodoRepository_FallsBackToPrimary(t *testing.T) {
+	primary := new(MockTodoRepository)
+	repo := NewRoutingTodoRepository(primary, nil)
+	primary.On("FindAll").Return([]*model.Todo{}, nil)
+
+	_, err := repo.FindAll(context.Background())
+	assert.NoError(t, err)
+
+	primary.AssertExpectations(t)
+}
+
+func TestRoutingTodoRepository_StrongConsistencyReadsPrimary(t *testing.T) {
+	primary, replica := new(MockTodoRepository), new(MockTodoRepository)
+	repo := NewRoutingTodoRepository(primary, replica)
+	primary.On("FindAll").Return([]*model.Todo{}, nil)
+	replica.On("FindAll").Return([]*model.Todo{}, nil)
+
+	_, err := repo.FindAll(port.WithReadConsistency(context.Background(), port.ReadConsistencyStrong))
+	assert.NoError(t, err)
+	_, err = repo.FindAll(context.Background())
+	assert.NoError(t, err)
+
+	primary.AssertNumberOfCalls(t, "FindAll", 1)
+	replica.AssertNumberOfCalls(t, "FindAll", 1)
+}
+
+func TestRoutingTodoRepository_ReadAfterWriteWindow(t *testing.T) {
+	ctx := context.Background()
+	primary, replica := new(MockTodoRepository), new(MockTodoRepository)
+	repo := NewRoutingTodoRepository(primary, replica, WithReadAfterWriteWindow(5*time.Second))
+	now := time.Now()
+	repo.now = func() time.Time { return now }
+	todo := model.NewTodo("Fresh", "", model.TodoPriorityLow)
+	primary.On("Save", todo).Return(nil)
+	primary.On("FindByID", todo.GetID()).Return(todo, nil)
+	replica.On("FindByID", todo.GetID()).Return(nil, errors.New("not replicated yet"))
+
+	assert.NoError(t, repo.Save(ctx, todo))
+	_, err := repo.FindByID(ctx, todo.GetID())
+	assert.NoError(t, err, "read inside the window should hit the primary")
+
+	now = now.Add(6 * time.Second)
+	_, err = repo.FindByID(ctx, todo.GetID())
+	assert.Error(t, err, "read after the window should hit the replica")
+
+	primary.AssertNumberOfCalls(t, "FindByID", 1)
+	replica.AssertNumberOfCalls(t, "FindByID", 1)
+}
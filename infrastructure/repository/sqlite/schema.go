@@ -0,0 +1,75 @@
+package sqlite
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// schema mirrors the Postgres migrations in SQLite's dialect: tags keep the
+// Postgres array text encoding ({"a","b"}) in a TEXT column
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS todos (
+		id VARCHAR(255) PRIMARY KEY,
+		title VARCHAR(255) NOT NULL,
+		description TEXT,
+		priority VARCHAR(50) NOT NULL,
+		status VARCHAR(50) NOT NULL,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		completed_at DATETIME,
+		created_by VARCHAR(255) NOT NULL DEFAULT '',
+		category_id VARCHAR(255) NOT NULL DEFAULT '',
+		due_date DATETIME,
+		tags TEXT NOT NULL DEFAULT '{}',
+		source VARCHAR(50) NOT NULL DEFAULT '',
+		deleted_at DATETIME
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_todos_status ON todos(status)`,
+	`CREATE INDEX IF NOT EXISTS idx_todos_priority ON todos(priority)`,
+	`CREATE INDEX IF NOT EXISTS idx_todos_created_at ON todos(created_at)`,
+	`CREATE INDEX IF NOT EXISTS idx_todos_created_by ON todos(created_by)`,
+	`CREATE INDEX IF NOT EXISTS idx_todos_category_id ON todos(category_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_todos_due_date ON todos(due_date)`,
+	`CREATE INDEX IF NOT EXISTS idx_todos_source ON todos(source)`,
+	`CREATE INDEX IF NOT EXISTS idx_todos_deleted_at ON todos(deleted_at)`,
+	`CREATE TABLE IF NOT EXISTS todo_dependencies (
+		todo_id VARCHAR(255) NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+		depends_on_id VARCHAR(255) NOT NULL,
+		PRIMARY KEY (todo_id, depends_on_id)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_todo_dependencies_depends_on_id ON todo_dependencies(depends_on_id)`,
+	`CREATE TABLE IF NOT EXISTS users (
+		id VARCHAR(255) PRIMARY KEY,
+		email VARCHAR(255) NOT NULL,
+		username VARCHAR(255) NOT NULL,
+		first_name VARCHAR(255) NOT NULL,
+		last_name VARCHAR(255) NOT NULL,
+		role VARCHAR(50) NOT NULL,
+		status VARCHAR(50) NOT NULL,
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		last_login_at DATETIME
+	)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users(LOWER(email))`,
+	`CREATE TABLE IF NOT EXISTS categories (
+		id VARCHAR(255) PRIMARY KEY,
+		name VARCHAR(50) NOT NULL,
+		description VARCHAR(200),
+		color VARCHAR(50) NOT NULL,
+		created_by VARCHAR(255),
+		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		is_default BOOLEAN NOT NULL DEFAULT FALSE
+	)`,
+}
+
+// Migrate creates the todos, todo_dependencies, users and categories tables if they do not exist yet
+func Migrate(db *gorm.DB) error {
+	for _, statement := range schema {
+		if err := db.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to migrate SQLite schema: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository/postgres"
+)
+
+// SQLiteTodoRepository implements port.TodoRepositoryPort on SQLite. It shares
+// postgres.TodoRecord and every portable query with the Postgres repository,
+// replacing only the queries that rely on Postgres arrays, ILIKE or EXTRACT.
+type SQLiteTodoRepository struct {
+	*postgres.PostgresTodoRepository
+	db *gorm.DB
+}
+
+var _ port.TodoRepositoryPort = (*SQLiteTodoRepository)(nil)
+var _ port.TransactionManager = (*SQLiteTodoRepository)(nil)
+
+// NewSQLiteTodoRepository creates a new SQLiteTodoRepository; db must have been migrated with Migrate
+func NewSQLiteTodoRepository(db *gorm.DB, opts ...postgres.PostgresTodoRepositoryOption) *SQLiteTodoRepository {
+	return &SQLiteTodoRepository{
+		PostgresTodoRepository: postgres.NewPostgresTodoRepository(db, opts...),
+		db:                     db,
+	}
+}
+
+// WithinTransaction calls fn with a SQLite repository bound to a database
+// transaction, so the SQLite queries are kept inside it, committed when fn
+// returns nil and rolled back otherwise
+func (r *SQLiteTodoRepository) WithinTransaction(ctx context.Context, fn func(repo port.TodoRepositoryPort) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&SQLiteTodoRepository{PostgresTodoRepository: r.PostgresTodoRepository.WithDB(tx), db: tx})
+	})
+}
+
+// LockDependencies takes no locks: SQLite has no advisory or row locks, and it
+// serializes write transactions, so a transaction whose reads went stale fails
+// to commit instead of closing a cycle
+func (r *SQLiteTodoRepository) LockDependencies(ctx context.Context, ids ...model.TodoID) error {
+	return ctx.Err()
+}
+
+// tagPattern matches one element of a tags column holding the Postgres array
+// text encoding, once both braces are replaced by commas: every element is
+// quoted with its quotes and backslashes escaped, so ,"tag", cannot match
+// inside another element
+func tagPattern(tag string) string {
+	return `,"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(tag) + `",`
+}
+
+// applyFilter narrows a query to the Todos matching the filter
+func applyFilter(query *gorm.DB, filter model.TodoFilter) *gorm.DB {
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Priority != "" {
+		query = query.Where("priority = ?", filter.Priority)
+	}
+	if filter.OverdueAt != nil {
+		query = query.Where("status = ? AND due_date IS NOT NULL AND due_date < ?", model.TodoStatusPending, *filter.OverdueAt)
+	}
+	if filter.Tag != "" {
+		query = query.Where("instr(replace(replace(tags, '{', ','), '}', ','), ?) > 0", tagPattern(filter.Tag))
+	}
+	if filter.Source != "" {
+		query = query.Where("source = ?", filter.Source)
+	}
+	if filter.CreatedBy != "" {
+		query = query.Where("created_by = ?", filter.CreatedBy)
+	}
+	if filter.Category != "" {
+		query = query.Where("category_id = ?", filter.Category)
+	}
+	if filter.Search != "" {
+		// LIKE is case-insensitive for ASCII in SQLite, matching ILIKE closely enough
+		pattern := postgres.ContainsPattern(filter.Search)
+		query = query.Where(`(title LIKE ? ESCAPE '\' OR description LIKE ? ESCAPE '\')`, pattern, pattern)
+	}
+	return query
+}
+
+// FindFiltered retrieves one page of the Todos matching the filter in the given order,
+// together with the total number of matches
+func (r *SQLiteTodoRepository) FindFiltered(ctx context.Context, filter model.TodoFilter, sort model.TodoSort, limit, offset int) ([]*model.Todo, int, error) {
+	order, err := postgres.OrderClause(sort)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := applyFilter(r.db.WithContext(ctx).Model(&postgres.TodoRecord{}), filter).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query := applyFilter(postgres.PreloadDependencies(r.db.WithContext(ctx)), filter).Order(order).Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var records []postgres.TodoRecord
+	if err := query.Find(&records).Error; err != nil {
+		return nil, 0, err
+	}
+
+	todos := make([]*model.Todo, len(records))
+	for i := range records {
+		todos[i] = records[i].ToModel()
+	}
+	return todos, int(total), nil
+}
+
+// Search retrieves the Todos whose title or description contains term, ignoring ASCII case
+func (r *SQLiteTodoRepository) Search(ctx context.Context, term string) ([]*model.Todo, error) {
+	todos, _, err := r.FindFiltered(ctx, model.TodoFilter{Search: term}, model.TodoSort{}, 0, 0)
+	return todos, err
+}
+
+// Count returns the number of Todos matching the filter
+func (r *SQLiteTodoRepository) Count(ctx context.Context, filter model.TodoFilter) (int, error) {
+	var count int64
+	if err := applyFilter(r.db.WithContext(ctx).Model(&postgres.TodoRecord{}), filter).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// CompletionTimeStats aggregates, per priority, the average time from creation
+// to completion over todos that have a completion time
+func (r *SQLiteTodoRepository) CompletionTimeStats(ctx context.Context) ([]model.CompletionTimeStat, error) {
+	var rows []struct {
+		Priority       string
+		Completed      int
+		AverageSeconds float64
+	}
+	result := r.db.WithContext(ctx).Model(&postgres.TodoRecord{}).
+		Select("priority, COUNT(*) AS completed, AVG((julianday(completed_at) - julianday(created_at)) * 86400.0) AS average_seconds").
+		Where("completed_at IS NOT NULL").
+		Group("priority").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	stats := make([]model.CompletionTimeStat, len(rows))
+	for i, row := range rows {
+		stats[i] = model.CompletionTimeStat{
+			Priority:        model.TodoPriority(row.Priority),
+			Completed:       row.Completed,
+			AverageDuration: time.Duration(row.AverageSeconds * float64(time.Second)),
+		}
+	}
+	return stats, nil
+}
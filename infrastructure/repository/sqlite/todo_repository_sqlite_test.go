@@ -0,0 +1,143 @@
+//go:build sqlite
+
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	gormsqlite "gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository/sqlite"
+)
+
+type SQLiteRepoTestSuite struct {
+	suite.Suite
+	repo *sqlite.SQLiteTodoRepository
+}
+
+// SetupTest gives every test a fresh in-memory database
+func (s *SQLiteRepoTestSuite) SetupTest() {
+	db, err := gorm.Open(gormsqlite.Open(":memory:"), &gorm.Config{})
+	s.Require().NoError(err)
+	s.Require().NoError(sqlite.Migrate(db))
+	s.repo = sqlite.NewSQLiteTodoRepository(db)
+}
+
+func (s *SQLiteRepoTestSuite) TestSaveAndFindByID() {
+	todo := model.NewTodo("Test Title", "Test Description", model.TodoPriorityHigh)
+	s.NoError(s.repo.Save(context.Background(), todo))
+
+	found, err := s.repo.FindByID(context.Background(), todo.GetID())
+	s.NoError(err)
+	s.Equal(todo.GetTitle(), found.GetTitle())
+	s.Equal(todo.GetPriority(), found.GetPriority())
+	s.WithinDuration(todo.GetCreatedAt(), found.GetCreatedAt(), time.Second)
+}
+
+func (s *SQLiteRepoTestSuite) TestSaveAndFilterDependencies() {
+	design, build := model.NewSimpleTodo("Design"), model.NewSimpleTodo("Build")
+	s.NoError(build.AddDependency(design))
+	s.NoError(s.repo.Save(context.Background(), design))
+	s.NoError(s.repo.Save(context.Background(), build))
+
+	todos, total, err := s.repo.FindFiltered(context.Background(), model.TodoFilter{Search: "Build"}, model.TodoSort{}, 0, 0)
+	s.NoError(err)
+	s.Equal(1, total)
+	s.Equal([]model.TodoID{design.GetID()}, todos[0].GetDependsOn())
+}
+
+func (s *SQLiteRepoTestSuite) TestSaveAndFilterByTag() {
+	tagged := model.NewSimpleTodo("Tagged")
+	s.NoError(tagged.AddTag("work"))
+	s.NoError(tagged.AddTag("urgent"))
+	s.NoError(s.repo.Save(context.Background(), tagged))
+	other := model.NewSimpleTodo("Other tag")
+	s.NoError(other.AddTag("workshop"))
+	s.NoError(s.repo.Save(context.Background(), other))
+
+	found, err := s.repo.FindByID(context.Background(), tagged.GetID())
+	s.NoError(err)
+	s.Equal([]string{"work", "urgent"}, found.GetTags())
+
+	todos, total, err := s.repo.FindFiltered(context.Background(), model.TodoFilter{Tag: "work"}, model.TodoSort{}, 0, 0)
+	s.NoError(err)
+	s.Equal(1, total)
+	s.Equal(tagged.GetID(), todos[0].GetID())
+}
+
+func (s *SQLiteRepoTestSuite) TestCountBySearch() {
+	s.NoError(s.repo.Save(context.Background(), model.NewTodo("Buy MILK", "", model.TodoPriorityLow)))
+	s.NoError(s.repo.Save(context.Background(), model.NewTodo("Walk dog", "", model.TodoPriorityLow)))
+
+	count, err := s.repo.Count(context.Background(), model.TodoFilter{Search: "milk"})
+	s.NoError(err)
+	s.Equal(1, count)
+}
+
+func (s *SQLiteRepoTestSuite) TestUpdateRejectsMissingID() {
+	todo := model.NewSimpleTodo("Missing")
+	s.ErrorIs(s.repo.Update(context.Background(), todo), model.ErrTodoNotFound)
+}
+
+func (s *SQLiteRepoTestSuite) TestCompletionTimeStats() {
+	created := time.Now().Add(-24 * time.Hour)
+	oneHour := created.Add(time.Hour)
+	threeHours := created.Add(3 * time.Hour)
+	for _, todo := range []*model.Todo{
+		model.NewTodoFromData("h1", "High 1", "", model.TodoStatusCompleted, model.TodoPriorityHigh, created, oneHour, &oneHour, "", "", nil, nil, ""),
+		model.NewTodoFromData("h2", "High 2", "", model.TodoStatusCompleted, model.TodoPriorityHigh, created, threeHours, &threeHours, "", "", nil, nil, ""),
+	} {
+		s.NoError(s.repo.Save(context.Background(), todo))
+	}
+
+	stats, err := s.repo.CompletionTimeStats(context.Background())
+	s.NoError(err)
+	s.Len(stats, 1)
+	s.Equal(2, stats[0].Completed)
+	s.InDelta(float64(2*time.Hour), float64(stats[0].AverageDuration), float64(time.Second))
+}
+
+func (s *SQLiteRepoTestSuite) TestDeleteByIDsReportsMissingIDs() {
+	kept, first, second := model.NewSimpleTodo("Kept"), model.NewSimpleTodo("First"), model.NewSimpleTodo("Second")
+	for _, todo := range []*model.Todo{kept, first, second} {
+		s.NoError(s.repo.Save(context.Background(), todo))
+	}
+
+	missing, err := s.repo.DeleteByIDs(context.Background(), []model.TodoID{first.GetID(), "absent", first.GetID(), "absent"})
+	s.NoError(err)
+	s.Equal([]model.TodoID{"absent"}, missing)
+
+	// Inside an outer transaction the delete rolls back with it
+	err = s.repo.WithinTransaction(context.Background(), func(repo port.TodoRepositoryPort) error {
+		missing, err := repo.DeleteByIDs(context.Background(), []model.TodoID{second.GetID()})
+		s.NoError(err)
+		s.Empty(missing)
+		return errors.New("abort")
+	})
+	s.Error(err)
+
+	todos, err := s.repo.FindAll(context.Background())
+	s.NoError(err)
+	s.Len(todos, 2)
+}
+
+func (s *SQLiteRepoTestSuite) TestLockDependenciesInsideTransaction() {
+	ctx := context.Background()
+	todo := model.NewSimpleTodo("Title")
+	s.NoError(s.repo.Save(ctx, todo))
+
+	s.NoError(s.repo.WithinTransaction(ctx, func(repo port.TodoRepositoryPort) error {
+		return repo.LockDependencies(ctx, todo.GetID())
+	}))
+}
+
+func TestSQLiteRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(SQLiteRepoTestSuite))
+}
@@ -0,0 +1,16 @@
+//go:build sqlite
+
+package repository
+
+import (
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// sqliteSupported reports whether this binary was built with SQLite support
+const sqliteSupported = true
+
+// sqliteDialector opens the SQLite database file at path
+func sqliteDialector(path string) (gorm.Dialector, error) {
+	return sqlite.Open(path), nil
+}
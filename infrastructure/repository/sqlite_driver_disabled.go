@@ -0,0 +1,18 @@
+//go:build !sqlite
+
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// sqliteSupported reports whether this binary was built with SQLite support
+const sqliteSupported = false
+
+// sqliteDialector reports that SQLite support was not compiled in. The
+// gorm.io/driver/sqlite driver needs cgo, so it is only linked with -tags sqlite.
+func sqliteDialector(path string) (gorm.Dialector, error) {
+	return nil, errors.New("SQLite support is not compiled in: rebuild with -tags sqlite")
+}
@@ -0,0 +1,430 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// InMemoryTodoRepository implements port.TodoRepositoryPort in memory.
+// Todos are stored by value so callers cannot mutate persisted state without calling Save.
+type InMemoryTodoRepository struct {
+	mu    sync.RWMutex
+	todos map[model.TodoID]model.Todo
+	// deleted records the IDs of deleted todos in deletion order, mirroring soft deletes
+	deleted []model.TodoID
+	// limits are the length limits todos are validated against before every write
+	limits model.TodoLimits
+}
+
+// InMemoryTodoRepositoryOption configures an InMemoryTodoRepository
+type InMemoryTodoRepositoryOption func(*InMemoryTodoRepository)
+
+// WithTodoLimits validates written todos against limits instead of model.DefaultTodoLimits
+func WithTodoLimits(limits model.TodoLimits) InMemoryTodoRepositoryOption {
+	return func(r *InMemoryTodoRepository) {
+		r.limits = limits
+	}
+}
+
+// NewInMemoryTodoRepository creates a new, empty InMemoryTodoRepository
+func NewInMemoryTodoRepository(opts ...InMemoryTodoRepositoryOption) *InMemoryTodoRepository {
+	r := &InMemoryTodoRepository{todos: make(map[model.TodoID]model.Todo), limits: model.DefaultTodoLimits()}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+var _ port.TodoRepositoryPort = (*InMemoryTodoRepository)(nil)
+var _ port.TransactionManager = (*InMemoryTodoRepository)(nil)
+
+// Save inserts or updates a Todo
+func (r *InMemoryTodoRepository) Save(ctx context.Context, todo *model.Todo) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := todo.Validate(r.limits); err != nil {
+		return fmt.Errorf("invalid todo %s: %w", todo.GetID(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.todos[todo.GetID()] = *todo
+	return nil
+}
+
+// Create inserts a new Todo and fails if one with the same ID exists
+func (r *InMemoryTodoRepository) Create(ctx context.Context, todo *model.Todo) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := todo.Validate(r.limits); err != nil {
+		return fmt.Errorf("invalid todo %s: %w", todo.GetID(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.todos[todo.GetID()]; exists {
+		return fmt.Errorf("todo with id %s already exists", todo.GetID())
+	}
+	r.todos[todo.GetID()] = *todo
+	return nil
+}
+
+// Update overwrites an existing Todo and fails if none has its ID
+func (r *InMemoryTodoRepository) Update(ctx context.Context, todo *model.Todo) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := todo.Validate(r.limits); err != nil {
+		return fmt.Errorf("invalid todo %s: %w", todo.GetID(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.todos[todo.GetID()]; !exists {
+		return fmt.Errorf("todo with id %s not found: %w", todo.GetID(), model.ErrTodoNotFound)
+	}
+	r.todos[todo.GetID()] = *todo
+	return nil
+}
+
+// UpdateAll overwrites several existing Todos under one lock, writing none
+// unless every one is valid and present
+func (r *InMemoryTodoRepository) UpdateAll(ctx context.Context, todos []*model.Todo) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	for _, todo := range todos {
+		if err := todo.Validate(r.limits); err != nil {
+			return fmt.Errorf("invalid todo %s: %w", todo.GetID(), err)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, todo := range todos {
+		if _, exists := r.todos[todo.GetID()]; !exists {
+			return fmt.Errorf("todo with id %s not found: %w", todo.GetID(), model.ErrTodoNotFound)
+		}
+	}
+	for _, todo := range todos {
+		r.todos[todo.GetID()] = *todo
+	}
+	return nil
+}
+
+// WithinTransaction holds the write lock while fn runs on a copy of the stored
+// todos, and keeps the copy only when fn returns nil. fn must use the repository
+// it is given: calling r itself would wait on the held lock.
+func (r *InMemoryTodoRepository) WithinTransaction(ctx context.Context, fn func(repo port.TodoRepositoryPort) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tx := &InMemoryTodoRepository{todos: maps.Clone(r.todos), deleted: slices.Clone(r.deleted), limits: r.limits}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	r.todos, r.deleted = tx.todos, tx.deleted
+	return nil
+}
+
+// LockDependencies needs no locks: WithinTransaction already holds the write
+// lock, so transactions never run concurrently
+func (r *InMemoryTodoRepository) LockDependencies(ctx context.Context, ids ...model.TodoID) error {
+	return ctx.Err()
+}
+
+// FindByID retrieves a Todo by ID
+func (r *InMemoryTodoRepository) FindByID(ctx context.Context, id model.TodoID) (*model.Todo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	todo, ok := r.todos[id]
+	if !ok {
+		return nil, fmt.Errorf("todo with id %s not found: %w", id, model.ErrTodoNotFound)
+	}
+	return &todo, nil
+}
+
+// FindByIDs retrieves the Todos with the given IDs ordered by creation time, skipping missing IDs
+func (r *InMemoryTodoRepository) FindByIDs(ctx context.Context, ids []model.TodoID) ([]*model.Todo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	wanted := make(map[model.TodoID]struct{}, len(ids))
+	for _, id := range ids {
+		wanted[id] = struct{}{}
+	}
+	return r.filter(func(todo *model.Todo) bool {
+		_, ok := wanted[todo.GetID()]
+		return ok
+	}), nil
+}
+
+// FindAll retrieves all Todos ordered by creation time
+func (r *InMemoryTodoRepository) FindAll(ctx context.Context) ([]*model.Todo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.FindAllSorted(), nil
+}
+
+// FindAllSorted returns a snapshot of every Todo ordered by creation time and then ID,
+// so concurrent tests can assert on a stable, complete set
+func (r *InMemoryTodoRepository) FindAllSorted() []*model.Todo {
+	return r.filter(func(*model.Todo) bool { return true })
+}
+
+// FindPaginated retrieves one page of Todos ordered by creation time together
+// with the total number of Todos. A non-positive limit returns the rest of the set.
+func (r *InMemoryTodoRepository) FindPaginated(ctx context.Context, limit, offset int) ([]*model.Todo, int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+	todos := r.FindAllSorted()
+	return paginate(todos, limit, offset), len(todos), nil
+}
+
+// FindFiltered retrieves one page of the Todos matching the filter in the given order,
+// together with the total number of matches
+func (r *InMemoryTodoRepository) FindFiltered(ctx context.Context, filter model.TodoFilter, order model.TodoSort, limit, offset int) ([]*model.Todo, int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+	todos := r.filter(filter.Matches)
+	sort.Slice(todos, func(i, j int) bool { return order.Less(todos[i], todos[j]) })
+	return paginate(todos, limit, offset), len(todos), nil
+}
+
+// paginate returns the page of todos starting at offset; a non-positive limit returns the rest
+func paginate(todos []*model.Todo, limit, offset int) []*model.Todo {
+	if offset >= len(todos) {
+		return []*model.Todo{}
+	}
+	todos = todos[offset:]
+	if limit > 0 && limit < len(todos) {
+		todos = todos[:limit]
+	}
+	return todos
+}
+
+// FindByStatus retrieves all Todos in the given status ordered by creation time
+func (r *InMemoryTodoRepository) FindByStatus(ctx context.Context, status model.TodoStatus) ([]*model.Todo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.filter(func(todo *model.Todo) bool { return todo.GetStatus() == status }), nil
+}
+
+// FindByCreatedBy retrieves all Todos owned by the given user
+func (r *InMemoryTodoRepository) FindByCreatedBy(ctx context.Context, userID model.UserID) ([]*model.Todo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.filter(func(todo *model.Todo) bool { return todo.GetCreatedBy() == userID }), nil
+}
+
+// FindRandom retrieves a random pending Todo
+func (r *InMemoryTodoRepository) FindRandom(ctx context.Context) (*model.Todo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var pending []model.Todo
+	for _, todo := range r.todos {
+		if todo.IsPending() {
+			pending = append(pending, todo)
+		}
+	}
+	if len(pending) == 0 {
+		return nil, fmt.Errorf("no pending todos found: %w", model.ErrTodoNotFound)
+	}
+	todo := pending[rand.Intn(len(pending))]
+	return &todo, nil
+}
+
+// FindStale retrieves pending Todos not updated within olderThan
+func (r *InMemoryTodoRepository) FindStale(ctx context.Context, olderThan time.Duration) ([]*model.Todo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	cutoff := time.Now().Add(-olderThan)
+	return r.filter(func(todo *model.Todo) bool {
+		return todo.IsPending() && todo.GetUpdatedAt().Before(cutoff)
+	}), nil
+}
+
+// Search retrieves the Todos whose lowercased title or description contains the lowercased term
+func (r *InMemoryTodoRepository) Search(ctx context.Context, term string) ([]*model.Todo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	term = strings.ToLower(term)
+	return r.filter(func(todo *model.Todo) bool {
+		return strings.Contains(strings.ToLower(todo.GetTitle()), term) ||
+			strings.Contains(strings.ToLower(todo.GetDescription()), term)
+	}), nil
+}
+
+// FindOrderedByPriority retrieves Todos from highest to lowest priority, optionally including archived ones
+func (r *InMemoryTodoRepository) FindOrderedByPriority(ctx context.Context, includeArchived bool) ([]*model.Todo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	todos := r.filter(func(todo *model.Todo) bool { return includeArchived || !todo.IsArchived() })
+	order := model.TodoSort{Field: model.SortByPriority, Descending: true}
+	sort.Slice(todos, func(i, j int) bool { return order.Less(todos[i], todos[j]) })
+	return todos, nil
+}
+
+// Count returns the number of Todos matching the filter
+func (r *InMemoryTodoRepository) Count(ctx context.Context, filter model.TodoFilter) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, todo := range r.todos {
+		if filter.Matches(&todo) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Delete removes a Todo by ID
+func (r *InMemoryTodoRepository) Delete(ctx context.Context, id model.TodoID) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.todos[id]; !ok {
+		return fmt.Errorf("todo with id %s not found: %w", id, model.ErrTodoNotFound)
+	}
+	delete(r.todos, id)
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+// DeleteByIDs removes all Todos with the given IDs and returns, once each, the IDs that were not present
+func (r *InMemoryTodoRepository) DeleteByIDs(ctx context.Context, ids []model.TodoID) ([]model.TodoID, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var missing []model.TodoID
+	seen := make(map[model.TodoID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if _, ok := r.todos[id]; !ok {
+			missing = append(missing, id)
+			continue
+		}
+		delete(r.todos, id)
+		r.deleted = append(r.deleted, id)
+	}
+	return missing, nil
+}
+
+// FindDeletedIDs lists the IDs of deleted Todos, oldest deletion first
+func (r *InMemoryTodoRepository) FindDeletedIDs(ctx context.Context) ([]model.TodoID, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]model.TodoID, len(r.deleted))
+	copy(ids, r.deleted)
+	return ids, nil
+}
+
+// Ping always succeeds since the store lives in process memory
+func (r *InMemoryTodoRepository) Ping(ctx context.Context) error {
+	return nil
+}
+
+// CompletionTimeStats aggregates, per priority, the average time from creation
+// to completion over todos that have a completion time
+func (r *InMemoryTodoRepository) CompletionTimeStats(ctx context.Context) ([]model.CompletionTimeStat, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	totals := make(map[model.TodoPriority]time.Duration)
+	counts := make(map[model.TodoPriority]int)
+	for _, todo := range r.todos {
+		completedAt := todo.GetCompletedAt()
+		if completedAt == nil {
+			continue
+		}
+		totals[todo.GetPriority()] += completedAt.Sub(todo.GetCreatedAt())
+		counts[todo.GetPriority()]++
+	}
+
+	stats := make([]model.CompletionTimeStat, 0, len(counts))
+	for priority, count := range counts {
+		stats = append(stats, model.CompletionTimeStat{
+			Priority:        priority,
+			Completed:       count,
+			AverageDuration: totals[priority] / time.Duration(count),
+		})
+	}
+	return stats, nil
+}
+
+// filter returns copies of the stored Todos matching keep, ordered by creation time
+// with the ID as a tiebreaker so the order is total and stable
+func (r *InMemoryTodoRepository) filter(keep func(*model.Todo) bool) []*model.Todo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	todos := make([]*model.Todo, 0, len(r.todos))
+	for _, stored := range r.todos {
+		todo := stored
+		if keep(&todo) {
+			todos = append(todos, &todo)
+		}
+	}
+	sort.Slice(todos, func(i, j int) bool {
+		if !todos[i].GetCreatedAt().Equal(todos[j].GetCreatedAt()) {
+			return todos[i].GetCreatedAt().Before(todos[j].GetCreatedAt())
+		}
+		return todos[i].GetID() < todos[j].GetID()
+	})
+	return todos
+}
@@ -0,0 +1,587 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+func TestInMemoryTodoRepository_SaveAndFindByID(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	todo := model.NewTodo("Title", "Description", model.TodoPriorityHigh)
+	require.NoError(t, repo.Save(context.Background(), todo))
+
+	found, err := repo.FindByID(context.Background(), todo.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, todo.GetTitle(), found.GetTitle())
+	assert.Equal(t, todo.GetPriority(), found.GetPriority())
+}
+
+func TestInMemoryTodoRepository_FindByIDNotFound(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+
+	_, err := repo.FindByID(context.Background(), "missing")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestInMemoryTodoRepository_FindByIDs(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryTodoRepository()
+	first := model.NewTodoFromData("b", "First", "", model.TodoStatusPending, model.TodoPriorityLow, time.Now().Add(-time.Hour), time.Now(), nil, "", "", nil, nil, "")
+	second := model.NewTodoFromData("a", "Second", "", model.TodoStatusPending, model.TodoPriorityLow, time.Now(), time.Now(), nil, "", "", nil, nil, "")
+	other := model.NewSimpleTodo("Other")
+	for _, todo := range []*model.Todo{first, second, other} {
+		require.NoError(t, repo.Save(ctx, todo))
+	}
+
+	todos, err := repo.FindByIDs(ctx, []model.TodoID{"a", "missing", "b"})
+	require.NoError(t, err)
+	require.Len(t, todos, 2)
+	assert.Equal(t, first.GetID(), todos[0].GetID())
+	assert.Equal(t, second.GetID(), todos[1].GetID())
+}
+
+func TestInMemoryTodoRepository_IsolatesStoredTodos(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	todo := model.NewTodo("Title", "", model.TodoPriorityLow)
+	require.NoError(t, repo.Save(context.Background(), todo))
+
+	require.NoError(t, todo.MarkAsCompleted())
+
+	found, err := repo.FindByID(context.Background(), todo.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, model.TodoStatusPending, found.GetStatus())
+}
+
+func TestInMemoryTodoRepository_CreateRejectsExistingID(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	todo := model.NewSimpleTodo("Title")
+	require.NoError(t, repo.Create(context.Background(), todo))
+
+	_, err := todo.UpdateTitle("Renamed", model.DefaultTodoLimits())
+	require.NoError(t, err)
+	assert.ErrorContains(t, repo.Create(context.Background(), todo), "already exists")
+
+	found, err := repo.FindByID(context.Background(), todo.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, "Title", found.GetTitle())
+}
+
+func TestInMemoryTodoRepository_UpdateRejectsMissingID(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	todo := model.NewSimpleTodo("Title")
+	assert.ErrorIs(t, repo.Update(context.Background(), todo), model.ErrTodoNotFound)
+
+	_, err := repo.FindByID(context.Background(), todo.GetID())
+	assert.Error(t, err)
+
+	require.NoError(t, repo.Create(context.Background(), todo))
+	_, err = todo.UpdateTitle("Renamed", model.DefaultTodoLimits())
+	require.NoError(t, err)
+	require.NoError(t, repo.Update(context.Background(), todo))
+	found, err := repo.FindByID(context.Background(), todo.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", found.GetTitle())
+}
+
+func TestInMemoryTodoRepository_UpdateAllWritesNoneWhenOneIsMissing(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	stored := model.NewSimpleTodo("Stored")
+	require.NoError(t, repo.Create(context.Background(), stored))
+	missing := model.NewSimpleTodo("Missing")
+
+	_, err := stored.UpdateTitle("Renamed", model.DefaultTodoLimits())
+	require.NoError(t, err)
+	assert.ErrorIs(t, repo.UpdateAll(context.Background(), []*model.Todo{stored, missing}), model.ErrTodoNotFound)
+
+	found, err := repo.FindByID(context.Background(), stored.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, "Stored", found.GetTitle())
+
+	require.NoError(t, repo.UpdateAll(context.Background(), []*model.Todo{stored}))
+	found, err = repo.FindByID(context.Background(), stored.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", found.GetTitle())
+}
+
+func TestInMemoryTodoRepository_WithinTransaction(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryTodoRepository()
+	todo := model.NewSimpleTodo("Title")
+	require.NoError(t, repo.Create(ctx, todo))
+
+	err := repo.WithinTransaction(ctx, func(tx port.TodoRepositoryPort) error {
+		require.NoError(t, todo.MarkAsCompleted())
+		require.NoError(t, tx.Update(ctx, todo))
+		require.NoError(t, tx.Delete(ctx, todo.GetID()))
+		return errors.New("abort")
+	})
+	assert.EqualError(t, err, "abort")
+	found, err := repo.FindByID(ctx, todo.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, model.TodoStatusPending, found.GetStatus())
+
+	require.NoError(t, repo.WithinTransaction(ctx, func(tx port.TodoRepositoryPort) error {
+		return tx.Update(ctx, todo)
+	}))
+	found, err = repo.FindByID(ctx, todo.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, model.TodoStatusCompleted, found.GetStatus())
+}
+
+func TestInMemoryTodoRepository_IsolatesStoredTags(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	todo := model.NewSimpleTodo("Title")
+	require.NoError(t, todo.AddTag("work"))
+	require.NoError(t, repo.Save(context.Background(), todo))
+
+	require.NoError(t, todo.AddTag("home"))
+	require.NoError(t, todo.RemoveTag("work"))
+
+	found, err := repo.FindByID(context.Background(), todo.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work"}, found.GetTags())
+}
+
+func TestInMemoryTodoRepository_FindAllOrdersByCreationTime(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	now := time.Now()
+	second := model.NewTodoFromData("a", "Second", "", model.TodoStatusPending, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, "")
+	first := model.NewTodoFromData("b", "First", "", model.TodoStatusPending, model.TodoPriorityLow, now.Add(-time.Minute), now, nil, "", "", nil, nil, "")
+	require.NoError(t, repo.Save(context.Background(), second))
+	require.NoError(t, repo.Save(context.Background(), first))
+
+	all, err := repo.FindAll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, first.GetID(), all[0].GetID())
+	assert.Equal(t, second.GetID(), all[1].GetID())
+}
+
+func TestInMemoryTodoRepository_FindAllBreaksTiesByID(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	now := time.Now()
+	for _, id := range []model.TodoID{"d", "b", "e", "a", "c"} {
+		require.NoError(t, repo.Save(context.Background(), model.NewTodoFromData(id, "Same time", "", model.TodoStatusPending, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, "")))
+	}
+
+	firstFetch, err := repo.FindAll(context.Background())
+	require.NoError(t, err)
+	secondFetch, err := repo.FindAll(context.Background())
+	require.NoError(t, err)
+
+	expected := []model.TodoID{"a", "b", "c", "d", "e"}
+	for i, id := range expected {
+		assert.Equal(t, id, firstFetch[i].GetID())
+		assert.Equal(t, id, secondFetch[i].GetID())
+	}
+}
+
+func TestInMemoryTodoRepository_FindPaginated(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	now := time.Now()
+	for i, id := range []model.TodoID{"a", "b", "c"} {
+		created := now.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, repo.Save(context.Background(), model.NewTodoFromData(id, "Todo", "", model.TodoStatusPending, model.TodoPriorityLow, created, created, nil, "", "", nil, nil, "")))
+	}
+
+	tests := []struct {
+		name   string
+		limit  int
+		offset int
+		want   []model.TodoID
+	}{
+		{name: "first page", limit: 2, offset: 0, want: []model.TodoID{"a", "b"}},
+		{name: "last page", limit: 2, offset: 2, want: []model.TodoID{"c"}},
+		{name: "no limit", limit: 0, offset: 1, want: []model.TodoID{"b", "c"}},
+		{name: "past the end", limit: 2, offset: 5, want: []model.TodoID{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, total, err := repo.FindPaginated(context.Background(), tt.limit, tt.offset)
+			require.NoError(t, err)
+			assert.Equal(t, 3, total)
+			ids := []model.TodoID{}
+			for _, todo := range page {
+				ids = append(ids, todo.GetID())
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestInMemoryTodoRepository_FindFiltered(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	now := time.Now()
+	for _, todo := range []*model.Todo{
+		model.NewTodoFromData("a", "Banana", "", model.TodoStatusPending, model.TodoPriorityHigh, now, now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("b", "Apple", "", model.TodoStatusPending, model.TodoPriorityLow, now.Add(time.Minute), now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("c", "Cherry", "", model.TodoStatusPending, model.TodoPriorityMedium, now.Add(2*time.Minute), now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("d", "Date", "", model.TodoStatusPending, model.TodoPriorityHigh, now.Add(3*time.Minute), now, nil, "", "", nil, nil, ""),
+	} {
+		require.NoError(t, repo.Save(context.Background(), todo))
+	}
+
+	ids := func(todos []*model.Todo) []model.TodoID {
+		result := []model.TodoID{}
+		for _, todo := range todos {
+			result = append(result, todo.GetID())
+		}
+		return result
+	}
+
+	page, total, err := repo.FindFiltered(context.Background(), model.TodoFilter{}, model.TodoSort{Field: model.SortByPriority, Descending: true}, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 4, total)
+	assert.Equal(t, []model.TodoID{"a", "d", "c", "b"}, ids(page))
+
+	page, total, err = repo.FindFiltered(context.Background(), model.TodoFilter{}, model.TodoSort{Field: model.SortByTitle}, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 4, total)
+	assert.Equal(t, []model.TodoID{"a", "c"}, ids(page))
+
+	page, total, err = repo.FindFiltered(context.Background(), model.TodoFilter{Priority: model.TodoPriorityHigh}, model.TodoSort{}, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, []model.TodoID{"a", "d"}, ids(page))
+}
+
+func TestInMemoryTodoRepository_FindByStatus(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	pending := model.NewTodo("Pending", "", model.TodoPriorityLow)
+	done := model.NewTodo("Done", "", model.TodoPriorityLow)
+	require.NoError(t, done.MarkAsCompleted())
+	require.NoError(t, repo.Save(context.Background(), pending))
+	require.NoError(t, repo.Save(context.Background(), done))
+
+	found, err := repo.FindByStatus(context.Background(), model.TodoStatusCompleted)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, done.GetID(), found[0].GetID())
+
+	found, err = repo.FindByStatus(context.Background(), model.TodoStatusArchived)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestInMemoryTodoRepository_FindByCreatedBy(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	mine := model.NewTodo("Mine", "", model.TodoPriorityLow)
+	require.NoError(t, mine.AssignCreator("user-1"))
+	theirs := model.NewTodo("Theirs", "", model.TodoPriorityLow)
+	require.NoError(t, theirs.AssignCreator("user-2"))
+	require.NoError(t, repo.Save(context.Background(), mine))
+	require.NoError(t, repo.Save(context.Background(), theirs))
+
+	found, err := repo.FindByCreatedBy(context.Background(), "user-1")
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, mine.GetID(), found[0].GetID())
+}
+
+func TestInMemoryTodoRepository_Delete(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	todo := model.NewTodo("Title", "", model.TodoPriorityLow)
+	require.NoError(t, repo.Save(context.Background(), todo))
+
+	require.NoError(t, repo.Delete(context.Background(), todo.GetID()))
+	assert.ErrorContains(t, repo.Delete(context.Background(), todo.GetID()), "not found")
+}
+
+func TestInMemoryTodoRepository_DeleteByIDs(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	first := model.NewTodo("First", "", model.TodoPriorityLow)
+	second := model.NewTodo("Second", "", model.TodoPriorityLow)
+	require.NoError(t, repo.Save(context.Background(), first))
+	require.NoError(t, repo.Save(context.Background(), second))
+
+	missing, err := repo.DeleteByIDs(context.Background(), []model.TodoID{first.GetID(), "absent", second.GetID()})
+	require.NoError(t, err)
+	assert.Equal(t, []model.TodoID{"absent"}, missing)
+
+	all, err := repo.FindAll(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
+
+func TestInMemoryTodoRepository_DeleteByIDsReportsDuplicatesOnce(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	todo := model.NewTodo("First", "", model.TodoPriorityLow)
+	require.NoError(t, repo.Save(context.Background(), todo))
+
+	missing, err := repo.DeleteByIDs(context.Background(), []model.TodoID{"absent", todo.GetID(), "absent", todo.GetID()})
+	require.NoError(t, err)
+	assert.Equal(t, []model.TodoID{"absent"}, missing)
+}
+
+func TestInMemoryTodoRepository_FindDeletedIDs(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	first := model.NewTodo("First", "", model.TodoPriorityLow)
+	second := model.NewTodo("Second", "", model.TodoPriorityLow)
+	kept := model.NewTodo("Kept", "", model.TodoPriorityLow)
+	for _, todo := range []*model.Todo{first, second, kept} {
+		require.NoError(t, repo.Save(context.Background(), todo))
+	}
+
+	deleted, err := repo.FindDeletedIDs(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+
+	require.NoError(t, repo.Delete(context.Background(), second.GetID()))
+	_, err = repo.DeleteByIDs(context.Background(), []model.TodoID{first.GetID(), "absent"})
+	require.NoError(t, err)
+
+	deleted, err = repo.FindDeletedIDs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []model.TodoID{second.GetID(), first.GetID()}, deleted)
+}
+
+func TestInMemoryTodoRepository_HonorsCancelledContext(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	todo := model.NewTodo("Title", "", model.TodoPriorityLow)
+	require.NoError(t, repo.Save(context.Background(), todo))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, repo.Save(ctx, model.NewTodo("Late", "", model.TodoPriorityLow)), context.Canceled)
+	_, err := repo.FindByID(ctx, todo.GetID())
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, repo.Delete(ctx, todo.GetID()), context.Canceled)
+
+	// Nothing changed under the cancelled context
+	all, err := repo.FindAll(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, all, 1)
+}
+
+func TestInMemoryTodoRepository_SaveRejectsInvalidTodo(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	now := time.Now()
+	corrupt := model.NewTodoFromData("corrupt", "Done", "", model.TodoStatusCompleted, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, "")
+
+	err := repo.Save(context.Background(), corrupt)
+	assert.ErrorContains(t, err, "completed todo must have a completion time")
+
+	_, err = repo.FindByID(context.Background(), "corrupt")
+	assert.Error(t, err)
+}
+
+func TestInMemoryTodoRepository_CompletionTimeStats(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	created := time.Now().Add(-24 * time.Hour)
+	oneHour := created.Add(time.Hour)
+	threeHours := created.Add(3 * time.Hour)
+	for _, todo := range []*model.Todo{
+		model.NewTodoFromData("h1", "High 1", "", model.TodoStatusCompleted, model.TodoPriorityHigh, created, oneHour, &oneHour, "", "", nil, nil, ""),
+		model.NewTodoFromData("h2", "High 2", "", model.TodoStatusCompleted, model.TodoPriorityHigh, created, threeHours, &threeHours, "", "", nil, nil, ""),
+		model.NewTodoFromData("l1", "Low 1", "", model.TodoStatusCompleted, model.TodoPriorityLow, created, oneHour, &oneHour, "", "", nil, nil, ""),
+		model.NewTodoFromData("p1", "Pending", "", model.TodoStatusPending, model.TodoPriorityHigh, created, created, nil, "", "", nil, nil, ""),
+	} {
+		require.NoError(t, repo.Save(context.Background(), todo))
+	}
+
+	stats, err := repo.CompletionTimeStats(context.Background())
+	require.NoError(t, err)
+
+	byPriority := make(map[model.TodoPriority]model.CompletionTimeStat)
+	for _, stat := range stats {
+		byPriority[stat.Priority] = stat
+	}
+	assert.Len(t, byPriority, 2)
+	assert.Equal(t, 2, byPriority[model.TodoPriorityHigh].Completed)
+	assert.Equal(t, 2*time.Hour, byPriority[model.TodoPriorityHigh].AverageDuration)
+	assert.Equal(t, 1, byPriority[model.TodoPriorityLow].Completed)
+	assert.Equal(t, time.Hour, byPriority[model.TodoPriorityLow].AverageDuration)
+}
+
+func TestInMemoryTodoRepository_FindRandomReturnsPendingTodo(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	pending := map[model.TodoID]bool{}
+	for _, title := range []string{"First", "Second", "Third"} {
+		todo := model.NewTodo(title, "", model.TodoPriorityLow)
+		require.NoError(t, repo.Save(context.Background(), todo))
+		pending[todo.GetID()] = true
+	}
+	done := model.NewTodo("Done", "", model.TodoPriorityLow)
+	require.NoError(t, done.MarkAsCompleted())
+	require.NoError(t, repo.Save(context.Background(), done))
+
+	for i := 0; i < 20; i++ {
+		todo, err := repo.FindRandom(context.Background())
+		require.NoError(t, err)
+		assert.True(t, pending[todo.GetID()])
+	}
+}
+
+func TestInMemoryTodoRepository_FindRandomWithoutPendingTodos(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	done := model.NewTodo("Done", "", model.TodoPriorityLow)
+	require.NoError(t, done.MarkAsCompleted())
+	require.NoError(t, repo.Save(context.Background(), done))
+
+	_, err := repo.FindRandom(context.Background())
+	assert.ErrorIs(t, err, model.ErrTodoNotFound)
+}
+
+func TestInMemoryTodoRepository_FindStale(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	longAgo := time.Now().Add(-60 * 24 * time.Hour)
+	recently := time.Now().Add(-time.Hour)
+	for _, todo := range []*model.Todo{
+		model.NewTodoFromData("stale", "Forgotten", "", model.TodoStatusPending, model.TodoPriorityLow, longAgo, longAgo, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("fresh", "Recent", "", model.TodoStatusPending, model.TodoPriorityLow, longAgo, recently, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("done", "Done long ago", "", model.TodoStatusCompleted, model.TodoPriorityLow, longAgo, longAgo, &longAgo, "", "", nil, nil, ""),
+	} {
+		require.NoError(t, repo.Save(context.Background(), todo))
+	}
+
+	stale, err := repo.FindStale(context.Background(), 30*24*time.Hour)
+	require.NoError(t, err)
+	require.Len(t, stale, 1)
+	assert.Equal(t, model.TodoID("stale"), stale[0].GetID())
+}
+
+func TestInMemoryTodoRepository_Search(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	now := time.Now()
+	for i, todo := range []*model.Todo{
+		model.NewTodoFromData("title", "Quarterly REPORT", "", model.TodoStatusPending, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("description", "Email", "attach the report", model.TodoStatusPending, model.TodoPriorityLow, now.Add(time.Second), now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("other", "Groceries", "milk", model.TodoStatusPending, model.TodoPriorityLow, now.Add(2*time.Second), now, nil, "", "", nil, nil, ""),
+	} {
+		require.NoError(t, repo.Save(context.Background(), todo), i)
+	}
+
+	found, err := repo.Search(context.Background(), "Report")
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, model.TodoID("title"), found[0].GetID())
+	assert.Equal(t, model.TodoID("description"), found[1].GetID())
+
+	found, err = repo.Search(context.Background(), "100%")
+	require.NoError(t, err)
+	assert.Empty(t, found)
+}
+
+func TestInMemoryTodoRepository_FindOrderedByPriority(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	now := time.Now()
+	for _, todo := range []*model.Todo{
+		model.NewTodoFromData("low", "Low", "", model.TodoStatusPending, model.TodoPriorityLow, now, now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("high", "High", "", model.TodoStatusPending, model.TodoPriorityHigh, now.Add(time.Minute), now, nil, "", "", nil, nil, ""),
+		model.NewTodoFromData("medium", "Medium", "", model.TodoStatusCompleted, model.TodoPriorityMedium, now, now, &now, "", "", nil, nil, ""),
+		model.NewTodoFromData("shelved", "Shelved", "", model.TodoStatusArchived, model.TodoPriorityHigh, now, now, nil, "", "", nil, nil, ""),
+	} {
+		require.NoError(t, repo.Save(context.Background(), todo))
+	}
+
+	todos, err := repo.FindOrderedByPriority(context.Background(), false)
+	require.NoError(t, err)
+	require.Len(t, todos, 3)
+	assert.Equal(t, model.TodoID("high"), todos[0].GetID())
+	assert.Equal(t, model.TodoID("medium"), todos[1].GetID())
+	assert.Equal(t, model.TodoID("low"), todos[2].GetID())
+
+	todos, err = repo.FindOrderedByPriority(context.Background(), true)
+	require.NoError(t, err)
+	assert.Len(t, todos, 4)
+}
+
+func TestInMemoryTodoRepository_Count(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	done := model.NewTodo("Write report", "quarterly numbers", model.TodoPriorityHigh)
+	require.NoError(t, done.MarkAsCompleted())
+	require.NoError(t, done.AddTag("work"))
+	require.NoError(t, done.SetSource(model.TodoSourceCLI))
+	for _, todo := range []*model.Todo{
+		model.NewTodo("Buy milk", "", model.TodoPriorityLow),
+		model.NewTodo("Call bank", "about the REPORT", model.TodoPriorityHigh),
+		model.NewTodo("Plan trip", "", model.TodoPriorityHigh),
+		done,
+	} {
+		require.NoError(t, repo.Save(context.Background(), todo))
+	}
+
+	tests := []struct {
+		name   string
+		filter model.TodoFilter
+		want   int
+	}{
+		{name: "no filter", filter: model.TodoFilter{}, want: 4},
+		{name: "status", filter: model.TodoFilter{Status: model.TodoStatusPending}, want: 3},
+		{name: "priority", filter: model.TodoFilter{Priority: model.TodoPriorityHigh}, want: 3},
+		{name: "status and priority", filter: model.TodoFilter{Status: model.TodoStatusPending, Priority: model.TodoPriorityHigh}, want: 2},
+		{name: "search title or description", filter: model.TodoFilter{Search: "report"}, want: 2},
+		{name: "search with status", filter: model.TodoFilter{Search: "report", Status: model.TodoStatusCompleted}, want: 1},
+		{name: "tag", filter: model.TodoFilter{Tag: "work"}, want: 1},
+		{name: "source", filter: model.TodoFilter{Source: model.TodoSourceCLI}, want: 1},
+		{name: "no match", filter: model.TodoFilter{Priority: model.TodoPriorityMedium}, want: 0},
+	}
+
+	all, err := repo.FindAll(context.Background())
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, err := repo.Count(context.Background(), tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, count)
+
+			// The count agrees with filtering the full listing
+			listed := 0
+			for _, todo := range all {
+				if tt.filter.Matches(todo) {
+					listed++
+				}
+			}
+			assert.Equal(t, listed, count)
+		})
+	}
+}
+
+func TestInMemoryTodoRepository_ConcurrentSaves(t *testing.T) {
+	repo := NewInMemoryTodoRepository()
+	const workers, perWorker = 10, 50
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				assert.NoError(t, repo.Save(context.Background(), model.NewTodo("Concurrent", "", model.TodoPriorityLow)))
+				repo.FindAllSorted()
+			}
+		}()
+	}
+	wg.Wait()
+
+	first := repo.FindAllSorted()
+	second := repo.FindAllSorted()
+	require.Len(t, first, workers*perWorker)
+	require.Len(t, second, workers*perWorker)
+
+	seen := make(map[model.TodoID]bool, len(first))
+	for i, todo := range first {
+		assert.Equal(t, todo.GetID(), second[i].GetID())
+		assert.False(t, seen[todo.GetID()])
+		seen[todo.GetID()] = true
+		if i > 0 {
+			assert.False(t, todo.GetCreatedAt().Before(first[i-1].GetCreatedAt()))
+		}
+	}
+}
+
+func TestInMemoryTodoRepository_Ping(t *testing.T) {
+	assert.NoError(t, NewInMemoryTodoRepository().Ping(context.Background()))
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+// InMemoryUserRepository implements port.UserRepositoryPort in memory.
+// Users are stored by value so callers cannot mutate persisted state without calling Save.
+type InMemoryUserRepository struct {
+	mu    sync.RWMutex
+	users map[model.UserID]model.User
+}
+
+// NewInMemoryUserRepository creates a new, empty InMemoryUserRepository
+func NewInMemoryUserRepository() *InMemoryUserRepository {
+	return &InMemoryUserRepository{users: make(map[model.UserID]model.User)}
+}
+
+var _ port.UserRepositoryPort = (*InMemoryUserRepository)(nil)
+
+// Save inserts or updates a User
+func (r *InMemoryUserRepository) Save(user *model.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users[user.GetID()] = *user
+	return nil
+}
+
+// FindByID retrieves a User by ID
+func (r *InMemoryUserRepository) FindByID(id model.UserID) (*model.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return nil, fmt.Errorf("user with id %s not found", id)
+	}
+	return &user, nil
+}
+
+// FindByEmail retrieves the User with the given email, compared case-insensitively,
+// or nil if there is none
+func (r *InMemoryUserRepository) FindByEmail(email string) (*model.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, stored := range r.users {
+		if strings.EqualFold(stored.GetEmail(), email) {
+			user := stored
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindAll retrieves all Users ordered by creation time
+func (r *InMemoryUserRepository) FindAll() ([]*model.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := make([]*model.User, 0, len(r.users))
+	for _, stored := range r.users {
+		user := stored
+		users = append(users, &user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		if !users[i].GetCreatedAt().Equal(users[j].GetCreatedAt()) {
+			return users[i].GetCreatedAt().Before(users[j].GetCreatedAt())
+		}
+		return users[i].GetID() < users[j].GetID()
+	})
+	return users, nil
+}
+
+// Delete removes a User by ID
+func (r *InMemoryUserRepository) Delete(id model.UserID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return fmt.Errorf("user with id %s not found", id)
+	}
+	delete(r.users, id)
+	return nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mr3iscuit/ddd-golang/domain/model"
+)
+
+func TestInMemoryUserRepository_SaveAndFindByID(t *testing.T) {
+	repo := NewInMemoryUserRepository()
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	require.NoError(t, repo.Save(user))
+
+	found, err := repo.FindByID(user.GetID())
+	require.NoError(t, err)
+	assert.Equal(t, user.GetEmail(), found.GetEmail())
+	assert.Equal(t, user.GetRole(), found.GetRole())
+
+	_, err = repo.FindByID("missing")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestInMemoryUserRepository_IsolatesStoredUsers(t *testing.T) {
+	repo := NewInMemoryUserRepository()
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	require.NoError(t, repo.Save(user))
+
+	require.NoError(t, user.PromoteToAdmin())
+
+	found, err := repo.FindByID(user.GetID())
+	require.NoError(t, err)
+	assert.False(t, found.IsAdmin())
+}
+
+func TestInMemoryUserRepository_FindByEmailIgnoresCase(t *testing.T) {
+	repo := NewInMemoryUserRepository()
+	user := model.NewUser("Ada@Example.com", "ada", "Ada", "Lovelace")
+	require.NoError(t, repo.Save(user))
+
+	found, err := repo.FindByEmail("ada@example.com")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, user.GetID(), found.GetID())
+
+	missing, err := repo.FindByEmail("grace@example.com")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestInMemoryUserRepository_FindAllOrdersByCreationTime(t *testing.T) {
+	repo := NewInMemoryUserRepository()
+	now := time.Now()
+	second := model.NewUserFromData("a", "b@example.com", "b", "B", "B", model.UserRoleUser, model.UserStatusActive, now, now, nil)
+	first := model.NewUserFromData("b", "a@example.com", "a", "A", "A", model.UserRoleUser, model.UserStatusActive, now.Add(-time.Minute), now, nil)
+	require.NoError(t, repo.Save(second))
+	require.NoError(t, repo.Save(first))
+
+	users, err := repo.FindAll()
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, first.GetID(), users[0].GetID())
+	assert.Equal(t, second.GetID(), users[1].GetID())
+}
+
+func TestInMemoryUserRepository_Delete(t *testing.T) {
+	repo := NewInMemoryUserRepository()
+	user := model.NewUser("ada@example.com", "ada", "Ada", "Lovelace")
+	require.NoError(t, repo.Save(user))
+
+	require.NoError(t, repo.Delete(user.GetID()))
+	assert.ErrorContains(t, repo.Delete(user.GetID()), "not found")
+}
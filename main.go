@@ -13,60 +13,176 @@
 // @BasePath  /
 // @schemes http
 
-// @securityDefinitions.basic  BasicAuth
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
+// @description                 Bearer token matching API_TOKEN, sent as "Bearer <token>"
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 
-	gormpostgres "gorm.io/driver/postgres"
-	"gorm.io/gorm"
-
+	"github.com/mr3iscuit/ddd-golang/adapters/cli"
 	handler "github.com/mr3iscuit/ddd-golang/adapters/http"
 	"github.com/mr3iscuit/ddd-golang/application/port"
 	"github.com/mr3iscuit/ddd-golang/application/usecase"
 	_ "github.com/mr3iscuit/ddd-golang/docs"
+	"github.com/mr3iscuit/ddd-golang/domain/model"
 	"github.com/mr3iscuit/ddd-golang/domain/service"
-	postgresrepo "github.com/mr3iscuit/ddd-golang/infrastructure/repository/postgres"
-
+	"github.com/mr3iscuit/ddd-golang/infrastructure/messaging"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/metrics"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/projection"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository"
 	"github.com/mr3iscuit/ddd-golang/pkg/config"
+	"github.com/mr3iscuit/ddd-golang/pkg/logger"
 )
 
 func main() {
+	if err := run(); err != nil {
+		log.Print(err)
+		os.Exit(1)
+	}
+}
+
+// run wires the application and runs the enabled adapters until they stop. It
+// returns its error rather than exiting, so the deferred closers always run.
+func run() error {
 	cfg, err := config.LoadConfig()
 	if err != nil {
-		log.Fatalf("Error loading configuration: %v", err)
+		return fmt.Errorf("error loading configuration: %w", err)
 	}
 
-	// Outbound port (repository)
-	var todoRepo port.TodoRepositoryPort
-
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
-		cfg.DBHost, cfg.DBUser, cfg.DBPassword, cfg.DBName, cfg.DBPort)
-
-	db, err := gorm.Open(gormpostgres.Open(dsn), &gorm.Config{})
+	appLogger, logCloser, err := logger.New(cfg)
 	if err != nil {
-		log.Fatalf("Failed to connect to DB: %v", err)
+		return fmt.Errorf("error configuring logger: %w", err)
 	}
+	defer logCloser.Close()
+	slog.SetDefault(appLogger)
 
-	log.Println("Using PostgresTodoRepository")
-	todoRepo = postgresrepo.NewPostgresTodoRepository(db)
+	// Outbound ports (repositories)
+	repos, err := repository.NewRepositories(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to set up %s repositories: %w", cfg.DBDriver, err)
+	}
+	log.Printf("Using %s repositories", cfg.DBDriver)
+	todoRepo, userRepo, categoryRepo, transactions := repos.Todos, repos.Users, repos.Categories, repos.Transactions
+
+	// Repository and use case metrics share one registry served at GET /metrics
+	var metricsRegistry *metrics.Registry
+	if cfg.MetricsEnabled {
+		log.Println("Recording repository and use case metrics")
+		metricsRegistry = metrics.NewRegistry()
+		todoRepo = repository.NewInstrumentedTodoRepository(todoRepo, metricsRegistry)
+		transactions = repository.NewInstrumentedTransactionManager(transactions, metricsRegistry)
+	}
 
 	// Domain service (outbound port implementation)
-	var domainService port.TodoDomainServicePort = service.NewTodoDomainService()
+	var domainService port.TodoDomainServicePort = service.NewTodoDomainService(
+		service.WithAllowEmptyTitle(cfg.AllowEmptyTitle),
+		service.WithTodoLimits(model.TodoLimits{MaxTitleLength: cfg.MaxTitleLength, MaxDescriptionLength: cfg.MaxDescriptionLength}),
+	)
+	// Event publisher (outbound port implementation)
+	inMemoryPublisher := messaging.NewInMemoryEventPublisher()
+	// Read models kept current from published events
+	searchIndex := projection.NewTodoSearchIndex()
+	inMemoryPublisher.Subscribe(searchIndex.Apply)
+	// Optional event store recording every published event so POST /admin/replay can rebuild the read models
+	var replayUseCase port.ReplayUseCasePort
+	if cfg.EventStoreEnabled {
+		log.Println("Recording published events for replay")
+		eventStore := messaging.NewInMemoryEventStore()
+		inMemoryPublisher.Subscribe(eventStore.Append)
+		replayUseCase = usecase.NewReplayUseCase(eventStore, searchIndex)
+	}
+	// Index the todos stored before startup; searches scan every todo until this finishes
+	go func() {
+		if err := searchIndex.Load(context.Background(), todoRepo); err != nil {
+			log.Printf("Failed to load the search index, searches will scan every todo: %v", err)
+		}
+	}()
+	var eventPublisher port.EventPublisherPort = inMemoryPublisher
+	if cfg.AsyncEvents {
+		log.Println("Dispatching events asynchronously")
+		asyncPublisher := messaging.NewAsyncEventPublisher(eventPublisher, cfg.EventBufferSize, cfg.EventWorkers,
+			cfg.EventOverflowPolicy == config.EventOverflowDrop)
+		defer asyncPublisher.Close()
+		eventPublisher = asyncPublisher
+	}
 	// Use case (inbound port implementation)
-	var todoUseCase port.TodoUseCasePort = usecase.NewTodoUseCase(todoRepo, domainService)
-	// Handler (inbound adapter)
+	var todoUseCase port.TodoUseCasePort = usecase.NewTodoUseCase(todoRepo, domainService,
+		usecase.WithEventPublisher(eventPublisher),
+		usecase.WithCategoryRepository(categoryRepo),
+		usecase.WithConfig(cfg),
+		usecase.WithLogger(appLogger),
+		usecase.WithSearchProjection(searchIndex),
+		usecase.WithTransactionManager(transactions),
+	)
+	if metricsRegistry != nil {
+		todoUseCase = metrics.NewInstrumentedTodoUseCase(todoUseCase, metricsRegistry)
+	}
+	var userUseCase port.UserUseCasePort = usecase.NewUserUseCase(userRepo)
+	var categoryUseCase port.CategoryUseCasePort = usecase.NewCategoryUseCase(categoryRepo)
+	var bootstrapUseCase port.BootstrapUseCasePort = usecase.NewBootstrapUseCase(todoUseCase, categoryUseCase)
+	var dependencyUseCase port.TodoDependencyUseCasePort = usecase.NewTodoDependencyUseCase(todoRepo, usecase.WithDependencyTransactionManager(transactions))
+	// Handlers (inbound adapters) sharing one router
 	todoHandler := handler.NewTodoHTTPAdapter(todoUseCase, cfg)
+	userHandler := handler.NewUserHTTPAdapter(userUseCase, cfg)
+	categoryHandler := handler.NewCategoryHTTPAdapter(categoryUseCase, cfg)
+	adminHandler := handler.NewAdminHTTPAdapter(replayUseCase, todoUseCase, cfg)
+	bootstrapHandler := handler.NewBootstrapHTTPAdapter(bootstrapUseCase, cfg)
+	dependencyHandler := handler.NewTodoDependencyHTTPAdapter(dependencyUseCase, cfg)
+	routes := []handler.RouteRegistrar{userHandler, categoryHandler, adminHandler, bootstrapHandler, dependencyHandler}
+	if metricsRegistry != nil {
+		routes = append(routes, handler.NewMetricsHTTPAdapter(metricsRegistry))
+	}
 
-	log.Printf("Starting HTTP server on :%s", cfg.ServerPort)
-	if err := http.ListenAndServe(fmt.Sprintf(":%s", cfg.ServerPort), todoHandler.Router()); err != nil {
-		log.Fatal("Failed to start server:", err)
+	cliHandler := cli.NewTodoCLIAdapter(todoUseCase, cfg)
+
+	runners := map[string]adapterRunner{
+		config.AdapterHTTP: func(ctx context.Context) error {
+			server := &http.Server{Addr: fmt.Sprintf(":%s", cfg.ServerPort), Handler: todoHandler.Router(routes...)}
+			ln, err := net.Listen("tcp", server.Addr)
+			if err != nil {
+				return err
+			}
+			log.Printf("Starting HTTP server on :%s", cfg.ServerPort)
+			return serveHTTP(ctx, server, ln)
+		},
+		config.AdapterCLI: func(ctx context.Context) error {
+			return runUntilDone(ctx, cliHandler.Run)
+		},
 	}
 
-	// CLI usage (uncomment to use CLI instead of HTTP)
-	// cli := cli.NewCLI(todoService)
-	// cli.Run()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Background jobs stop with the adapters, before the deferred closers run
+	var jobs sync.WaitGroup
+	if cfg.EnablePriorityEscalation {
+		log.Println("Escalating the priority of neglected todos")
+		escalator := usecase.NewPriorityEscalator(todoRepo, eventPublisher, cfg, appLogger)
+		jobs.Add(1)
+		go func() {
+			defer jobs.Done()
+			escalator.Run(ctx)
+		}()
+	}
+
+	// Deferred closers run after every adapter has stopped, draining queued events
+	err = runAdapters(ctx, runners, cfg.EnabledAdapters)
+	stop()
+	jobs.Wait()
+	if err != nil {
+		return fmt.Errorf("failed to run adapters: %w", err)
+	}
+	return nil
 }
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun_ReturnsSetupErrors(t *testing.T) {
+	t.Setenv("MAX_TITLE_LENGTH", "0")
+
+	err := run()
+
+	assert.ErrorContains(t, err, "error loading configuration")
+	assert.ErrorContains(t, err, "MAX_TITLE_LENGTH")
+}
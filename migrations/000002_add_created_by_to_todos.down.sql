@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_todos_created_by;
+
+ALTER TABLE todos DROP COLUMN IF EXISTS created_by;
@@ -0,0 +1,4 @@
+-- Track the user who owns each todo
+ALTER TABLE todos ADD COLUMN created_by VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_todos_created_by ON todos(created_by);
@@ -0,0 +1,8 @@
+-- Drop trigger first
+DROP TRIGGER IF EXISTS update_users_updated_at ON users;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_users_email;
+
+-- Drop table
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,5 @@
+-- Drop trigger first
+DROP TRIGGER IF EXISTS update_categories_updated_at ON categories;
+
+-- Drop table
+DROP TABLE IF EXISTS categories;
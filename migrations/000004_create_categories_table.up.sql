@@ -0,0 +1,17 @@
+-- Create categories table
+CREATE TABLE categories (
+    id VARCHAR(255) PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    description VARCHAR(200),
+    color VARCHAR(50) NOT NULL,
+    created_by VARCHAR(255),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+-- Reuse the updated_at trigger function from the todos migration
+CREATE TRIGGER update_categories_updated_at
+    BEFORE UPDATE ON categories
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
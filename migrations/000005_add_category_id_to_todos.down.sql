@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_todos_category_id;
+
+ALTER TABLE todos DROP COLUMN IF EXISTS category_id;
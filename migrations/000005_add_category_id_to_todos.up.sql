@@ -0,0 +1,4 @@
+-- Link todos to the category they are filed under; empty when uncategorized
+ALTER TABLE todos ADD COLUMN category_id VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_todos_category_id ON todos(category_id);
@@ -0,0 +1,4 @@
+-- Optional date by which a todo should be completed
+ALTER TABLE todos ADD COLUMN due_date TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_todos_due_date ON todos(due_date);
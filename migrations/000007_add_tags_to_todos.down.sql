@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_todos_tags;
+
+ALTER TABLE todos DROP COLUMN IF EXISTS tags;
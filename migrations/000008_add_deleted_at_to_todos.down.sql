@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_todos_deleted_at;
+
+ALTER TABLE todos DROP COLUMN IF EXISTS deleted_at;
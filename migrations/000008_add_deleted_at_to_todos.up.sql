@@ -0,0 +1,4 @@
+-- Soft-delete marker; deleted todos stay as tombstones for cache sync
+ALTER TABLE todos ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_todos_deleted_at ON todos(deleted_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_todos_source;
+
+ALTER TABLE todos DROP COLUMN IF EXISTS source;
@@ -0,0 +1,4 @@
+-- Where a todo was created: http, cli, grpc or import; empty when unknown
+ALTER TABLE todos ADD COLUMN source VARCHAR(50) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_todos_source ON todos(source);
@@ -0,0 +1,10 @@
+-- Todos that must be completed before another todo is actionable
+CREATE TABLE todo_dependencies (
+    todo_id VARCHAR(255) NOT NULL,
+    depends_on_id VARCHAR(255) NOT NULL,
+    PRIMARY KEY (todo_id, depends_on_id),
+    CONSTRAINT fk_todos_dependencies FOREIGN KEY (todo_id) REFERENCES todos(id) ON DELETE CASCADE
+);
+
+-- depends_on_id has no foreign key: a deleted dependency stops blocking instead of being cascaded
+CREATE INDEX idx_todo_dependencies_depends_on_id ON todo_dependencies(depends_on_id);
@@ -0,0 +1,5 @@
+-- The application sets updated_at only when a todo really changes, which is what
+-- stale todo lookups rely on; the trigger overwrote it on every UPDATE
+DROP TRIGGER IF EXISTS update_todos_updated_at ON todos;
+
+DROP FUNCTION IF EXISTS update_updated_at_column();
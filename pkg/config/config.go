@@ -4,18 +4,236 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
 
+// Root path behaviors supported by the HTTP adapter
+const (
+	RootBehaviorIndex    = "index"
+	RootBehaviorRedirect = "redirect"
+	RootBehaviorDisabled = "disabled"
+)
+
+// Log outputs supported by the logger
+const (
+	LogOutputStdout = "stdout"
+	LogOutputStderr = "stderr"
+	LogOutputFile   = "file"
+	LogOutputBoth   = "both"
+)
+
+// Log levels accepted by LOG_LEVEL
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+// Deployment environments accepted by ENVIRONMENT
+const (
+	EnvironmentDevelopment = "development"
+	EnvironmentProduction  = "production"
+)
+
+// publicUnavailableMessage is the generic text production shows for storage failures
+const publicUnavailableMessage = "Service temporarily unavailable"
+
+// Database drivers accepted by DB_DRIVER
+const (
+	DBDriverPostgres = "postgres"
+	DBDriverSQLite   = "sqlite"
+	DBDriverMemory   = "memory"
+)
+
+// Overflow policies for the async event queue
+const (
+	EventOverflowBlock = "block"
+	EventOverflowDrop  = "drop"
+)
+
+// Inbound adapters that can be enabled
+const (
+	AdapterHTTP    = "http"
+	AdapterCLI     = "cli"
+	AdapterGRPC    = "grpc"
+	AdapterGraphQL = "graphql"
+)
+
 // Config holds all application configuration settings
 type Config struct {
-	DBHost     string
-	DBPort     string
-	DBUser     string
-	DBPassword string
-	DBName     string
-	ServerPort string
+	DBHost       string
+	DBPort       string
+	DBUser       string
+	DBPassword   string
+	DBName       string
+	ServerPort   string
+	RootBehavior string
+	// DBDriver selects the database backend: postgres, sqlite for running
+	// without external dependencies (requires building with -tags sqlite), or
+	// memory for non-persistent in-process storage
+	DBDriver string
+	// SQLitePath is the SQLite database file used when DBDriver is sqlite
+	SQLitePath string
+	// DBReplicaDSN is the DSN of a read replica serving todo reads; empty sends reads to the primary
+	DBReplicaDSN string
+	// ReplicaReadAfterWriteSeconds sends every read to the primary for this long after a write,
+	// tolerating replica lag; 0 relies on clients sending X-Consistency: strong instead
+	ReplicaReadAfterWriteSeconds int
+	// DBLogRowMismatches logs a warning whenever a todo write affects a different
+	// number of rows than expected, such as an update matching no row
+	DBLogRowMismatches bool
+	// EnabledAdapters lists the inbound adapters main starts: http, cli, grpc, graphql
+	EnabledAdapters []string
+	// StrictContentNegotiation rejects requests whose Accept header excludes JSON
+	StrictContentNegotiation bool
+	// MetricsEnabled instruments the repository and use cases with per-operation
+	// metrics served at GET /metrics
+	MetricsEnabled bool
+	// AuditLog logs every todo status transition at INFO level
+	AuditLog bool
+	// StaleOnError serves the last successfully read todos when a repository read fails
+	StaleOnError bool
+	// NormalizeTitles trims todo titles and collapses internal whitespace
+	NormalizeTitles bool
+	// AllowEmptyTitle accepts todos created without a title, storing them as "Untitled"
+	AllowEmptyTitle bool
+	// DefaultDescription is applied to todos created without a description
+	DefaultDescription string
+	// AllowArchiveCompleted permits archiving todos that are already completed
+	AllowArchiveCompleted bool
+	// RequireCategoryForCompletion rejects completing todos that have no category
+	RequireCategoryForCompletion bool
+	// RepairInconsistentState backfills completedAt from updatedAt on completed todos read without one
+	RepairInconsistentState bool
+	// InferPriority suggests a priority from title keywords when a create command omits it
+	InferPriority bool
+	// MaxBulkOperationSize limits how many IDs a single bulk request may carry
+	MaxBulkOperationSize int
+	// MaxDescriptionLength limits todo descriptions in both validation and the aggregate
+	MaxDescriptionLength int
+	// MaxTitleLength limits todo titles; the CLI also checks it before dispatching
+	MaxTitleLength int
+	// CORSExposedHeaders lists the response headers browsers may read on requests from allowed origins
+	CORSExposedHeaders []string
+	// CORSAllowedOrigins lists the origins allowed to call the API, or "*" for any; empty disables CORS
+	CORSAllowedOrigins []string
+	// CORSAllowedMethods and CORSAllowedHeaders are advertised in answers to preflight requests
+	CORSAllowedMethods []string
+	CORSAllowedHeaders []string
+	// RequestIDHeader lists the inbound headers a request ID is read from; the first present wins
+	RequestIDHeader []string
+	// RetryAfterSeconds is the Retry-After hint sent with 429, 503 and 504 responses
+	RetryAfterSeconds int
+	// MaxURLLength rejects requests whose path and query string exceed this many bytes; 0 disables the check
+	MaxURLLength int
+	// APIToken is the bearer token every request must carry; empty leaves the API unauthenticated
+	APIToken string
+	// AdminToken must be sent as X-Admin-Token on guarded admin endpoints; empty disables them
+	AdminToken string
+
+	// Environment is development or production
+	Environment string
+	// PublicErrorMessages maps error codes to the generic message clients see in
+	// production instead of the error's own message, which is logged instead
+	PublicErrorMessages map[int]string
+
+	// EnablePriorityEscalation runs a background job raising the priority of neglected pending todos
+	EnablePriorityEscalation bool
+	// EscalateLowAfterHours and EscalateMediumAfterHours are how long a low or medium priority
+	// todo may go without updates before it is escalated one level
+	EscalateLowAfterHours    int
+	EscalateMediumAfterHours int
+	// PriorityEscalationIntervalMinutes is how often the escalation job runs
+	PriorityEscalationIntervalMinutes int
+
+	// AsyncEvents dispatches domain events from a bounded queue instead of inline
+	AsyncEvents bool
+	// EventBufferSize and EventWorkers size the async event queues and their workers; the
+	// events of one todo always go to the same worker, so they are handled in order
+	EventBufferSize int
+	EventWorkers    int
+	// EventOverflowPolicy decides what happens when the queue is full: block or drop
+	EventOverflowPolicy string
+	// EventStoreEnabled keeps every published event in memory so POST /admin/replay
+	// can rebuild the projections. The log grows with every write and is lost on
+	// restart, so it is off by default and the replay endpoint is then not served.
+	EventStoreEnabled bool
+
+	// LogOutput selects where logs are written: stdout, stderr, file or both (stdout and file)
+	LogOutput   string
+	LogFilePath string
+	// LogLevel is the minimum level logged: debug, info, warn or error. Request logs are
+	// written at info, or warn and error for 4xx and 5xx responses.
+	LogLevel string
+	// LogMaxSizeMB and LogMaxAgeDays control rotation of the log file
+	LogMaxSizeMB  int
+	LogMaxAgeDays int
+}
+
+// Default returns the configuration used when no environment overrides are set
+func Default() *Config {
+	return &Config{
+		DBHost:       "localhost",
+		DBPort:       "5432",
+		DBUser:       "todo_user",
+		DBPassword:   "todo_password",
+		DBName:       "todo_db",
+		ServerPort:   "8080",
+		RootBehavior: RootBehaviorIndex,
+
+		DBDriver:   DBDriverPostgres,
+		SQLitePath: "todo.db",
+
+		EnabledAdapters: []string{AdapterHTTP},
+
+		NormalizeTitles:       true,
+		AllowArchiveCompleted: true,
+		MaxDescriptionLength:  1000,
+		MaxTitleLength:        100,
+		MaxBulkOperationSize:  100,
+
+		CORSExposedHeaders: []string{"X-Error-Type", "X-Request-ID", "X-Total-Count", "X-Served-Stale"},
+		CORSAllowedMethods: []string{"GET", "POST", "PUT", "DELETE"},
+		CORSAllowedHeaders: []string{"Accept", "Content-Type", "X-Consistency", "X-Request-ID"},
+		RequestIDHeader:    []string{"X-Request-ID"},
+		RetryAfterSeconds:  30,
+		MaxURLLength:       2048,
+
+		Environment: EnvironmentDevelopment,
+		PublicErrorMessages: map[int]string{
+			4001: publicUnavailableMessage, // ErrRepositoryNotInitialized
+			4002: publicUnavailableMessage, // ErrFailedToSaveTodo
+			4003: publicUnavailableMessage, // ErrFailedToSaveCompletedTodo
+			4004: publicUnavailableMessage, // ErrFailedToSaveArchivedTodo
+			4005: publicUnavailableMessage, // ErrFailedToRetrieveTodos
+			4006: publicUnavailableMessage, // ErrFailedToDeleteTodo
+			4007: publicUnavailableMessage, // ErrFailedToSaveUser
+			4008: publicUnavailableMessage, // ErrFailedToRetrieveUsers
+			4009: publicUnavailableMessage, // ErrFailedToSaveCategory
+			4010: publicUnavailableMessage, // ErrFailedToRetrieveCategories
+			4011: publicUnavailableMessage, // ErrFailedToDeleteCategory
+			4012: publicUnavailableMessage, // ErrFailedToReplayEvents
+			4013: publicUnavailableMessage, // ErrDatabaseUnavailable
+		},
+
+		EscalateLowAfterHours:             72,
+		EscalateMediumAfterHours:          168,
+		PriorityEscalationIntervalMinutes: 60,
+
+		EventBufferSize:     100,
+		EventWorkers:        2,
+		EventOverflowPolicy: EventOverflowBlock,
+
+		LogOutput:     LogOutputStderr,
+		LogFilePath:   "logs/app.log",
+		LogLevel:      LogLevelInfo,
+		LogMaxSizeMB:  100,
+		LogMaxAgeDays: 28,
+	}
 }
 
 // LoadConfig loads configuration from environment variables and .env file
@@ -28,18 +246,159 @@ func LoadConfig() (*Config, error) {
 		}
 	}
 
+	defaults := Default()
 	cfg := &Config{
-		DBHost:     getEnv("DB_HOST", "localhost"),
-		DBPort:     getEnv("DB_PORT", "5432"),
-		DBUser:     getEnv("DB_USER", "todo_user"),
-		DBPassword: getEnv("DB_PASSWORD", "todo_password"),
-		DBName:     getEnv("DB_NAME", "todo_db"),
-		ServerPort: getEnv("SERVER_PORT", "8080"),
+		DBHost:       getEnv("DB_HOST", defaults.DBHost),
+		DBPort:       getEnv("DB_PORT", defaults.DBPort),
+		DBUser:       getEnv("DB_USER", defaults.DBUser),
+		DBPassword:   getEnv("DB_PASSWORD", defaults.DBPassword),
+		DBName:       getEnv("DB_NAME", defaults.DBName),
+		DBReplicaDSN: getEnv("DB_REPLICA_DSN", defaults.DBReplicaDSN),
+		ServerPort:   getEnv("SERVER_PORT", defaults.ServerPort),
+		RootBehavior: getEnv("ROOT_BEHAVIOR", defaults.RootBehavior),
+
+		DBDriver:   getEnv("DB_DRIVER", defaults.DBDriver),
+		SQLitePath: getEnv("SQLITE_PATH", defaults.SQLitePath),
+
+		ReplicaReadAfterWriteSeconds: getEnvInt("REPLICA_READ_AFTER_WRITE_SECONDS", defaults.ReplicaReadAfterWriteSeconds),
+		DBLogRowMismatches:           getEnvBool("DB_LOG_ROW_MISMATCHES", defaults.DBLogRowMismatches),
+
+		EnabledAdapters: getEnvList("ENABLED_ADAPTERS", defaults.EnabledAdapters),
+
+		StrictContentNegotiation:     getEnvBool("STRICT_CONTENT_NEGOTIATION", defaults.StrictContentNegotiation),
+		MetricsEnabled:               getEnvBool("METRICS_ENABLED", defaults.MetricsEnabled),
+		StaleOnError:                 getEnvBool("STALE_ON_ERROR", defaults.StaleOnError),
+		AuditLog:                     getEnvBool("AUDIT_LOG", defaults.AuditLog),
+		NormalizeTitles:              getEnvBool("NORMALIZE_TITLES", defaults.NormalizeTitles),
+		AllowEmptyTitle:              getEnvBool("ALLOW_EMPTY_TITLE", defaults.AllowEmptyTitle),
+		InferPriority:                getEnvBool("INFER_PRIORITY", defaults.InferPriority),
+		AllowArchiveCompleted:        getEnvBool("ALLOW_ARCHIVE_COMPLETED", defaults.AllowArchiveCompleted),
+		RequireCategoryForCompletion: getEnvBool("REQUIRE_CATEGORY_FOR_COMPLETION", defaults.RequireCategoryForCompletion),
+		RepairInconsistentState:      getEnvBool("REPAIR_INCONSISTENT_STATE", defaults.RepairInconsistentState),
+		DefaultDescription:           getEnv("DEFAULT_DESCRIPTION", defaults.DefaultDescription),
+		MaxDescriptionLength:         getEnvInt("MAX_DESCRIPTION_LENGTH", defaults.MaxDescriptionLength),
+		MaxTitleLength:               getEnvInt("MAX_TITLE_LENGTH", defaults.MaxTitleLength),
+		MaxBulkOperationSize:         getEnvInt("MAX_BULK_OPERATION_SIZE", defaults.MaxBulkOperationSize),
+		CORSExposedHeaders:           getEnvList("CORS_EXPOSED_HEADERS", defaults.CORSExposedHeaders),
+		CORSAllowedOrigins:           getEnvList("CORS_ALLOWED_ORIGINS", defaults.CORSAllowedOrigins),
+		CORSAllowedMethods:           getEnvList("CORS_ALLOWED_METHODS", defaults.CORSAllowedMethods),
+		CORSAllowedHeaders:           getEnvList("CORS_ALLOWED_HEADERS", defaults.CORSAllowedHeaders),
+		RequestIDHeader:              getEnvList("REQUEST_ID_HEADER", defaults.RequestIDHeader),
+		RetryAfterSeconds:            getEnvInt("RETRY_AFTER_SECONDS", defaults.RetryAfterSeconds),
+		APIToken:                     getEnv("API_TOKEN", defaults.APIToken),
+		AdminToken:                   getEnv("ADMIN_TOKEN", defaults.AdminToken),
+		MaxURLLength:                 getEnvInt("MAX_URL_LENGTH", defaults.MaxURLLength),
+
+		Environment:         getEnv("ENVIRONMENT", defaults.Environment),
+		PublicErrorMessages: getEnvCodeMap("PUBLIC_ERROR_MESSAGES", defaults.PublicErrorMessages),
+
+		EnablePriorityEscalation:          getEnvBool("ENABLE_PRIORITY_ESCALATION", defaults.EnablePriorityEscalation),
+		EscalateLowAfterHours:             getEnvInt("ESCALATE_LOW_AFTER_HOURS", defaults.EscalateLowAfterHours),
+		EscalateMediumAfterHours:          getEnvInt("ESCALATE_MEDIUM_AFTER_HOURS", defaults.EscalateMediumAfterHours),
+		PriorityEscalationIntervalMinutes: getEnvInt("PRIORITY_ESCALATION_INTERVAL_MINUTES", defaults.PriorityEscalationIntervalMinutes),
+
+		AsyncEvents:         getEnvBool("ASYNC_EVENTS", defaults.AsyncEvents),
+		EventStoreEnabled:   getEnvBool("EVENT_STORE_ENABLED", defaults.EventStoreEnabled),
+		EventBufferSize:     getEnvInt("EVENT_BUFFER_SIZE", defaults.EventBufferSize),
+		EventWorkers:        getEnvInt("EVENT_WORKERS", defaults.EventWorkers),
+		EventOverflowPolicy: getEnv("EVENT_OVERFLOW_POLICY", defaults.EventOverflowPolicy),
+
+		LogOutput:     getEnv("LOG_OUTPUT", defaults.LogOutput),
+		LogFilePath:   getEnv("LOG_FILE_PATH", defaults.LogFilePath),
+		LogLevel:      getEnv("LOG_LEVEL", defaults.LogLevel),
+		LogMaxSizeMB:  getEnvInt("LOG_MAX_SIZE_MB", defaults.LogMaxSizeMB),
+		LogMaxAgeDays: getEnvInt("LOG_MAX_AGE_DAYS", defaults.LogMaxAgeDays),
 	}
 
 	// Basic validation: ensure critical DB configs are not empty
-	if cfg.DBHost == "" || cfg.DBUser == "" || cfg.DBPassword == "" || cfg.DBName == "" || cfg.DBPort == "" {
-		return nil, fmt.Errorf("missing critical database environment variables: DB_HOST, DB_USER, DB_PASSWORD, DB_NAME, DB_PORT must be set")
+	switch cfg.DBDriver {
+	case DBDriverPostgres:
+		if cfg.DBHost == "" || cfg.DBUser == "" || cfg.DBPassword == "" || cfg.DBName == "" || cfg.DBPort == "" {
+			return nil, fmt.Errorf("missing critical database environment variables: DB_HOST, DB_USER, DB_PASSWORD, DB_NAME, DB_PORT must be set")
+		}
+	case DBDriverSQLite:
+		if cfg.SQLitePath == "" {
+			return nil, fmt.Errorf("missing SQLITE_PATH: required when DB_DRIVER is sqlite")
+		}
+		if cfg.DBReplicaDSN != "" {
+			return nil, fmt.Errorf("invalid DB_REPLICA_DSN: read replicas require DB_DRIVER postgres")
+		}
+	case DBDriverMemory:
+		if cfg.DBReplicaDSN != "" {
+			return nil, fmt.Errorf("invalid DB_REPLICA_DSN: read replicas require DB_DRIVER postgres")
+		}
+	default:
+		return nil, fmt.Errorf("invalid DB_DRIVER %q: must be one of postgres, sqlite, memory", cfg.DBDriver)
+	}
+
+	switch cfg.Environment {
+	case EnvironmentDevelopment, EnvironmentProduction:
+	default:
+		return nil, fmt.Errorf("invalid ENVIRONMENT %q: must be one of development, production", cfg.Environment)
+	}
+
+	switch cfg.RootBehavior {
+	case RootBehaviorIndex, RootBehaviorRedirect, RootBehaviorDisabled:
+	default:
+		return nil, fmt.Errorf("invalid ROOT_BEHAVIOR %q: must be one of index, redirect, disabled", cfg.RootBehavior)
+	}
+
+	if len(cfg.EnabledAdapters) == 0 {
+		return nil, fmt.Errorf("invalid ENABLED_ADAPTERS: at least one adapter must be enabled")
+	}
+	for _, adapter := range cfg.EnabledAdapters {
+		switch adapter {
+		case AdapterHTTP, AdapterCLI, AdapterGRPC, AdapterGraphQL:
+		default:
+			return nil, fmt.Errorf("invalid ENABLED_ADAPTERS entry %q: must be one of http, cli, grpc, graphql", adapter)
+		}
+	}
+
+	if cfg.MaxDescriptionLength <= 0 {
+		return nil, fmt.Errorf("invalid MAX_DESCRIPTION_LENGTH %d: must be positive", cfg.MaxDescriptionLength)
+	}
+
+	if cfg.MaxTitleLength <= 0 {
+		return nil, fmt.Errorf("invalid MAX_TITLE_LENGTH %d: must be positive", cfg.MaxTitleLength)
+	}
+
+	if cfg.MaxBulkOperationSize <= 0 {
+		return nil, fmt.Errorf("invalid MAX_BULK_OPERATION_SIZE %d: must be positive", cfg.MaxBulkOperationSize)
+	}
+
+	if cfg.ReplicaReadAfterWriteSeconds < 0 {
+		return nil, fmt.Errorf("invalid REPLICA_READ_AFTER_WRITE_SECONDS %d: must not be negative", cfg.ReplicaReadAfterWriteSeconds)
+	}
+
+	if cfg.MaxURLLength < 0 {
+		return nil, fmt.Errorf("invalid MAX_URL_LENGTH %d: must not be negative", cfg.MaxURLLength)
+	}
+
+	if cfg.EscalateLowAfterHours <= 0 || cfg.EscalateMediumAfterHours <= 0 || cfg.PriorityEscalationIntervalMinutes <= 0 {
+		return nil, fmt.Errorf("invalid priority escalation settings: ESCALATE_LOW_AFTER_HOURS %d, ESCALATE_MEDIUM_AFTER_HOURS %d and PRIORITY_ESCALATION_INTERVAL_MINUTES %d must be positive",
+			cfg.EscalateLowAfterHours, cfg.EscalateMediumAfterHours, cfg.PriorityEscalationIntervalMinutes)
+	}
+
+	if cfg.EventBufferSize < 0 || cfg.EventWorkers <= 0 {
+		return nil, fmt.Errorf("invalid EVENT_BUFFER_SIZE %d or EVENT_WORKERS %d: buffer must be non-negative and workers positive", cfg.EventBufferSize, cfg.EventWorkers)
+	}
+
+	switch cfg.EventOverflowPolicy {
+	case EventOverflowBlock, EventOverflowDrop:
+	default:
+		return nil, fmt.Errorf("invalid EVENT_OVERFLOW_POLICY %q: must be one of block, drop", cfg.EventOverflowPolicy)
+	}
+
+	switch cfg.LogOutput {
+	case LogOutputStdout, LogOutputStderr, LogOutputFile, LogOutputBoth:
+	default:
+		return nil, fmt.Errorf("invalid LOG_OUTPUT %q: must be one of stdout, stderr, file, both", cfg.LogOutput)
+	}
+
+	switch cfg.LogLevel {
+	case LogLevelDebug, LogLevelInfo, LogLevelWarn, LogLevelError:
+	default:
+		return nil, fmt.Errorf("invalid LOG_LEVEL %q: must be one of debug, info, warn, error", cfg.LogLevel)
 	}
 
 	return cfg, nil
@@ -52,3 +411,69 @@ func getEnv(key string, fallback string) string {
 	}
 	return fallback
 }
+
+// getEnvBool retrieves a boolean environment variable or returns a fallback value
+func getEnvBool(key string, fallback bool) bool {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Printf("Warning: invalid boolean for %s: %q, using %t", key, value, fallback)
+		return fallback
+	}
+	return parsed
+}
+
+// getEnvInt retrieves an integer environment variable or returns a fallback value
+func getEnvInt(key string, fallback int) int {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	parsed, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		log.Printf("Warning: invalid integer for %s: %q, using %d", key, value, fallback)
+		return fallback
+	}
+	return parsed
+}
+
+// getEnvList retrieves a comma-separated environment variable or returns a fallback value
+func getEnvList(key string, fallback []string) []string {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getEnvCodeMap retrieves a semicolon-separated list of code=message pairs, such as
+// "4005=Service temporarily unavailable;4008=Try again later", or returns a fallback value
+func getEnvCodeMap(key string, fallback map[int]string) map[int]string {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	messages := make(map[int]string)
+	for _, pair := range strings.Split(value, ";") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		rawCode, message, found := strings.Cut(pair, "=")
+		code, err := strconv.Atoi(strings.TrimSpace(rawCode))
+		if !found || err != nil {
+			log.Printf("Warning: invalid code=message pair for %s: %q, using the default", key, pair)
+			return fallback
+		}
+		messages[code] = strings.TrimSpace(message)
+	}
+	return messages
+}
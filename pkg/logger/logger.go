@@ -0,0 +1,70 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+// New builds the application logger writing to the configured output.
+// The returned closer releases the log file, if any, and must be called on shutdown.
+func New(cfg *config.Config) (*slog.Logger, io.Closer, error) {
+	writer, closer, err := newWriter(cfg, os.Stdout, os.Stderr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return slog.New(slog.NewJSONHandler(writer, &slog.HandlerOptions{Level: level(cfg.LogLevel)})), closer, nil
+}
+
+// level maps the configured log level to a slog level, defaulting to info
+func level(name string) slog.Level {
+	switch name {
+	case config.LogLevelDebug:
+		return slog.LevelDebug
+	case config.LogLevelWarn:
+		return slog.LevelWarn
+	case config.LogLevelError:
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// newWriter resolves the configured log output to a writer
+func newWriter(cfg *config.Config, stdout io.Writer, stderr io.Writer) (io.Writer, io.Closer, error) {
+	switch cfg.LogOutput {
+	case config.LogOutputStdout:
+		return stdout, nopCloser{}, nil
+	case config.LogOutputStderr, "":
+		return stderr, nopCloser{}, nil
+	case config.LogOutputFile:
+		file := newRotatingFile(cfg)
+		return file, file, nil
+	case config.LogOutputBoth:
+		file := newRotatingFile(cfg)
+		return io.MultiWriter(stdout, file), file, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported log output %q", cfg.LogOutput)
+	}
+}
+
+// newRotatingFile opens the configured log file with size and age based rotation
+func newRotatingFile(cfg *config.Config) *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename: cfg.LogFilePath,
+		MaxSize:  cfg.LogMaxSizeMB,
+		MaxAge:   cfg.LogMaxAgeDays,
+	}
+}
+
+// nopCloser is returned for outputs the logger does not own
+type nopCloser struct{}
+
+func (nopCloser) Close() error {
+	return nil
+}
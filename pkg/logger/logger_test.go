@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+func writeLine(t *testing.T, cfg *config.Config, stdout *bytes.Buffer, stderr *bytes.Buffer) {
+	writer, closer, err := newWriter(cfg, stdout, stderr)
+	require.NoError(t, err)
+	slog.New(slog.NewJSONHandler(writer, nil)).Info("hello sink")
+	require.NoError(t, closer.Close())
+}
+
+func TestNewWriter_Stdout(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	writeLine(t, &config.Config{LogOutput: config.LogOutputStdout}, &stdout, &stderr)
+
+	assert.Contains(t, stdout.String(), "hello sink")
+	assert.Empty(t, stderr.String())
+}
+
+func TestNewWriter_Stderr(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	writeLine(t, &config.Config{LogOutput: config.LogOutputStderr}, &stdout, &stderr)
+
+	assert.Empty(t, stdout.String())
+	assert.Contains(t, stderr.String(), "hello sink")
+}
+
+func TestNewWriter_File(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	path := filepath.Join(t.TempDir(), "app.log")
+	writeLine(t, &config.Config{LogOutput: config.LogOutputFile, LogFilePath: path, LogMaxSizeMB: 1}, &stdout, &stderr)
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "hello sink")
+	assert.Empty(t, stdout.String())
+	assert.Empty(t, stderr.String())
+}
+
+func TestNewWriter_Both(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	path := filepath.Join(t.TempDir(), "app.log")
+	writeLine(t, &config.Config{LogOutput: config.LogOutputBoth, LogFilePath: path, LogMaxSizeMB: 1}, &stdout, &stderr)
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "hello sink")
+	assert.Contains(t, stdout.String(), "hello sink")
+	assert.Empty(t, stderr.String())
+}
+
+func TestNewWriter_Unsupported(t *testing.T) {
+	_, _, err := newWriter(&config.Config{LogOutput: "syslog"}, &bytes.Buffer{}, &bytes.Buffer{})
+	assert.Error(t, err)
+}
+
+func TestLevel(t *testing.T) {
+	assert.Equal(t, slog.LevelDebug, level(config.LogLevelDebug))
+	assert.Equal(t, slog.LevelInfo, level(config.LogLevelInfo))
+	assert.Equal(t, slog.LevelWarn, level(config.LogLevelWarn))
+	assert.Equal(t, slog.LevelError, level(config.LogLevelError))
+	assert.Equal(t, slog.LevelInfo, level(""))
+}
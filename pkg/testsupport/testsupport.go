@@ -0,0 +1,125 @@
+// Package testsupport provides shared setup for integration tests: a migrated
+// test database, a repository for the selected backend and a running HTTP server.
+package testsupport
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	gormpostgres "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	handler "github.com/mr3iscuit/ddd-golang/adapters/http"
+	"github.com/mr3iscuit/ddd-golang/application/port"
+	"github.com/mr3iscuit/ddd-golang/application/usecase"
+	"github.com/mr3iscuit/ddd-golang/domain/service"
+	"github.com/mr3iscuit/ddd-golang/infrastructure/repository"
+	postgresrepo "github.com/mr3iscuit/ddd-golang/infrastructure/repository/postgres"
+	"github.com/mr3iscuit/ddd-golang/pkg/config"
+)
+
+// Backends selectable through the TEST_BACKEND environment variable
+const (
+	BackendMemory   = "memory"
+	BackendPostgres = "postgres"
+)
+
+// Backend returns the repository backend integration tests should run against.
+// It defaults to the in-memory backend so tests run without a database.
+func Backend() string {
+	if backend := os.Getenv("TEST_BACKEND"); backend != "" {
+		return backend
+	}
+	return BackendMemory
+}
+
+// NewTestDB connects to the test Postgres database, migrates the schema and
+// truncates existing rows. The returned cleanup drops the schema and closes the connection.
+// The DSN is read from TEST_POSTGRES_DSN, falling back to the application configuration.
+func NewTestDB(t *testing.T) (*gorm.DB, func()) {
+	t.Helper()
+
+	db, err := gorm.Open(gormpostgres.Open(testDSN(t)), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to Postgres with GORM: %v", err)
+	}
+	if err := db.AutoMigrate(&postgresrepo.TodoRecord{}, &postgresrepo.TodoDependencyRecord{}, &postgresrepo.UserRecord{}, &postgresrepo.CategoryRecord{}); err != nil {
+		t.Fatalf("Failed to auto-migrate schema: %v", err)
+	}
+	ResetDB(t, db)
+
+	cleanup := func() {
+		if err := db.Migrator().DropTable(&postgresrepo.TodoRecord{}, &postgresrepo.TodoDependencyRecord{}, &postgresrepo.UserRecord{}, &postgresrepo.CategoryRecord{}); err != nil {
+			t.Logf("Failed to drop table in cleanup: %v", err)
+		}
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	}
+	return db, cleanup
+}
+
+// ResetDB removes all rows so each test starts from an empty database
+func ResetDB(t *testing.T, db *gorm.DB) {
+	t.Helper()
+
+	if err := db.Exec("DELETE FROM todo_dependencies").Error; err != nil {
+		t.Fatalf("Failed to clean todo_dependencies table: %v", err)
+	}
+	if err := db.Exec("DELETE FROM todos").Error; err != nil {
+		t.Fatalf("Failed to clean todos table: %v", err)
+	}
+	if err := db.Exec("DELETE FROM users").Error; err != nil {
+		t.Fatalf("Failed to clean users table: %v", err)
+	}
+	if err := db.Exec("DELETE FROM categories").Error; err != nil {
+		t.Fatalf("Failed to clean categories table: %v", err)
+	}
+}
+
+// NewTestRepository returns an empty repository for the selected backend.
+// Any resources it holds are released when the test finishes.
+func NewTestRepository(t *testing.T) port.TodoRepositoryPort {
+	t.Helper()
+
+	switch backend := Backend(); backend {
+	case BackendMemory:
+		return repository.NewInMemoryTodoRepository()
+	case BackendPostgres:
+		db, cleanup := NewTestDB(t)
+		t.Cleanup(cleanup)
+		return postgresrepo.NewPostgresTodoRepository(db)
+	default:
+		t.Fatalf("Unsupported TEST_BACKEND %q: must be one of memory, postgres", backend)
+		return nil
+	}
+}
+
+// NewTestServer starts an HTTP server wired with all adapters on top of repo
+// and returns its base URL. The server is closed when the test finishes.
+func NewTestServer(t *testing.T, repo port.TodoRepositoryPort) string {
+	t.Helper()
+
+	cfg := config.Default()
+	useCase := usecase.NewTodoUseCase(repo, service.NewTodoDomainService(), usecase.WithConfig(cfg))
+	h := handler.NewTodoHTTPAdapter(useCase, cfg)
+
+	server := httptest.NewServer(h.Router())
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// testDSN resolves the connection string for the test database
+func testDSN(t *testing.T) string {
+	if dsn := os.Getenv("TEST_POSTGRES_DSN"); dsn != "" {
+		return dsn
+	}
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		t.Fatalf("Error loading configuration for tests: %v", err)
+	}
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
+		cfg.DBHost, cfg.DBUser, cfg.DBPassword, cfg.DBName, cfg.DBPort)
+}